@@ -3,6 +3,7 @@ package bridgectrl
 import (
 	"context"
 	"math"
+	"sync"
 
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
@@ -20,6 +21,11 @@ type BridgeController struct {
 	exitTrees   []*MerkleTree
 	rollupsTree *MerkleTree
 	networkIDs  map[uint]uint8
+
+	// XLayer
+	mu     sync.RWMutex
+	store  merkleTreeStore
+	height uint8
 }
 
 // NewBridgeController creates new BridgeController.
@@ -47,10 +53,14 @@ func NewBridgeController(ctx context.Context, cfg Config, networks []uint, mtSto
 		exitTrees:   exitTrees,
 		rollupsTree: rollupsTree,
 		networkIDs:  networkIDs,
+		store:       mtStore.(merkleTreeStore),
+		height:      cfg.Height,
 	}, nil
 }
 
 func (bt *BridgeController) GetNetworkID(networkID uint) (uint8, error) {
+	bt.mu.RLock()
+	defer bt.mu.RUnlock()
 	tID, found := bt.networkIDs[networkID]
 	if !found {
 		return 0, gerror.ErrNetworkNotRegister
@@ -61,20 +71,20 @@ func (bt *BridgeController) GetNetworkID(networkID uint) (uint8, error) {
 // AddDeposit adds deposit information to the bridge tree.
 func (bt *BridgeController) AddDeposit(ctx context.Context, deposit *etherman.Deposit, depositID uint64, dbTx pgx.Tx) error {
 	leaf := hashDeposit(deposit)
-	tID, err := bt.GetNetworkID(deposit.NetworkID)
+	mt, err := bt.exitTree(deposit.NetworkID)
 	if err != nil {
 		return err
 	}
-	return bt.exitTrees[tID].addLeaf(ctx, depositID, leaf, deposit.DepositCount, dbTx)
+	return mt.addLeaf(ctx, depositID, leaf, deposit.DepositCount, dbTx)
 }
 
 // ReorgMT reorg the specific merkle tree.
 func (bt *BridgeController) ReorgMT(ctx context.Context, depositCount uint, networkID uint, dbTx pgx.Tx) error {
-	tID, err := bt.GetNetworkID(networkID)
+	mt, err := bt.exitTree(networkID)
 	if err != nil {
 		return err
 	}
-	return bt.exitTrees[tID].resetLeaf(ctx, depositCount, dbTx)
+	return mt.resetLeaf(ctx, depositCount, dbTx)
 }
 
 // GetExitRoot returns the dedicated merkle tree's root.
@@ -0,0 +1,32 @@
+package bridgectrl
+
+import (
+	"context"
+)
+
+// AddNetwork adds the exit tree of a network registered at runtime, its tree index is the next one
+func (bt *BridgeController) AddNetwork(ctx context.Context, networkID uint) error {
+	bt.mu.Lock()
+	defer bt.mu.Unlock()
+	if _, found := bt.networkIDs[networkID]; found {
+		return nil
+	}
+	mt, err := NewMerkleTree(ctx, bt.store, bt.height, networkID)
+	if err != nil {
+		return err
+	}
+	bt.networkIDs[networkID] = uint8(len(bt.exitTrees))
+	bt.exitTrees = append(bt.exitTrees, mt)
+	return nil
+}
+
+// exitTree returns the exit tree of the network
+func (bt *BridgeController) exitTree(networkID uint) (*MerkleTree, error) {
+	tID, err := bt.GetNetworkID(networkID)
+	if err != nil {
+		return nil, err
+	}
+	bt.mu.RLock()
+	defer bt.mu.RUnlock()
+	return bt.exitTrees[tID], nil
+}
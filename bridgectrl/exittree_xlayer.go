@@ -0,0 +1,58 @@
+package bridgectrl
+
+import (
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ExitTree is an append only exit tree kept in memory, it only stores the left branch of the next leaf
+// so the roots of a whole network can be recomputed from its deposits without touching the stored nodes
+type ExitTree struct {
+	height uint8
+	count  uint
+	branch [][KeyLen]byte
+}
+
+// NewExitTree creates an empty ExitTree
+func NewExitTree(height uint8) *ExitTree {
+	return &ExitTree{height: height, branch: make([][KeyLen]byte, height)}
+}
+
+// AddDeposit appends the leaf of the deposit to the tree
+func (t *ExitTree) AddDeposit(deposit *etherman.Deposit) {
+	t.AddLeaf(hashDeposit(deposit))
+}
+
+// AddLeaf appends the leaf to the tree
+func (t *ExitTree) AddLeaf(leaf [KeyLen]byte) {
+	t.count++
+	node, size := leaf, t.count
+	for h := uint8(0); h < t.height; h++ {
+		if size%2 == 1 {
+			t.branch[h] = node
+			return
+		}
+		node = Hash(t.branch[h], node)
+		size /= 2
+	}
+}
+
+// Count returns the number of leaves of the tree
+func (t *ExitTree) Count() uint {
+	return t.count
+}
+
+// Root returns the current root of the tree
+func (t *ExitTree) Root() common.Hash {
+	var node [KeyLen]byte
+	size := t.count
+	for h := uint8(0); h < t.height; h++ {
+		if size%2 == 1 {
+			node = Hash(t.branch[h], node)
+		} else {
+			node = Hash(node, zeroHashes[h])
+		}
+		size /= 2
+	}
+	return node
+}
@@ -0,0 +1,61 @@
+package bridgectrl
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/test/vectors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExitTreeRootVectors(t *testing.T) {
+	data, err := os.ReadFile("test/vectors/src/mt-bridge/root-vectors.json")
+	require.NoError(t, err)
+
+	var mtTestVectors []vectors.MTRootVectorRaw
+	err = json.Unmarshal(data, &mtTestVectors)
+	require.NoError(t, err)
+
+	for ti, testVector := range mtTestVectors {
+		t.Run(fmt.Sprintf("Test vector %d", ti), func(t *testing.T) {
+			tree := NewExitTree(32)
+			for _, leaf := range testVector.ExistingLeaves {
+				tree.AddLeaf(common.HexToHash(leaf))
+			}
+			assert.Equal(t, common.HexToHash(testVector.CurrentRoot), tree.Root())
+
+			amount, ok := new(big.Int).SetString(testVector.NewLeaf.Amount, 0)
+			require.True(t, ok)
+			tree.AddDeposit(&etherman.Deposit{
+				OriginalNetwork:    testVector.NewLeaf.OriginalNetwork,
+				OriginalAddress:    common.HexToAddress(testVector.NewLeaf.TokenAddress),
+				Amount:             amount,
+				DestinationNetwork: testVector.NewLeaf.DestinationNetwork,
+				DestinationAddress: common.HexToAddress(testVector.NewLeaf.DestinationAddress),
+				Metadata:           common.FromHex(testVector.NewLeaf.Metadata),
+			})
+			assert.Equal(t, common.HexToHash(testVector.NewRoot), tree.Root())
+			assert.Equal(t, uint(len(testVector.ExistingLeaves)+1), tree.Count())
+		})
+	}
+}
+
+func TestExitTreeMatchesComputeSiblings(t *testing.T) {
+	tree := NewExitTree(32)
+	var leaves [][KeyLen]byte
+	for i := 0; i < 20; i++ {
+		leaf := Hash(common.BigToHash(big.NewInt(int64(i))))
+		tree.AddLeaf(leaf)
+		leaves = append(leaves, leaf)
+
+		_, root, err := ComputeSiblings(0, append([][KeyLen]byte{}, leaves...), 32)
+		require.NoError(t, err)
+		assert.Equal(t, root, tree.Root(), "leaves: %d", i+1)
+	}
+}
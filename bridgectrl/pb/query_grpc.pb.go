@@ -37,6 +37,8 @@ type BridgeServiceClient interface {
 	GetTokenWrapped(ctx context.Context, in *GetTokenWrappedRequest, opts ...grpc.CallOption) (*GetTokenWrappedResponse, error)
 	// / Get the latest price of the specified coins
 	GetCoinPrice(ctx context.Context, in *GetCoinPriceRequest, opts ...grpc.CallOption) (*CommonCoinPricesResponse, error)
+	// / Get the health of the price sources the coin prices are aggregated from
+	GetPriceSources(ctx context.Context, in *GetPriceSourcesRequest, opts ...grpc.CallOption) (*CommonPriceSourcesResponse, error)
 	// / Get the list of all the main coins of a specified network
 	GetMainCoins(ctx context.Context, in *GetMainCoinsRequest, opts ...grpc.CallOption) (*CommonCoinsResponse, error)
 	// / Get the pending (not claimed) transactions of an account
@@ -46,11 +48,31 @@ type BridgeServiceClient interface {
 	GetSmtProof(ctx context.Context, in *GetSmtProofRequest, opts ...grpc.CallOption) (*CommonProofResponse, error)
 	// / Get all transactions with ready_for_claim = false
 	GetNotReadyTransactions(ctx context.Context, in *GetNotReadyTransactionsRequest, opts ...grpc.CallOption) (*CommonTransactionsResponse, error)
+	// / Get the deposits to the address that are newer than the sync target of their network
+	GetUnconfirmedTransactions(ctx context.Context, in *GetUnconfirmedTransactionsRequest, opts ...grpc.CallOption) (*CommonTransactionsResponse, error)
 	// / Get list of monitored transactions, filtered by status
 	GetMonitoredTxsByStatus(ctx context.Context, in *GetMonitoredTxsByStatusRequest, opts ...grpc.CallOption) (*CommonMonitoredTxsResponse, error)
+	// / Request an operator action (requeue/cancel/resend) on the monitored tx of a deposit
+	OperateMonitoredTx(ctx context.Context, in *OperateMonitoredTxRequest, opts ...grpc.CallOption) (*CommonMonitoredTxOperationResponse, error)
+	// / Get the audit trail of the operations requested on the monitored tx of a deposit
+	GetMonitoredTxOperations(ctx context.Context, in *GetMonitoredTxOperationsRequest, opts ...grpc.CallOption) (*CommonMonitoredTxOperationsResponse, error)
+	// / Add a webhook subscription, the transaction updates matching its filters are posted to its URL
+	AddWebhookSubscription(ctx context.Context, in *AddWebhookSubscriptionRequest, opts ...grpc.CallOption) (*CommonWebhookSubscriptionResponse, error)
+	// / Get the enabled webhook subscriptions
+	GetWebhookSubscriptions(ctx context.Context, in *GetWebhookSubscriptionsRequest, opts ...grpc.CallOption) (*CommonWebhookSubscriptionsResponse, error)
+	// / Disable a webhook subscription, its delivery log is kept
+	DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*CommonResponse, error)
+	// / Get the delivery log of a webhook subscription, newest first
+	GetWebhookDeliveries(ctx context.Context, in *GetWebhookDeliveriesRequest, opts ...grpc.CallOption) (*CommonWebhookDeliveriesResponse, error)
+	// / Send a webhook delivery again, with its attempts reset
+	ReplayWebhookDelivery(ctx context.Context, in *ReplayWebhookDeliveryRequest, opts ...grpc.CallOption) (*CommonWebhookDeliveryResponse, error)
+	// / Get the journal of the reorgs of a network, with what was rolled back by each one
+	GetReorgJournals(ctx context.Context, in *GetReorgJournalsRequest, opts ...grpc.CallOption) (*CommonReorgJournalsResponse, error)
 	// / Return the estimated deposit wait time for L1 and L2
 	GetEstimateTime(ctx context.Context, in *GetEstimateTimeRequest, opts ...grpc.CallOption) (*CommonEstimateTimeResponse, error)
 	ManualClaim(ctx context.Context, in *ManualClaimRequest, opts ...grpc.CallOption) (*CommonManualClaimResponse, error)
+	// / Build the claim calldata of a deposit of any network, to be signed and sent by the user to the destination network
+	GetClaimCalldata(ctx context.Context, in *GetClaimCalldataRequest, opts ...grpc.CallOption) (*CommonClaimCalldataResponse, error)
 	// / Returns all transactions from a network that are ready_for_claim but not claimed
 	GetReadyPendingTransactions(ctx context.Context, in *GetReadyPendingTransactionsRequest, opts ...grpc.CallOption) (*CommonTransactionsResponse, error)
 	// / Return the messages from the fake producer, only for testing when UseFakeProducer is enabled
@@ -59,6 +81,9 @@ type BridgeServiceClient interface {
 	// / Return large transaction infos
 	GetLargeTransactionInfos(ctx context.Context, in *LargeTxsRequest, opts ...grpc.CallOption) (*LargeTxsResponse, error)
 	GetWstEthTokenNotWithdrawn(ctx context.Context, in *GetWstEthTokenNotWithdrawnRequest, opts ...grpc.CallOption) (*GetWstEthTokenNotWithdrawnResponse, error)
+	// / Stream the status changes of the transactions of a destination address or a tx hash, the same updates
+	// / pushed to the message push producer. It's served over HTTP as server-sent events on /transactions/subscribe
+	SubscribeTransactions(ctx context.Context, in *SubscribeTransactionsRequest, opts ...grpc.CallOption) (BridgeService_SubscribeTransactionsClient, error)
 }
 
 type bridgeServiceClient struct {
@@ -132,6 +157,15 @@ func (c *bridgeServiceClient) GetCoinPrice(ctx context.Context, in *GetCoinPrice
 	return out, nil
 }
 
+func (c *bridgeServiceClient) GetPriceSources(ctx context.Context, in *GetPriceSourcesRequest, opts ...grpc.CallOption) (*CommonPriceSourcesResponse, error) {
+	out := new(CommonPriceSourcesResponse)
+	err := c.cc.Invoke(ctx, "/bridge.v1.BridgeService/GetPriceSources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bridgeServiceClient) GetMainCoins(ctx context.Context, in *GetMainCoinsRequest, opts ...grpc.CallOption) (*CommonCoinsResponse, error) {
 	out := new(CommonCoinsResponse)
 	err := c.cc.Invoke(ctx, "/bridge.v1.BridgeService/GetMainCoins", in, out, opts...)
@@ -177,6 +211,15 @@ func (c *bridgeServiceClient) GetNotReadyTransactions(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *bridgeServiceClient) GetUnconfirmedTransactions(ctx context.Context, in *GetUnconfirmedTransactionsRequest, opts ...grpc.CallOption) (*CommonTransactionsResponse, error) {
+	out := new(CommonTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/bridge.v1.BridgeService/GetUnconfirmedTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bridgeServiceClient) GetMonitoredTxsByStatus(ctx context.Context, in *GetMonitoredTxsByStatusRequest, opts ...grpc.CallOption) (*CommonMonitoredTxsResponse, error) {
 	out := new(CommonMonitoredTxsResponse)
 	err := c.cc.Invoke(ctx, "/bridge.v1.BridgeService/GetMonitoredTxsByStatus", in, out, opts...)
@@ -186,6 +229,78 @@ func (c *bridgeServiceClient) GetMonitoredTxsByStatus(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *bridgeServiceClient) OperateMonitoredTx(ctx context.Context, in *OperateMonitoredTxRequest, opts ...grpc.CallOption) (*CommonMonitoredTxOperationResponse, error) {
+	out := new(CommonMonitoredTxOperationResponse)
+	err := c.cc.Invoke(ctx, "/bridge.v1.BridgeService/OperateMonitoredTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeServiceClient) GetMonitoredTxOperations(ctx context.Context, in *GetMonitoredTxOperationsRequest, opts ...grpc.CallOption) (*CommonMonitoredTxOperationsResponse, error) {
+	out := new(CommonMonitoredTxOperationsResponse)
+	err := c.cc.Invoke(ctx, "/bridge.v1.BridgeService/GetMonitoredTxOperations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeServiceClient) AddWebhookSubscription(ctx context.Context, in *AddWebhookSubscriptionRequest, opts ...grpc.CallOption) (*CommonWebhookSubscriptionResponse, error) {
+	out := new(CommonWebhookSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/bridge.v1.BridgeService/AddWebhookSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeServiceClient) GetWebhookSubscriptions(ctx context.Context, in *GetWebhookSubscriptionsRequest, opts ...grpc.CallOption) (*CommonWebhookSubscriptionsResponse, error) {
+	out := new(CommonWebhookSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, "/bridge.v1.BridgeService/GetWebhookSubscriptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeServiceClient) DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*CommonResponse, error) {
+	out := new(CommonResponse)
+	err := c.cc.Invoke(ctx, "/bridge.v1.BridgeService/DeleteWebhookSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeServiceClient) GetWebhookDeliveries(ctx context.Context, in *GetWebhookDeliveriesRequest, opts ...grpc.CallOption) (*CommonWebhookDeliveriesResponse, error) {
+	out := new(CommonWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, "/bridge.v1.BridgeService/GetWebhookDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeServiceClient) ReplayWebhookDelivery(ctx context.Context, in *ReplayWebhookDeliveryRequest, opts ...grpc.CallOption) (*CommonWebhookDeliveryResponse, error) {
+	out := new(CommonWebhookDeliveryResponse)
+	err := c.cc.Invoke(ctx, "/bridge.v1.BridgeService/ReplayWebhookDelivery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeServiceClient) GetReorgJournals(ctx context.Context, in *GetReorgJournalsRequest, opts ...grpc.CallOption) (*CommonReorgJournalsResponse, error) {
+	out := new(CommonReorgJournalsResponse)
+	err := c.cc.Invoke(ctx, "/bridge.v1.BridgeService/GetReorgJournals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bridgeServiceClient) GetEstimateTime(ctx context.Context, in *GetEstimateTimeRequest, opts ...grpc.CallOption) (*CommonEstimateTimeResponse, error) {
 	out := new(CommonEstimateTimeResponse)
 	err := c.cc.Invoke(ctx, "/bridge.v1.BridgeService/GetEstimateTime", in, out, opts...)
@@ -204,6 +319,15 @@ func (c *bridgeServiceClient) ManualClaim(ctx context.Context, in *ManualClaimRe
 	return out, nil
 }
 
+func (c *bridgeServiceClient) GetClaimCalldata(ctx context.Context, in *GetClaimCalldataRequest, opts ...grpc.CallOption) (*CommonClaimCalldataResponse, error) {
+	out := new(CommonClaimCalldataResponse)
+	err := c.cc.Invoke(ctx, "/bridge.v1.BridgeService/GetClaimCalldata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bridgeServiceClient) GetReadyPendingTransactions(ctx context.Context, in *GetReadyPendingTransactionsRequest, opts ...grpc.CallOption) (*CommonTransactionsResponse, error) {
 	out := new(CommonTransactionsResponse)
 	err := c.cc.Invoke(ctx, "/bridge.v1.BridgeService/GetReadyPendingTransactions", in, out, opts...)
@@ -240,6 +364,38 @@ func (c *bridgeServiceClient) GetWstEthTokenNotWithdrawn(ctx context.Context, in
 	return out, nil
 }
 
+func (c *bridgeServiceClient) SubscribeTransactions(ctx context.Context, in *SubscribeTransactionsRequest, opts ...grpc.CallOption) (BridgeService_SubscribeTransactionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BridgeService_ServiceDesc.Streams[0], "/bridge.v1.BridgeService/SubscribeTransactions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bridgeServiceSubscribeTransactionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BridgeService_SubscribeTransactionsClient interface {
+	Recv() (*Transaction, error)
+	grpc.ClientStream
+}
+
+type bridgeServiceSubscribeTransactionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *bridgeServiceSubscribeTransactionsClient) Recv() (*Transaction, error) {
+	m := new(Transaction)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // BridgeServiceServer is the server API for BridgeService service.
 // All implementations must embed UnimplementedBridgeServiceServer
 // for forward compatibility
@@ -259,6 +415,8 @@ type BridgeServiceServer interface {
 	GetTokenWrapped(context.Context, *GetTokenWrappedRequest) (*GetTokenWrappedResponse, error)
 	// / Get the latest price of the specified coins
 	GetCoinPrice(context.Context, *GetCoinPriceRequest) (*CommonCoinPricesResponse, error)
+	// / Get the health of the price sources the coin prices are aggregated from
+	GetPriceSources(context.Context, *GetPriceSourcesRequest) (*CommonPriceSourcesResponse, error)
 	// / Get the list of all the main coins of a specified network
 	GetMainCoins(context.Context, *GetMainCoinsRequest) (*CommonCoinsResponse, error)
 	// / Get the pending (not claimed) transactions of an account
@@ -268,11 +426,31 @@ type BridgeServiceServer interface {
 	GetSmtProof(context.Context, *GetSmtProofRequest) (*CommonProofResponse, error)
 	// / Get all transactions with ready_for_claim = false
 	GetNotReadyTransactions(context.Context, *GetNotReadyTransactionsRequest) (*CommonTransactionsResponse, error)
+	// / Get the deposits to the address that are newer than the sync target of their network
+	GetUnconfirmedTransactions(context.Context, *GetUnconfirmedTransactionsRequest) (*CommonTransactionsResponse, error)
 	// / Get list of monitored transactions, filtered by status
 	GetMonitoredTxsByStatus(context.Context, *GetMonitoredTxsByStatusRequest) (*CommonMonitoredTxsResponse, error)
+	// / Request an operator action (requeue/cancel/resend) on the monitored tx of a deposit
+	OperateMonitoredTx(context.Context, *OperateMonitoredTxRequest) (*CommonMonitoredTxOperationResponse, error)
+	// / Get the audit trail of the operations requested on the monitored tx of a deposit
+	GetMonitoredTxOperations(context.Context, *GetMonitoredTxOperationsRequest) (*CommonMonitoredTxOperationsResponse, error)
+	// / Add a webhook subscription, the transaction updates matching its filters are posted to its URL
+	AddWebhookSubscription(context.Context, *AddWebhookSubscriptionRequest) (*CommonWebhookSubscriptionResponse, error)
+	// / Get the enabled webhook subscriptions
+	GetWebhookSubscriptions(context.Context, *GetWebhookSubscriptionsRequest) (*CommonWebhookSubscriptionsResponse, error)
+	// / Disable a webhook subscription, its delivery log is kept
+	DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*CommonResponse, error)
+	// / Get the delivery log of a webhook subscription, newest first
+	GetWebhookDeliveries(context.Context, *GetWebhookDeliveriesRequest) (*CommonWebhookDeliveriesResponse, error)
+	// / Send a webhook delivery again, with its attempts reset
+	ReplayWebhookDelivery(context.Context, *ReplayWebhookDeliveryRequest) (*CommonWebhookDeliveryResponse, error)
+	// / Get the journal of the reorgs of a network, with what was rolled back by each one
+	GetReorgJournals(context.Context, *GetReorgJournalsRequest) (*CommonReorgJournalsResponse, error)
 	// / Return the estimated deposit wait time for L1 and L2
 	GetEstimateTime(context.Context, *GetEstimateTimeRequest) (*CommonEstimateTimeResponse, error)
 	ManualClaim(context.Context, *ManualClaimRequest) (*CommonManualClaimResponse, error)
+	// / Build the claim calldata of a deposit of any network, to be signed and sent by the user to the destination network
+	GetClaimCalldata(context.Context, *GetClaimCalldataRequest) (*CommonClaimCalldataResponse, error)
 	// / Returns all transactions from a network that are ready_for_claim but not claimed
 	GetReadyPendingTransactions(context.Context, *GetReadyPendingTransactionsRequest) (*CommonTransactionsResponse, error)
 	// / Return the messages from the fake producer, only for testing when UseFakeProducer is enabled
@@ -281,6 +459,9 @@ type BridgeServiceServer interface {
 	// / Return large transaction infos
 	GetLargeTransactionInfos(context.Context, *LargeTxsRequest) (*LargeTxsResponse, error)
 	GetWstEthTokenNotWithdrawn(context.Context, *GetWstEthTokenNotWithdrawnRequest) (*GetWstEthTokenNotWithdrawnResponse, error)
+	// / Stream the status changes of the transactions of a destination address or a tx hash, the same updates
+	// / pushed to the message push producer. It's served over HTTP as server-sent events on /transactions/subscribe
+	SubscribeTransactions(*SubscribeTransactionsRequest, BridgeService_SubscribeTransactionsServer) error
 	mustEmbedUnimplementedBridgeServiceServer()
 }
 
@@ -309,6 +490,9 @@ func (UnimplementedBridgeServiceServer) GetTokenWrapped(context.Context, *GetTok
 func (UnimplementedBridgeServiceServer) GetCoinPrice(context.Context, *GetCoinPriceRequest) (*CommonCoinPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCoinPrice not implemented")
 }
+func (UnimplementedBridgeServiceServer) GetPriceSources(context.Context, *GetPriceSourcesRequest) (*CommonPriceSourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceSources not implemented")
+}
 func (UnimplementedBridgeServiceServer) GetMainCoins(context.Context, *GetMainCoinsRequest) (*CommonCoinsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMainCoins not implemented")
 }
@@ -324,15 +508,45 @@ func (UnimplementedBridgeServiceServer) GetSmtProof(context.Context, *GetSmtProo
 func (UnimplementedBridgeServiceServer) GetNotReadyTransactions(context.Context, *GetNotReadyTransactionsRequest) (*CommonTransactionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNotReadyTransactions not implemented")
 }
+func (UnimplementedBridgeServiceServer) GetUnconfirmedTransactions(context.Context, *GetUnconfirmedTransactionsRequest) (*CommonTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnconfirmedTransactions not implemented")
+}
 func (UnimplementedBridgeServiceServer) GetMonitoredTxsByStatus(context.Context, *GetMonitoredTxsByStatusRequest) (*CommonMonitoredTxsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMonitoredTxsByStatus not implemented")
 }
+func (UnimplementedBridgeServiceServer) OperateMonitoredTx(context.Context, *OperateMonitoredTxRequest) (*CommonMonitoredTxOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateMonitoredTx not implemented")
+}
+func (UnimplementedBridgeServiceServer) GetMonitoredTxOperations(context.Context, *GetMonitoredTxOperationsRequest) (*CommonMonitoredTxOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMonitoredTxOperations not implemented")
+}
+func (UnimplementedBridgeServiceServer) AddWebhookSubscription(context.Context, *AddWebhookSubscriptionRequest) (*CommonWebhookSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWebhookSubscription not implemented")
+}
+func (UnimplementedBridgeServiceServer) GetWebhookSubscriptions(context.Context, *GetWebhookSubscriptionsRequest) (*CommonWebhookSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookSubscriptions not implemented")
+}
+func (UnimplementedBridgeServiceServer) DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*CommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhookSubscription not implemented")
+}
+func (UnimplementedBridgeServiceServer) GetWebhookDeliveries(context.Context, *GetWebhookDeliveriesRequest) (*CommonWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookDeliveries not implemented")
+}
+func (UnimplementedBridgeServiceServer) ReplayWebhookDelivery(context.Context, *ReplayWebhookDeliveryRequest) (*CommonWebhookDeliveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayWebhookDelivery not implemented")
+}
+func (UnimplementedBridgeServiceServer) GetReorgJournals(context.Context, *GetReorgJournalsRequest) (*CommonReorgJournalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReorgJournals not implemented")
+}
 func (UnimplementedBridgeServiceServer) GetEstimateTime(context.Context, *GetEstimateTimeRequest) (*CommonEstimateTimeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEstimateTime not implemented")
 }
 func (UnimplementedBridgeServiceServer) ManualClaim(context.Context, *ManualClaimRequest) (*CommonManualClaimResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ManualClaim not implemented")
 }
+func (UnimplementedBridgeServiceServer) GetClaimCalldata(context.Context, *GetClaimCalldataRequest) (*CommonClaimCalldataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClaimCalldata not implemented")
+}
 func (UnimplementedBridgeServiceServer) GetReadyPendingTransactions(context.Context, *GetReadyPendingTransactionsRequest) (*CommonTransactionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReadyPendingTransactions not implemented")
 }
@@ -345,6 +559,9 @@ func (UnimplementedBridgeServiceServer) GetLargeTransactionInfos(context.Context
 func (UnimplementedBridgeServiceServer) GetWstEthTokenNotWithdrawn(context.Context, *GetWstEthTokenNotWithdrawnRequest) (*GetWstEthTokenNotWithdrawnResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWstEthTokenNotWithdrawn not implemented")
 }
+func (UnimplementedBridgeServiceServer) SubscribeTransactions(*SubscribeTransactionsRequest, BridgeService_SubscribeTransactionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeTransactions not implemented")
+}
 func (UnimplementedBridgeServiceServer) mustEmbedUnimplementedBridgeServiceServer() {}
 
 // UnsafeBridgeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -484,6 +701,24 @@ func _BridgeService_GetCoinPrice_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BridgeService_GetPriceSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceSourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).GetPriceSources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.v1.BridgeService/GetPriceSources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).GetPriceSources(ctx, req.(*GetPriceSourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BridgeService_GetMainCoins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetMainCoinsRequest)
 	if err := dec(in); err != nil {
@@ -574,6 +809,24 @@ func _BridgeService_GetNotReadyTransactions_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BridgeService_GetUnconfirmedTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnconfirmedTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).GetUnconfirmedTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.v1.BridgeService/GetUnconfirmedTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).GetUnconfirmedTransactions(ctx, req.(*GetUnconfirmedTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BridgeService_GetMonitoredTxsByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetMonitoredTxsByStatusRequest)
 	if err := dec(in); err != nil {
@@ -592,6 +845,150 @@ func _BridgeService_GetMonitoredTxsByStatus_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BridgeService_OperateMonitoredTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateMonitoredTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).OperateMonitoredTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.v1.BridgeService/OperateMonitoredTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).OperateMonitoredTx(ctx, req.(*OperateMonitoredTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeService_GetMonitoredTxOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMonitoredTxOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).GetMonitoredTxOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.v1.BridgeService/GetMonitoredTxOperations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).GetMonitoredTxOperations(ctx, req.(*GetMonitoredTxOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeService_AddWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).AddWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.v1.BridgeService/AddWebhookSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).AddWebhookSubscription(ctx, req.(*AddWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeService_GetWebhookSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).GetWebhookSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.v1.BridgeService/GetWebhookSubscriptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).GetWebhookSubscriptions(ctx, req.(*GetWebhookSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeService_DeleteWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).DeleteWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.v1.BridgeService/DeleteWebhookSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).DeleteWebhookSubscription(ctx, req.(*DeleteWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeService_GetWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).GetWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.v1.BridgeService/GetWebhookDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).GetWebhookDeliveries(ctx, req.(*GetWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeService_ReplayWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayWebhookDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).ReplayWebhookDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.v1.BridgeService/ReplayWebhookDelivery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).ReplayWebhookDelivery(ctx, req.(*ReplayWebhookDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeService_GetReorgJournals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReorgJournalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).GetReorgJournals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.v1.BridgeService/GetReorgJournals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).GetReorgJournals(ctx, req.(*GetReorgJournalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BridgeService_GetEstimateTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetEstimateTimeRequest)
 	if err := dec(in); err != nil {
@@ -628,6 +1025,24 @@ func _BridgeService_ManualClaim_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BridgeService_GetClaimCalldata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClaimCalldataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).GetClaimCalldata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.v1.BridgeService/GetClaimCalldata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).GetClaimCalldata(ctx, req.(*GetClaimCalldataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BridgeService_GetReadyPendingTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetReadyPendingTransactionsRequest)
 	if err := dec(in); err != nil {
@@ -700,6 +1115,27 @@ func _BridgeService_GetWstEthTokenNotWithdrawn_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BridgeService_SubscribeTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeTransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BridgeServiceServer).SubscribeTransactions(m, &bridgeServiceSubscribeTransactionsServer{stream})
+}
+
+type BridgeService_SubscribeTransactionsServer interface {
+	Send(*Transaction) error
+	grpc.ServerStream
+}
+
+type bridgeServiceSubscribeTransactionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *bridgeServiceSubscribeTransactionsServer) Send(m *Transaction) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // BridgeService_ServiceDesc is the grpc.ServiceDesc for BridgeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -735,6 +1171,10 @@ var BridgeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCoinPrice",
 			Handler:    _BridgeService_GetCoinPrice_Handler,
 		},
+		{
+			MethodName: "GetPriceSources",
+			Handler:    _BridgeService_GetPriceSources_Handler,
+		},
 		{
 			MethodName: "GetMainCoins",
 			Handler:    _BridgeService_GetMainCoins_Handler,
@@ -755,10 +1195,46 @@ var BridgeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetNotReadyTransactions",
 			Handler:    _BridgeService_GetNotReadyTransactions_Handler,
 		},
+		{
+			MethodName: "GetUnconfirmedTransactions",
+			Handler:    _BridgeService_GetUnconfirmedTransactions_Handler,
+		},
 		{
 			MethodName: "GetMonitoredTxsByStatus",
 			Handler:    _BridgeService_GetMonitoredTxsByStatus_Handler,
 		},
+		{
+			MethodName: "OperateMonitoredTx",
+			Handler:    _BridgeService_OperateMonitoredTx_Handler,
+		},
+		{
+			MethodName: "GetMonitoredTxOperations",
+			Handler:    _BridgeService_GetMonitoredTxOperations_Handler,
+		},
+		{
+			MethodName: "AddWebhookSubscription",
+			Handler:    _BridgeService_AddWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "GetWebhookSubscriptions",
+			Handler:    _BridgeService_GetWebhookSubscriptions_Handler,
+		},
+		{
+			MethodName: "DeleteWebhookSubscription",
+			Handler:    _BridgeService_DeleteWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "GetWebhookDeliveries",
+			Handler:    _BridgeService_GetWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "ReplayWebhookDelivery",
+			Handler:    _BridgeService_ReplayWebhookDelivery_Handler,
+		},
+		{
+			MethodName: "GetReorgJournals",
+			Handler:    _BridgeService_GetReorgJournals_Handler,
+		},
 		{
 			MethodName: "GetEstimateTime",
 			Handler:    _BridgeService_GetEstimateTime_Handler,
@@ -767,6 +1243,10 @@ var BridgeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ManualClaim",
 			Handler:    _BridgeService_ManualClaim_Handler,
 		},
+		{
+			MethodName: "GetClaimCalldata",
+			Handler:    _BridgeService_GetClaimCalldata_Handler,
+		},
 		{
 			MethodName: "GetReadyPendingTransactions",
 			Handler:    _BridgeService_GetReadyPendingTransactions_Handler,
@@ -784,6 +1264,12 @@ var BridgeService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _BridgeService_GetWstEthTokenNotWithdrawn_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeTransactions",
+			Handler:       _BridgeService_SubscribeTransactions_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "query.proto",
 }
@@ -0,0 +1,92 @@
+package claimtxman
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/messagepush"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/metrics"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils"
+	"github.com/pkg/errors"
+)
+
+// startBalanceMonitor checks periodically the balance of the claim signers, the underfunded
+// signers are paused so they don't get new claims until their balance is restored. If all of
+// them are underfunded, the new claims are kept and sent once a signer is funded again
+func (tm *ClaimTxManager) startBalanceMonitor() {
+	if !tm.cfg.BalanceMonitor.Enabled {
+		return
+	}
+	tm.checkSignersBalance(tm.ctx)
+	ticker := time.NewTicker(tm.cfg.BalanceMonitor.CheckInterval.Duration)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-tm.ctx.Done():
+			return
+		case <-ticker.C:
+			tm.checkSignersBalance(tm.ctx)
+		}
+	}
+}
+
+func (tm *ClaimTxManager) checkSignersBalance(ctx context.Context) {
+	minBalance, ok := new(big.Int).SetString(tm.minSignerBalance.Get(), 10) //nolint:gomnd
+	if !ok {
+		log.Errorf("invalid claim signer min balance %s", tm.minSignerBalance.Get())
+		return
+	}
+	for _, signer := range tm.signers.signers {
+		balance, err := tm.l2Node.BalanceAt(ctx, signer.auth.From, nil)
+		if err != nil {
+			log.Errorf("failed to get the balance of the claim signer %s: %v", signer.auth.From.String(), err)
+			continue
+		}
+		metrics.RecordClaimSignerBalance(uint32(tm.l2NetworkID), signer.auth.From, balance)
+
+		underfunded := balance.Cmp(minBalance) < 0
+		if !tm.signers.setUnderfunded(signer, underfunded) {
+			continue
+		}
+		if !underfunded {
+			log.Infof("claim signer %s balance %s is restored, resuming new claims", signer.auth.From.String(), balance.String())
+			continue
+		}
+		log.Warnf("claim signer %s balance %s is below %s, pausing new claims", signer.auth.From.String(), balance.String(), minBalance.String())
+		err = tm.pushSignerBalanceAlert(signer, balance, minBalance)
+		if err != nil {
+			log.Errorf("failed to push the claim signer balance alert: %v", err)
+		}
+	}
+}
+
+// pushSignerBalanceAlert notifies that the balance of the signer dropped below the threshold
+func (tm *ClaimTxManager) pushSignerBalanceAlert(signer *claimSigner, balance, minBalance *big.Int) error {
+	if tm.messagePushProducer == nil {
+		return errors.New("kafka push producer is nil")
+	}
+	b, err := json.Marshal(&messagepush.ClaimSignerBalanceAlert{
+		NetworkID:  tm.l2NetworkID,
+		Address:    signer.auth.From.String(),
+		Balance:    balance.String(),
+		MinBalance: minBalance.String(),
+		Time:       time.Now().UnixMilli(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "json marshal error")
+	}
+	msg := &messagepush.PushMessage{
+		BizCode:       messagepush.BizCodeClaimSignerBalance,
+		WalletAddress: signer.auth.From.String(),
+		RequestID:     utils.GenerateTraceID(),
+		PushContent:   string(b),
+		Time:          time.Now().UnixMilli(),
+	}
+	if tm.cfg.BalanceMonitor.AlertTopic != "" {
+		return tm.messagePushProducer.Produce(msg, messagepush.WithTopic(tm.cfg.BalanceMonitor.AlertTopic))
+	}
+	return tm.messagePushProducer.Produce(msg)
+}
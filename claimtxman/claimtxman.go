@@ -55,10 +55,22 @@ type ClaimTxManager struct {
 	updateDepositsL1Mutex sync.Mutex
 	updateDepositsL2Mutex sync.Mutex
 	isDone                bool
+	// wg tracks the monitor loops and the deposits being updated, StartXLayer waits for them before returning
+	wg sync.WaitGroup
 	// Producer to push the transaction status change to front end
 	messagePushProducer messagepush.KafkaProducer
 	redisStorage        redisstorage.RedisStorage
 	monitorTxsLimit     apolloconfig.Entry[uint]
+	signers             *signerPool
+	minSignerBalance    apolloconfig.Entry[string]
+	policy              apolloconfig.Entry[ClaimPolicy]
+	rateLimit           apolloconfig.Entry[RateLimitConfig]
+	// depositNetworkID is the network of the deposits claimed by this manager, 0 (L1) for the L2 claims
+	depositNetworkID uint
+	// l1Claimer claims on L1 the deposits of this L2 network, nil if the L1 claim mode is disabled
+	l1Claimer *ClaimTxManager
+	// leader tells whether this replica is the leader, nil if the leader election is disabled
+	leader leaderInterface
 }
 
 // NewClaimTxManager creates a new claim transaction manager.
@@ -75,7 +87,12 @@ func NewClaimTxManager(cfg Config, chExitRootEvent chan *etherman.GlobalExitRoot
 		return nil, err
 	}
 	ctx, cancel := context.WithCancel(ctx)
-	auth, err := client.GetSignerFromKeystore(ctx, cfg.PrivateKey)
+	signers, err := newSignerPool(ctx, client, cfg)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	auth := signers.first().auth
 	return &ClaimTxManager{
 		ctx:                 ctx,
 		cancel:              cancel,
@@ -92,7 +109,11 @@ func NewClaimTxManager(cfg Config, chExitRootEvent chan *etherman.GlobalExitRoot
 		messagePushProducer: producer,
 		redisStorage:        redisStorage,
 		monitorTxsLimit:     apolloconfig.NewIntEntry("claimtxman.monitorTxsLimit", uint(128)), //nolint:gomnd
-	}, err
+		signers:             signers,
+		minSignerBalance:    apolloconfig.NewStringEntry("claimtxman.minSignerBalance", cfg.BalanceMonitor.MinBalance),
+		policy:              apolloconfig.NewJSONEntry("claimtxman.policy", cfg.Policy),
+		rateLimit:           apolloconfig.NewJSONEntry("claimtxman.rateLimit", cfg.RateLimit),
+	}, nil
 }
 
 // Start will start the tx management, reading txs from storage,
@@ -276,7 +297,7 @@ func (tm *ClaimTxManager) addClaimTx(depositCount uint, from common.Address, to
 
 	// create monitored tx
 	mTx := ctmtypes.MonitoredTx{
-		DepositID: depositCount, From: from, To: to,
+		DepositID: depositCount, NetworkID: tm.l2NetworkID, From: from, To: to,
 		Nonce: nonce, Value: value, Data: data,
 		Gas: gas, Status: ctmtypes.MonitoredTxStatusCreated,
 	}
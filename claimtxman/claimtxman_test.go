@@ -292,3 +292,65 @@ func TestUpdateL2DepositStatusMultipleRollups(t *testing.T) {
 	require.True(t, deposits[1].ReadyForClaim)
 	require.True(t, deposits[0].ReadyForClaim)
 }
+
+func TestUpdateL1DepositStatusMultipleNetworks(t *testing.T) {
+	ctx := context.Background()
+	dbCfg := pgstorage.NewConfigFromEnv()
+	err := pgstorage.InitOrReset(dbCfg)
+	require.NoError(t, err)
+	pg, err := pgstorage.NewPostgresStorage(dbCfg)
+	require.NoError(t, err)
+
+	block := &etherman.Block{
+		BlockNumber: 1,
+		BlockHash:   common.HexToHash("0x29e885edaf8e4b51e1d2e05f9da28161d2fb4f6b1d53827d9b80a23cf2d7d9f1"),
+		ParentHash:  common.HexToHash("0x29e885edaf8e4b51e1d2e05f9da28161d2fb4f6b1d53827d9b80a23cf2d7d9f2"),
+		NetworkID:   0,
+		ReceivedAt:  time.Now(),
+	}
+	blockID, err := pg.AddBlock(ctx, block, nil)
+	require.NoError(t, err)
+
+	// The deposits of L1 to two L2 networks, the root covers both of them
+	var l1Root []byte
+	for i, destNetwork := range []uint{1, 2} {
+		deposit := &etherman.Deposit{
+			NetworkID:          0,
+			OriginalNetwork:    0,
+			OriginalAddress:    common.HexToAddress("0x6B175474E89094C44Da98b954EedeAC495271d0F"),
+			Amount:             big.NewInt(1000000),
+			DestinationNetwork: destNetwork,
+			DestinationAddress: common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"),
+			BlockNumber:        1,
+			BlockID:            blockID,
+			DepositCount:       uint(i + 1),
+			Metadata:           common.FromHex("0x0"),
+		}
+		depositID, err := pg.AddDeposit(ctx, deposit, nil)
+		require.NoError(t, err)
+		l1Root = common.BigToHash(big.NewInt(int64(i + 1))).Bytes()
+		require.NoError(t, pg.SetRoot(ctx, l1Root, depositID, deposit.NetworkID, nil))
+	}
+
+	// Every claim tx manager gets the deposits to its own network
+	deposits, err := pg.GetL1Deposits(ctx, l1Root, 2, nil)
+	require.NoError(t, err)
+	require.Len(t, deposits, 1)
+	require.Equal(t, uint(2), deposits[0].DepositCount)
+
+	deposits, err = pg.UpdateL1DepositsStatusXLayer(ctx, l1Root, 1, nil)
+	require.NoError(t, err)
+	require.Len(t, deposits, 1)
+	require.Equal(t, uint(1), deposits[0].DepositCount)
+	require.True(t, deposits[0].ReadyForClaim)
+
+	deposits, err = pg.UpdateL1DepositsStatusXLayer(ctx, l1Root, 2, nil)
+	require.NoError(t, err)
+	require.Len(t, deposits, 1)
+	require.Equal(t, uint(2), deposits[0].DepositCount)
+	require.Equal(t, uint(2), deposits[0].DestinationNetwork)
+
+	deposits, err = pg.UpdateL1DepositsStatusXLayer(ctx, l1Root, 1, nil)
+	require.NoError(t, err)
+	require.Len(t, deposits, 0)
+}
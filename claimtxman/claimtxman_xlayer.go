@@ -11,6 +11,7 @@ import (
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/metrics"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/pushoutbox"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/pushtask"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/utils"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
@@ -23,15 +24,26 @@ import (
 	"github.com/pkg/errors"
 )
 
+// ErrStaleLeader is returned when a newer leader took over, the writes of this replica are rejected
+var ErrStaleLeader = errors.New("a newer leader holds the lease")
+
 // StartXLayer will start the tx management, reading txs from storage,
 // send then to the blockchain and keep monitoring them until they
-// get mined
+// get mined. Once stopped, it returns when the txs being processed are done
 func (tm *ClaimTxManager) StartXLayer() {
-	go tm.startMonitorTxs()
+	tm.goTracked(tm.startMonitorTxs)
+	tm.goTracked(tm.startBalanceMonitor)
+	if tm.l1Claimer != nil {
+		tm.goTracked(tm.l1Claimer.startMonitorTxs)
+	}
 	for {
 		select {
 		case <-tm.ctx.Done():
 			tm.isDone = true
+			if tm.l1Claimer != nil {
+				tm.l1Claimer.isDone = true
+			}
+			tm.wg.Wait()
 			return
 		case netID := <-tm.chSynced:
 			if netID == tm.l2NetworkID && !tm.synced {
@@ -41,12 +53,12 @@ func (tm *ClaimTxManager) StartXLayer() {
 		case ger := <-tm.chExitRootEvent:
 			if tm.synced {
 				log.Debug("UpdateDepositsStatus for ger: ", ger.GlobalExitRoot)
-				go func() {
+				tm.goTracked(func() {
 					err := tm.updateDepositsStatusXLayer(ger)
 					if err != nil {
 						log.Errorf("failed to update deposits status: %v", err)
 					}
-				}()
+				})
 			} else {
 				log.Infof("Waiting for networkID %d to be synced before processing deposits", tm.l2NetworkID)
 			}
@@ -54,6 +66,49 @@ func (tm *ClaimTxManager) StartXLayer() {
 	}
 }
 
+// Stop stops the tx management of the network
+func (tm *ClaimTxManager) Stop() {
+	tm.cancel()
+}
+
+// goTracked runs the function in a goroutine that StartXLayer waits for before returning
+func (tm *ClaimTxManager) goTracked(f func()) {
+	tm.wg.Add(1)
+	go func() {
+		defer tm.wg.Done()
+		f()
+	}()
+}
+
+// SetLeader sets the leader elector, the monitored txs are only sent while this replica is the leader
+func (tm *ClaimTxManager) SetLeader(leader leaderInterface) {
+	tm.leader = leader
+	if tm.l1Claimer != nil {
+		tm.l1Claimer.SetLeader(leader)
+	}
+}
+
+// isLeader returns whether this replica is allowed to send the monitored txs
+func (tm *ClaimTxManager) isLeader() bool {
+	return tm.leader == nil || tm.leader.IsLeader()
+}
+
+// checkFencingToken returns ErrStaleLeader if the fencing token of this replica is older than the one of the
+// last elected leader. It locks the token in the db tx, so the writes of the db tx can't overlap with a newer leader
+func (tm *ClaimTxManager) checkFencingToken(ctx context.Context, dbTx pgx.Tx) error {
+	if tm.leader == nil {
+		return nil
+	}
+	latest, err := tm.storage.CheckFencingToken(ctx, tm.leader.LeaseKey(), tm.leader.FencingToken(), dbTx)
+	if err != nil {
+		return errors.Wrap(err, "failed to check the fencing token")
+	}
+	if !latest {
+		return ErrStaleLeader
+	}
+	return nil
+}
+
 func (tm *ClaimTxManager) startMonitorTxs() {
 	ticker := time.NewTicker(tm.cfg.FrequencyToMonitorTxs.Duration)
 	for range ticker.C {
@@ -89,7 +144,10 @@ func (tm *ClaimTxManager) updateDepositsStatusXLayer(ger *etherman.GlobalExitRoo
 	if err != nil {
 		return err
 	}
-	err = tm.processDepositStatusXLayer(ger, dbTx)
+	err = tm.checkFencingToken(tm.ctx, dbTx)
+	if err == nil {
+		err = tm.processDepositStatusXLayer(ger, dbTx)
+	}
 	if err != nil {
 		log.Errorf("error processing ger. Error: %v", err)
 		rollbackErr := tm.storage.Rollback(tm.ctx, dbTx)
@@ -118,6 +176,10 @@ func (tm *ClaimTxManager) processDepositStatusL2(ger *etherman.GlobalExitRoot) e
 	if err != nil {
 		return err
 	}
+	if err = tm.checkFencingToken(tm.ctx, dbTx); err != nil {
+		tm.rollbackStore(dbTx)
+		return err
+	}
 	log.Infof("Rollup exitroot %v is updated", ger.ExitRoots[1])
 	deposits, err := tm.storage.UpdateL2DepositsStatusXLayer(tm.ctx, ger.ExitRoots[1][:], tm.rollupID, tm.l2NetworkID, dbTx)
 	if err != nil {
@@ -129,6 +191,20 @@ func (tm *ClaimTxManager) processDepositStatusL2(ger *etherman.GlobalExitRoot) e
 		}
 		return err
 	}
+	autoClaimed, err := tm.addL1ClaimTxs(deposits, dbTx)
+	if err != nil {
+		log.Errorf("error adding L1 claim txs. Error: %v", err)
+		tm.rollbackStore(dbTx)
+		return err
+	}
+	for _, deposit := range deposits {
+		// Notify FE that tx is pending user claim, or auto claim if it is claimed on L1 by the L1 claimer
+		if err = tm.addPushMessage(deposit, l2DepositReadyStatus(autoClaimed[deposit.DepositCount]), dbTx); err != nil {
+			log.Errorf("error adding the push message of deposit %d. Error: %v", deposit.DepositCount, err)
+			tm.rollbackStore(dbTx)
+			return err
+		}
+	}
 	err = tm.storage.Commit(tm.ctx, dbTx)
 	if err != nil {
 		log.Errorf("AddClaimTx committing dbTx. Err: %v", err)
@@ -141,8 +217,6 @@ func (tm *ClaimTxManager) processDepositStatusL2(ger *etherman.GlobalExitRoot) e
 	log.Debugf("begin send deposits for l1 ready_claim, blockId: %v, blockNumber: %v, deposit size: %v", ger.BlockID, ger.BlockNumber,
 		len(deposits))
 	for _, deposit := range deposits {
-		// Notify FE that tx is pending auto claim
-		go tm.pushTransactionUpdate(deposit, uint32(pb.TransactionStatus_TX_PENDING_USER_CLAIM))
 		// Record order waiting time metric
 		metrics.RecordOrderWaitTime(uint32(deposit.NetworkID), uint32(deposit.DestinationNetwork), time.Since(deposit.Time))
 	}
@@ -151,7 +225,7 @@ func (tm *ClaimTxManager) processDepositStatusL2(ger *etherman.GlobalExitRoot) e
 
 func (tm *ClaimTxManager) getDeposits(ger *etherman.GlobalExitRoot) ([]*etherman.Deposit, error) {
 	log.Infof("Mainnet exitroot %v is updated", ger.ExitRoots[0])
-	deposits, err := tm.storage.GetL1Deposits(tm.ctx, ger.ExitRoots[0][:], nil)
+	deposits, err := tm.storage.GetL1Deposits(tm.ctx, ger.ExitRoots[0][:], tm.l2NetworkID, nil)
 	if err != nil {
 		log.Errorf("error processing ger. Error: %v", err)
 		return nil, err
@@ -171,6 +245,10 @@ func (tm *ClaimTxManager) processDepositStatusL1(newGer *etherman.GlobalExitRoot
 		if err != nil {
 			return err
 		}
+		if err = tm.checkFencingToken(tm.ctx, dbTx); err != nil {
+			tm.rollbackStore(dbTx)
+			return err
+		}
 		err = tm.storage.UpdateL1DepositStatus(tm.ctx, deposit.DepositCount, dbTx)
 		if err != nil {
 			log.Errorf("error update deposit %d status. Error: %v", deposit.DepositCount, err)
@@ -182,7 +260,7 @@ func (tm *ClaimTxManager) processDepositStatusL1(newGer *etherman.GlobalExitRoot
 			log.Infof("Ignoring deposit: %d: dest_net: %d, we are:%d", deposit.DepositCount, deposit.DestinationNetwork, tm.l2NetworkID)
 			ignore = true
 		} else {
-			claimHash, err := tm.bridgeService.GetDepositStatus(tm.ctx, deposit.DepositCount, deposit.DestinationNetwork)
+			claimHash, err := tm.getClaimTxHash(tm.ctx, deposit.DepositCount, dbTx)
 			if err != nil {
 				log.Errorf("error getting deposit status for deposit %d. Error: %v", deposit.DepositCount, err)
 				tm.rollbackStore(dbTx)
@@ -192,8 +270,22 @@ func (tm *ClaimTxManager) processDepositStatusL1(newGer *etherman.GlobalExitRoot
 				log.Infof("Ignoring deposit: %d, leafType: %d, claimHash: %s", deposit.DepositCount, deposit.LeafType, claimHash)
 				ignore = true
 			}
+			if !ignore {
+				ignore, err = tm.isRejectedByPolicy(deposit, dbTx)
+				if err != nil {
+					tm.rollbackStore(dbTx)
+					return err
+				}
+			}
 		}
 
+		if !ignore {
+			err = tm.createClaimTxXLayer(deposit, dbTx)
+			if err != nil {
+				tm.rollbackStore(dbTx)
+				return err
+			}
+		}
 		if ignore {
 			// todo: optimize it
 			err = tm.storage.Commit(tm.ctx, dbTx)
@@ -209,39 +301,6 @@ func (tm *ClaimTxManager) processDepositStatusL1(newGer *etherman.GlobalExitRoot
 			}
 			continue
 		}
-		log.Infof("create the claim tx for the deposit %d", deposit.DepositCount)
-		ger, proof, rollupProof, err := tm.bridgeService.GetClaimProof(deposit.DepositCount, deposit.NetworkID, dbTx)
-		if err != nil {
-			log.Errorf("error getting Claim Proof for deposit %d. Error: %v", deposit.DepositCount, err)
-			tm.rollbackStore(dbTx)
-			return err
-		}
-		log.Infof("get the claim proof for the deposit %d successfully", deposit.DepositCount)
-		var (
-			mtProof       [mtHeight][keyLen]byte
-			mtRollupProof [mtHeight][keyLen]byte
-		)
-		for i := 0; i < mtHeight; i++ {
-			mtProof[i] = proof[i]
-			mtRollupProof[i] = rollupProof[i]
-		}
-		tx, err := tm.l2Node.BuildSendClaimXLayer(tm.ctx, deposit, mtProof, mtRollupProof,
-			&etherman.GlobalExitRoot{
-				ExitRoots: []common.Hash{
-					ger.ExitRoots[0],
-					ger.ExitRoots[1],
-				}}, 1, 1, 1, tm.rollupID,
-			tm.auth)
-		if err != nil {
-			log.Errorf("error BuildSendClaimXLayer tx for deposit %d. Error: %v", deposit.DepositCount, err)
-			tm.rollbackStore(dbTx)
-			return err
-		}
-		if err = tm.addClaimTxXLayer(deposit.DepositCount, tm.auth.From, tx.To(), nil, tx.Data(), dbTx); err != nil {
-			log.Errorf("error adding claim tx for deposit %d. Error: %v", deposit.DepositCount, err)
-			tm.rollbackStore(dbTx)
-			return err
-		}
 
 		// There can be cases that the deposit can be ready for claim (and even claimed) before it reached 64 block confirmations
 		// (for example, in devnet where the block confirmations required is lower)
@@ -254,6 +313,14 @@ func (tm *ClaimTxManager) processDepositStatusL1(newGer *etherman.GlobalExitRoot
 			return err
 		}
 
+		// Notify FE that tx is pending auto claim
+		err = tm.addPushMessage(deposit, uint32(pb.TransactionStatus_TX_PENDING_AUTO_CLAIM), dbTx)
+		if err != nil {
+			log.Errorf("error adding the push message of deposit %d. Error: %v", deposit.DepositCount, err)
+			tm.rollbackStore(dbTx)
+			return err
+		}
+
 		err = tm.storage.Commit(tm.ctx, dbTx)
 		if err != nil {
 			log.Errorf("AddClaimTx committing dbTx. Err: %v", err)
@@ -267,14 +334,34 @@ func (tm *ClaimTxManager) processDepositStatusL1(newGer *etherman.GlobalExitRoot
 		}
 		log.Infof("add claim tx for the deposit %d blockID %d successfully", deposit.DepositCount, deposit.BlockID)
 
-		// Notify FE that tx is pending auto claim
-		go tm.pushTransactionUpdate(deposit, uint32(pb.TransactionStatus_TX_PENDING_AUTO_CLAIM))
 		// Record order waiting time metric
 		metrics.RecordOrderWaitTime(uint32(deposit.NetworkID), uint32(deposit.DestinationNetwork), time.Since(deposit.Time))
 	}
 	return nil
 }
 
+// getClaim returns the claim on the network of this manager of the deposit made on depositNetworkID. The claims are
+// looked up by their source, since the deposit counts of the different source networks overlap
+func (tm *ClaimTxManager) getClaim(ctx context.Context, depositCount uint, dbTx pgx.Tx) (*etherman.Claim, error) {
+	mainnetFlag := tm.depositNetworkID == 0
+	var rollupIndex uint
+	if !mainnetFlag {
+		rollupIndex = tm.depositNetworkID - 1
+	}
+	return tm.storage.GetClaimBySource(ctx, depositCount, tm.l2NetworkID, mainnetFlag, rollupIndex, dbTx)
+}
+
+// getClaimTxHash returns the tx hash of the claim of the deposit, empty if it's not claimed yet
+func (tm *ClaimTxManager) getClaimTxHash(ctx context.Context, depositCount uint, dbTx pgx.Tx) (string, error) {
+	claim, err := tm.getClaim(ctx, depositCount, dbTx)
+	if errors.Is(err, gerror.ErrStorageNotFound) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+	return claim.TxHash.String(), nil
+}
+
 func (tm *ClaimTxManager) rollbackStore(dbTx pgx.Tx) {
 	rollbackErr := tm.storage.Rollback(tm.ctx, dbTx)
 	if rollbackErr != nil {
@@ -290,17 +377,25 @@ func (tm *ClaimTxManager) processDepositStatusXLayer(ger *etherman.GlobalExitRoo
 			log.Errorf("error getting and updating L2DepositsStatus. Error: %v", err)
 			return err
 		}
+		autoClaimed, err := tm.addL1ClaimTxs(deposits, dbTx)
+		if err != nil {
+			log.Errorf("error adding L1 claim txs. Error: %v", err)
+			return err
+		}
 		log.Debugf("begin send deposits for l1 ready_claim, blockId: %v, blockNumber: %v, deposit size: %v", ger.BlockID, ger.BlockNumber,
 			len(deposits))
 		for _, deposit := range deposits {
-			// Notify FE that tx is pending auto claim
-			go tm.pushTransactionUpdate(deposit, uint32(pb.TransactionStatus_TX_PENDING_USER_CLAIM))
+			// Notify FE that tx is pending user claim, or auto claim if it is claimed on L1 by the L1 claimer
+			if err = tm.addPushMessage(deposit, l2DepositReadyStatus(autoClaimed[deposit.DepositCount]), dbTx); err != nil {
+				log.Errorf("error adding the push message of deposit %d. Error: %v", deposit.DepositCount, err)
+				return err
+			}
 			// Record order waiting time metric
 			metrics.RecordOrderWaitTime(uint32(deposit.NetworkID), uint32(deposit.DestinationNetwork), time.Since(deposit.Time))
 		}
 	} else { // L1 exit root is updated in the trusted state
 		log.Infof("Mainnet exitroot %v is updated", ger.ExitRoots[0])
-		deposits, err := tm.storage.UpdateL1DepositsStatusXLayer(tm.ctx, ger.ExitRoots[0][:], dbTx)
+		deposits, err := tm.storage.UpdateL1DepositsStatusXLayer(tm.ctx, ger.ExitRoots[0][:], tm.l2NetworkID, dbTx)
 		if err != nil {
 			log.Errorf("error getting and updating L1DepositsStatus. Error: %v", err)
 			return err
@@ -312,7 +407,7 @@ func (tm *ClaimTxManager) processDepositStatusXLayer(ger *etherman.GlobalExitRoo
 				continue
 			}
 
-			claimHash, err := tm.bridgeService.GetDepositStatus(tm.ctx, deposit.DepositCount, deposit.DestinationNetwork)
+			claimHash, err := tm.getClaimTxHash(tm.ctx, deposit.DepositCount, dbTx)
 			if err != nil {
 				log.Errorf("error getting deposit status for deposit %d. Error: %v", deposit.DepositCount, err)
 				return err
@@ -321,38 +416,17 @@ func (tm *ClaimTxManager) processDepositStatusXLayer(ger *etherman.GlobalExitRoo
 				log.Infof("Ignoring deposit: %d, leafType: %d, claimHash: %s, deposit.OriginalAddress: %s", deposit.DepositCount, deposit.LeafType, claimHash, deposit.OriginalAddress.String())
 				continue
 			}
-			log.Infof("create the claim tx for the deposit %d", deposit.DepositCount)
-			ger, proof, rollupProof, err := tm.bridgeService.GetClaimProof(deposit.DepositCount, deposit.NetworkID, dbTx)
+			rejected, err := tm.isRejectedByPolicy(deposit, dbTx)
 			if err != nil {
-				log.Errorf("error getting Claim Proof for deposit %d. Error: %v", deposit.DepositCount, err)
 				return err
 			}
-			log.Debugf("get claim proof done for the deposit %d", deposit.DepositCount)
-			var (
-				mtProof       [mtHeight][keyLen]byte
-				mtRollupProof [mtHeight][keyLen]byte
-			)
-			for i := 0; i < mtHeight; i++ {
-				mtProof[i] = proof[i]
-				mtRollupProof[i] = rollupProof[i]
-			}
-			tx, err := tm.l2Node.BuildSendClaimXLayer(tm.ctx, deposit, mtProof, mtRollupProof,
-				&etherman.GlobalExitRoot{
-					ExitRoots: []common.Hash{
-						ger.ExitRoots[0],
-						ger.ExitRoots[1],
-					}}, 1, 1, 1, tm.rollupID,
-				tm.auth)
-			if err != nil {
-				log.Errorf("error BuildSendClaimXLayer tx for deposit %d. Error: %v", deposit.DepositCount, err)
-				return err
+			if rejected {
+				continue
 			}
-			log.Debugf("claimTx for deposit %d build successfully %d", deposit.DepositCount)
-			if err = tm.addClaimTxXLayer(deposit.DepositCount, tm.auth.From, tx.To(), nil, tx.Data(), dbTx); err != nil {
-				log.Errorf("error adding claim tx for deposit %d. Error: %v", deposit.DepositCount, err)
+			err = tm.createClaimTxXLayer(deposit, dbTx)
+			if err != nil {
 				return err
 			}
-			log.Debugf("claimTx for deposit %d save successfully %d", deposit.DepositCount)
 
 			// There can be cases that the deposit can be ready for claim (and even claimed) before it reached 64 block confirmations
 			// (for example, in devnet where the block confirmations required is lower)
@@ -365,7 +439,10 @@ func (tm *ClaimTxManager) processDepositStatusXLayer(ger *etherman.GlobalExitRoo
 			}
 
 			// Notify FE that tx is pending auto claim
-			go tm.pushTransactionUpdate(deposit, uint32(pb.TransactionStatus_TX_PENDING_AUTO_CLAIM))
+			if err = tm.addPushMessage(deposit, uint32(pb.TransactionStatus_TX_PENDING_AUTO_CLAIM), dbTx); err != nil {
+				log.Errorf("error adding the push message of deposit %d. Error: %v", deposit.DepositCount, err)
+				return err
+			}
 			// Record order waiting time metric
 			metrics.RecordOrderWaitTime(uint32(deposit.NetworkID), uint32(deposit.DestinationNetwork), time.Since(deposit.Time))
 		}
@@ -373,6 +450,76 @@ func (tm *ClaimTxManager) processDepositStatusXLayer(ger *etherman.GlobalExitRoo
 	return nil
 }
 
+// createClaimTxXLayer builds the claim tx of the deposit with its proof and adds it to the monitored txs. While
+// all the signers are draining, the claim is added without signer and the monitor assigns it to the first active one
+func (tm *ClaimTxManager) createClaimTxXLayer(deposit *etherman.Deposit, dbTx pgx.Tx) error {
+	log.Infof("create the claim tx for the deposit %d", deposit.DepositCount)
+	ger, proof, rollupProof, err := tm.bridgeService.GetClaimProof(deposit.DepositCount, deposit.NetworkID, dbTx)
+	if err != nil {
+		log.Errorf("error getting Claim Proof for deposit %d. Error: %v", deposit.DepositCount, err)
+		return err
+	}
+	log.Debugf("get claim proof done for the deposit %d", deposit.DepositCount)
+	var (
+		mtProof       [mtHeight][keyLen]byte
+		mtRollupProof [mtHeight][keyLen]byte
+	)
+	for i := 0; i < mtHeight; i++ {
+		mtProof[i] = proof[i]
+		mtRollupProof[i] = rollupProof[i]
+	}
+	signer, err := tm.signers.pick()
+	unassigned := errors.Is(err, ErrNoActiveSigner)
+	if unassigned {
+		log.Warnf("all the claim signers are draining, the claim tx of deposit %d waits for an active one", deposit.DepositCount)
+		// the tx is only built to get its data, it's never sent by the draining signer
+		signer = tm.signers.first()
+	} else if err != nil {
+		log.Errorf("error picking the claim signer for deposit %d. Error: %v", deposit.DepositCount, err)
+		return err
+	}
+	tx, err := tm.l2Node.BuildSendClaimXLayer(tm.ctx, deposit, mtProof, mtRollupProof,
+		&etherman.GlobalExitRoot{
+			ExitRoots: []common.Hash{
+				ger.ExitRoots[0],
+				ger.ExitRoots[1],
+			}}, 1, 1, 1, tm.rollupID,
+		signer.auth)
+	if err != nil {
+		log.Errorf("error BuildSendClaimXLayer tx for deposit %d. Error: %v", deposit.DepositCount, err)
+		return err
+	}
+	log.Debugf("claimTx for deposit %d build successfully", deposit.DepositCount)
+	from := signer.auth.From
+	if unassigned {
+		from = common.Address{}
+	}
+	if err = tm.addClaimTxXLayer(deposit.DepositCount, from, tx.To(), nil, tx.Data(), dbTx); err != nil {
+		log.Errorf("error adding claim tx for deposit %d. Error: %v", deposit.DepositCount, err)
+		return err
+	}
+	log.Debugf("claimTx for deposit %d save successfully", deposit.DepositCount)
+	return nil
+}
+
+// moveToActiveSigner assigns the monitored tx to an active signer, for the claims added while all the signers
+// were draining and the ones of a signer removed from the pool. A tx that was sent is claimed again from scratch
+// with the nonce of the new signer: if a previous attempt is still mined, the claim is found and it's confirmed
+func (tm *ClaimTxManager) moveToActiveSigner(ctx context.Context, mTx *ctmtypes.MonitoredTx, dbTx pgx.Tx) (*claimSigner, error) {
+	signer, err := tm.signers.pick()
+	if err != nil {
+		return nil, err
+	}
+	if len(mTx.History) > 0 {
+		mTx.Reset()
+	}
+	mTx.From = signer.auth.From
+	if err = tm.storage.UpdateClaimTx(ctx, *mTx, dbTx); err != nil {
+		return nil, errors.Wrap(err, "failed to update the signer of the monitored tx")
+	}
+	return signer, nil
+}
+
 func (tm *ClaimTxManager) addClaimTxXLayer(depositCount uint, from common.Address, to *common.Address, value *big.Int, data []byte, dbTx pgx.Tx) error {
 	// get gas
 	tx := ethereum.CallMsg{
@@ -389,13 +536,14 @@ func (tm *ClaimTxManager) addClaimTxXLayer(depositCount uint, from common.Addres
 		gas, err = tm.l2Node.EstimateGas(tm.ctx, tx)
 	}
 	if err != nil {
-		log.Errorf("failed to estimate gas. Ignoring tx... Error: %v, data: %s", err, common.Bytes2Hex(data))
+		reason, _ := decodeRevertError(err)
+		log.Errorf("failed to estimate gas. Ignoring tx... Error: %v, revert reason: %s, data: %s", err, reason, common.Bytes2Hex(data))
 		return nil
 	}
 
 	// create monitored tx
 	mTx := ctmtypes.MonitoredTx{
-		DepositID: depositCount, From: from, To: to,
+		DepositID: depositCount, NetworkID: tm.l2NetworkID, From: from, To: to,
 		Value: value, Data: data,
 		Gas: gas, Status: ctmtypes.MonitoredTxStatusCreated,
 	}
@@ -415,15 +563,28 @@ func (tm *ClaimTxManager) addClaimTxXLayer(depositCount uint, from common.Addres
 // monitorTxsXLayer process all pending monitored tx
 func (tm *ClaimTxManager) monitorTxsXLayer(ctx context.Context) error {
 	mLog := log.WithFields(utils.TraceID, ctx.Value(utils.CtxTraceID))
+	if !tm.isLeader() {
+		mLog.Info("this replica is not the leader, the monitored txs are not processed")
+		return nil
+	}
 
 	dbTx, err := tm.storage.BeginDBTransaction(ctx)
 	if err != nil {
 		return err
 	}
+	if err = tm.checkFencingToken(ctx, dbTx); err != nil {
+		tm.rollbackStore(dbTx)
+		return err
+	}
 	mLog.Infof("monitorTxs begin")
 
+	err = tm.processOperationsXLayer(ctx, dbTx)
+	if err != nil {
+		mLog.Errorf("failed to process the monitored tx operations: %v", err)
+	}
+
 	statusesFilter := []ctmtypes.MonitoredTxStatus{ctmtypes.MonitoredTxStatusCreated}
-	mTxs, err := tm.storage.GetClaimTxsByStatusWithLimit(ctx, statusesFilter, tm.monitorTxsLimit.Get(), 0, dbTx)
+	mTxs, err := tm.storage.GetClaimTxsByStatusAndNetwork(ctx, statusesFilter, tm.l2NetworkID, tm.monitorTxsLimit.Get(), 0, dbTx)
 	if err != nil {
 		mLog.Errorf("failed to get created monitored txs: %v", err)
 		rollbackErr := tm.storage.Rollback(tm.ctx, dbTx)
@@ -436,13 +597,34 @@ func (tm *ClaimTxManager) monitorTxsXLayer(ctx context.Context) error {
 	mLog.Infof("found %v monitored tx to process", len(mTxs))
 	metrics.RecordPendingMonitoredTxsCount(len(mTxs))
 
-	isResetNonce := false // it will reset the nonce in one cycle
+	isResetNonce := make(map[common.Address]bool) // it will reset the nonce of each signer in one cycle
+	pendingBySigner := make(map[common.Address]int)
 	for _, mTx := range mTxs {
 		mTx := mTx // force variable shadowing to avoid pointer conflicts
+		// a replica that is not the leader anymore must not send claims, another one is sending them
+		if !tm.isLeader() {
+			mLog.Warn("this replica is not the leader anymore, stop processing the monitored txs")
+			break
+		}
 		mTxLog := mLog.WithFields("monitoredTx", mTx.DepositID)
 		mTxLog.Infof("processing tx with nonce %d", mTx.Nonce)
+		pendingBySigner[mTx.From]++
+		signer := tm.signers.get(mTx.From)
+		if signer == nil {
+			signer, err = tm.moveToActiveSigner(ctx, &mTx, dbTx)
+			if err != nil {
+				mTxLog.Warnf("claim signer %s is not in the pool, the tx waits for an active signer: %v", mTx.From.String(), err)
+				continue
+			}
+			mTxLog.Infof("tx moved to the claim signer %s", mTx.From.String())
+		}
+		// the claims that were never sent wait until the signer is funded again
+		if len(mTx.History) == 0 && tm.signers.isUnderfunded(signer) {
+			mTxLog.Warnf("claim signer %s is underfunded, the tx is not sent yet", mTx.From.String())
+			continue
+		}
 		// Check the claim table to see whether the transaction has already been claimed by some other methods
-		_, err = tm.storage.GetClaim(ctx, mTx.DepositID, tm.l2NetworkID, dbTx)
+		_, err = tm.getClaim(ctx, mTx.DepositID, dbTx)
 		if err != nil && err != gerror.ErrStorageNotFound {
 			mTxLog.Errorf("failed to get claim tx: %v", err)
 			return err
@@ -459,10 +641,11 @@ func (tm *ClaimTxManager) monitorTxsXLayer(ctx context.Context) error {
 			metrics.RecordAutoClaimDuration(time.Since(mTx.CreatedAt))
 			continue
 		}
+		neverSent := len(mTx.History) == 0
 
 		// check if any of the txs in the history was mined
 		mined := false
-		var receipt *types.Receipt
+		var receipt, failedReceipt *types.Receipt
 		hasFailedReceipts := false
 		allHistoryTxMined := true
 		receiptSuccessful := false
@@ -477,6 +660,11 @@ func (tm *ClaimTxManager) monitorTxsXLayer(ctx context.Context) error {
 
 			// if the tx is not mined yet, check that not all the tx were mined and go to the next
 			if !mined {
+				// the txs replaced by a higher priced one are not expected to be found in the pool
+				if mTx.IsReplaced(txHash) {
+					mTxLog.Infof("tx %s was replaced by %s", txHash.String(), mTx.LastTxHash.String())
+					continue
+				}
 				// check if the tx is in the pending pool
 				_, _, err = tm.l2Node.TransactionByHash(ctx, txHash)
 				if err != nil {
@@ -521,36 +709,33 @@ func (tm *ClaimTxManager) monitorTxsXLayer(ctx context.Context) error {
 			// and store the failed receipt to be used to check if nonce needs to be reviewed
 			mined = false
 			hasFailedReceipts = true
+			failedReceipt = receipt
 		}
 
 		if receiptSuccessful {
 			continue
 		}
 
-		// if the history size reaches the max history size, this means something is really wrong with
-		// this Tx and we are not able to identify automatically, so we mark this as failed to let the
-		// caller know something is not right and needs to be review and to avoid to monitor this
-		// tx infinitely
-		if allHistoryTxMined && len(mTx.History) >= maxHistorySize {
-			mTx.Status = ctmtypes.MonitoredTxStatusFailed
-			mTxLog.Infof("marked as failed because reached the history size limit (%d)", maxHistorySize)
-			// update monitored tx changes into storage
-			err = tm.storage.UpdateClaimTx(ctx, mTx, dbTx)
+		// if there are txs still pending in the pool, the last one can be replaced by a higher
+		// priced one to avoid keeping the claim in the pool forever
+		if !allHistoryTxMined {
+			err = tm.escalateMonitoredTx(ctx, signer, &mTx, dbTx)
 			if err != nil {
-				mTxLog.Errorf("failed to update monitored tx when max history size limit reached: %v", err)
+				mTxLog.Errorf("failed to escalate monitored tx: %v", err)
 			}
-			metrics.RecordMonitoredTxsResult(string(mTx.Status))
+			continue
+		}
 
-			// Notify FE that tx is pending user claim
-			go func() {
-				// Retrieve L1 transaction info
-				deposit, err := tm.storage.GetDeposit(ctx, mTx.DepositID, 0, nil)
-				if err != nil {
-					mTxLog.Errorf("push message: GetDeposit error: %v", err)
-					return
-				}
-				tm.pushTransactionUpdate(deposit, uint32(pb.TransactionStatus_TX_PENDING_USER_CLAIM))
-			}()
+		// if the history size reaches the max history size, this means something is really wrong with
+		// this Tx and we are not able to identify automatically, so we move it to the dead-letter status
+		// with the revert reason to let the operators review it and to avoid to monitor this tx infinitely
+		if allHistoryTxMined && len(mTx.History) >= maxHistorySize {
+			reason := tm.decodeReceiptRevert(ctx, mTx, failedReceipt)
+			if reason == "" {
+				reason = fmt.Sprintf("reached the history size limit (%d)", maxHistorySize)
+			}
+			mTxLog.Infof("moved to dead-letter because reached the history size limit (%d), revert reason: %s", maxHistorySize, reason)
+			tm.moveToDeadLetter(ctx, &mTx, reason, dbTx)
 			continue
 		}
 
@@ -562,7 +747,14 @@ func (tm *ClaimTxManager) monitorTxsXLayer(ctx context.Context) error {
 			// in case of all tx were mined and none of them were mined successfully, we need to
 			// review the tx information
 			if hasFailedReceipts {
-				mTxLog.Infof("monitored tx needs to be updated")
+				mTx.RevertReason = tm.decodeReceiptRevert(ctx, mTx, failedReceipt)
+				mTxLog.Infof("monitored tx needs to be updated, revert reason: %s", mTx.RevertReason)
+			}
+			// the claim is simulated before signing it, so no gas is spent on a claim that would revert
+			if tm.cfg.SimulateClaims && !tm.preflightMonitoredTx(ctx, &mTx, dbTx) {
+				continue
+			}
+			if hasFailedReceipts {
 				err := tm.ReviewMonitoredTxXLayer(ctx, &mTx)
 				if err != nil {
 					mTxLog.Errorf("failed to review monitored tx: %v", err)
@@ -570,26 +762,45 @@ func (tm *ClaimTxManager) monitorTxsXLayer(ctx context.Context) error {
 				}
 			}
 
-			// GasPrice is set here to use always the proper and most accurate value right before sending it to L2
-			gasPrice := big.NewInt(0)
-			if !tm.cfg.FreeGas {
-				gasPrice, err = tm.l2Node.SuggestGasPrice(ctx)
+			prevNonce, prevGasPrice, prevGasTipCap, prevGasFeeCap := mTx.Nonce, mTx.GasPrice, mTx.GasTipCap, mTx.GasFeeCap
+			if tm.cfg.DynamicFee.Enabled {
+				// Fee caps are set here to use always the proper and most accurate values right before sending it to L2
+				mTx.GasTipCap, mTx.GasFeeCap, err = tm.suggestDynamicFee(ctx)
 				if err != nil {
-					mTxLog.Errorf("failed to get suggested gasPrice. Error: %v", err)
+					mTxLog.Errorf("failed to get suggested dynamic fee. Error: %v", err)
 					continue
 				}
-			}
+				mTx.GasPrice = nil
+				mTxLog.Infof("Using gasTipCap: %s, gasFeeCap: %s", mTx.GasTipCap.String(), mTx.GasFeeCap.String())
+			} else {
+				// GasPrice is set here to use always the proper and most accurate value right before sending it to L2
+				gasPrice := big.NewInt(0)
+				if !tm.cfg.FreeGas {
+					gasPrice, err = tm.l2Node.SuggestGasPrice(ctx)
+					if err != nil {
+						mTxLog.Errorf("failed to get suggested gasPrice. Error: %v", err)
+						continue
+					}
+				}
 
-			//Multiply gasPrice by 10 to increase the efficiency of the tx in the sequence
-			mTx.GasPrice = big.NewInt(0).Mul(gasPrice, big.NewInt(10)) //nolint:gomnd
-			mTxLog.Infof("Using gasPrice: %s. The gasPrice suggested by the network is %s", mTx.GasPrice.String(), gasPrice.String())
+				mTx.GasPrice = gasPrice
+				if tm.l2NetworkID != 0 {
+					//Multiply gasPrice by 10 to increase the efficiency of the tx in the sequence
+					mTx.GasPrice = big.NewInt(0).Mul(gasPrice, big.NewInt(10)) //nolint:gomnd
+				}
+				mTx.GasTipCap, mTx.GasFeeCap = nil, nil
+				mTxLog.Infof("Using gasPrice: %s. The gasPrice suggested by the network is %s", mTx.GasPrice.String(), gasPrice.String())
+			}
 
 			// Calculate nonce before signing
-			err = tm.setTxNonce(&mTx)
+			err = tm.setTxNonce(signer, &mTx)
 			if err != nil {
 				mTxLog.Errorf("failed to set tx nonce: %v", err)
 				continue
 			}
+			if mTx.IsDynamicFee() {
+				tm.applyDynamicFeeBump(&mTx, prevNonce, prevGasPrice, prevGasTipCap, prevGasFeeCap)
+			}
 
 			// rebuild transaction
 			tx := mTx.Tx()
@@ -597,7 +808,7 @@ func (tm *ClaimTxManager) monitorTxsXLayer(ctx context.Context) error {
 
 			var signedTx *types.Transaction
 			// sign tx
-			signedTx, err = tm.auth.Signer(mTx.From, tx)
+			signedTx, err = signer.auth.Signer(mTx.From, tx)
 			if err != nil {
 				mTxLog.Errorf("failed to sign tx %v created from monitored tx: %v", tx.Hash().String(), err)
 				continue
@@ -616,32 +827,59 @@ func (tm *ClaimTxManager) monitorTxsXLayer(ctx context.Context) error {
 			// check if the tx is already in the network, if not, send it
 			_, _, err = tm.l2Node.TransactionByHash(ctx, signedTx.Hash())
 			if errors.Is(err, ethereum.NotFound) {
+				// the claims that were never sent are deferred while their rate limits are exhausted, the
+				// units are taken right before sending the tx and given back if it can't be sent
+				var rateLimits []claimRateLimit
+				if neverSent {
+					var scope string
+					rateLimits, scope, err = tm.acquireClaimRateLimits(ctx, mTx, dbTx)
+					if err != nil || scope != "" {
+						if err != nil {
+							mTxLog.Errorf("failed to check the rate limits, the tx is not sent yet: %v", err)
+						} else {
+							mTxLog.Infof("%s rate limit reached, the tx is deferred", scope)
+						}
+						tm.decreaseNonceCache(signer, mTx.From)
+						mTx.RemoveHistory(signedTx)
+						continue
+					}
+				}
 				err := tm.l2Node.SendTransaction(ctx, signedTx)
 				if err != nil {
 					mTxLog.Errorf("failed to send tx %s to network: %v", signedTx.Hash().String(), err)
+					tm.releaseClaimRateLimits(ctx, rateLimits)
 					if err.Error() == pool.ErrNonceTooLow.Error() {
 						mTxLog.Infof("nonce error detected, Nonce used: %d", signedTx.Nonce())
-						if !isResetNonce {
-							isResetNonce = true
+						if !isResetNonce[mTx.From] {
+							isResetNonce[mTx.From] = true
 							tm.nonceCache.Remove(mTx.From.Hex())
 							mTxLog.Infof("nonce cache cleared for address %v", mTx.From.Hex())
 						}
 					}
 					if err.Error() == pool.ErrNonceTooHigh.Error() {
 						mTxLog.Infof("nonce error detected, Nonce used: %d", signedTx.Nonce())
-						if !isResetNonce {
-							isResetNonce = true
+						if !isResetNonce[mTx.From] {
+							isResetNonce[mTx.From] = true
 							tm.nonceCache.Remove(mTx.From.Hex())
 							mTxLog.Infof("nonce cache cleared for address %v", mTx.From.Hex())
 						}
 					}
-					tm.decreaseNonceCache(mTx.From)
+					tm.decreaseNonceCache(signer, mTx.From)
 					mTx.RemoveHistory(signedTx)
 					// we should rebuild the monitored tx to fix the nonce
 					err := tm.ReviewMonitoredTxXLayer(ctx, &mTx)
 					if err != nil {
 						mTxLog.Errorf("failed to review monitored tx: %v", err)
 					}
+				} else {
+					blockNumber, err := tm.l2Node.BlockNumber(ctx)
+					if err != nil {
+						mTxLog.Warnf("failed to get the block number: %v", err)
+					}
+					err = tm.markTxSent(ctx, &mTx, signedTx, blockNumber, false, dbTx)
+					if err != nil {
+						mTxLog.Errorf("failed to mark tx %s as sent: %v", signedTx.Hash().String(), err)
+					}
 				}
 			} else if err != nil && !errors.Is(err, ethereum.NotFound) {
 				mTxLog.Error("unexpected error getting TransactionByHash. Error: ", err)
@@ -659,6 +897,10 @@ func (tm *ClaimTxManager) monitorTxsXLayer(ctx context.Context) error {
 		}
 	}
 	mLog.Infof("monitorTxs end")
+	// the pending count of each signer is only complete if all the pending txs were loaded
+	if uint(len(mTxs)) < tm.monitorTxsLimit.Get() {
+		tm.signers.checkDrained(pendingBySigner)
+	}
 
 	err = tm.storage.Commit(tm.ctx, dbTx)
 	if err != nil {
@@ -676,7 +918,9 @@ func (tm *ClaimTxManager) monitorTxsXLayer(ctx context.Context) error {
 }
 
 // setTxNonce get the next nonce from the nonce cache and set it to the tx
-func (tm *ClaimTxManager) setTxNonce(mTx *ctmtypes.MonitoredTx) error {
+func (tm *ClaimTxManager) setTxNonce(signer *claimSigner, mTx *ctmtypes.MonitoredTx) error {
+	signer.nonceMutex.Lock()
+	defer signer.nonceMutex.Unlock()
 	nonce, err := tm.getNextNonce(mTx.From)
 	if err != nil {
 		return errors.Wrap(err, "getNextNonce err")
@@ -686,7 +930,9 @@ func (tm *ClaimTxManager) setTxNonce(mTx *ctmtypes.MonitoredTx) error {
 }
 
 // decreaseNonceCache decreases the nonce value stored in the local cache
-func (tm *ClaimTxManager) decreaseNonceCache(from common.Address) {
+func (tm *ClaimTxManager) decreaseNonceCache(signer *claimSigner, from common.Address) {
+	signer.nonceMutex.Lock()
+	defer signer.nonceMutex.Unlock()
 	nonce, err := tm.l2Node.NonceAt(tm.ctx, from, nil)
 	if err != nil {
 		return
@@ -701,21 +947,21 @@ func (tm *ClaimTxManager) decreaseNonceCache(from common.Address) {
 	}
 }
 
-// Push message to FE to notify about tx status change
-func (tm *ClaimTxManager) pushTransactionUpdate(deposit *etherman.Deposit, status uint32) {
+// addPushMessage stores the tx status change in the db tx, it's pushed to FE by the outbox relay once committed
+func (tm *ClaimTxManager) addPushMessage(deposit *etherman.Deposit, status uint32, dbTx pgx.Tx) error {
 	if tm.messagePushProducer == nil {
 		log.Errorf("kafka push producer is nil, so can't push tx status change msg!")
-		return
+		return nil
 	}
 	if deposit.LeafType != uint8(utils.LeafTypeAsset) && !tm.isDepositMessageAllowed(deposit) {
 		log.Infof("transaction is not asset, so skip push update change, hash: %v", deposit.TxHash)
-		return
+		return nil
 	}
 	estimateTime := uint64(0)
 	if deposit.NetworkID != 0 {
 		estimateTime = pushtask.GetAvgVerifyDuration(tm.ctx, tm.redisStorage)
 	}
-	err := tm.messagePushProducer.PushTransactionUpdate(&pb.Transaction{
+	msg, err := pushoutbox.NewMessage(&pb.Transaction{
 		FromChain:    uint32(deposit.NetworkID),
 		ToChain:      uint32(deposit.DestinationNetwork),
 		TxHash:       deposit.TxHash.String(),
@@ -724,10 +970,11 @@ func (tm *ClaimTxManager) pushTransactionUpdate(deposit *etherman.Deposit, statu
 		DestAddr:     deposit.DestinationAddress.Hex(),
 		EstimateTime: uint32(estimateTime),
 		GlobalIndex:  etherman.GenerateGlobalIndex(false, tm.rollupID-1, deposit.DepositCount).String(),
-	})
+	}, deposit.BlockID)
 	if err != nil {
-		log.Errorf("PushTransactionUpdate error: %v", err)
+		return err
 	}
+	return tm.storage.AddPushOutboxMessage(tm.ctx, msg, dbTx)
 }
 
 // ReviewMonitoredTxXLayer checks if tx needs to be updated
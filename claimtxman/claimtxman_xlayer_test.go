@@ -0,0 +1,225 @@
+package claimtxman
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl"
+	ctmtypes "github.com/0xPolygonHermez/zkevm-bridge-service/claimtxman/types"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/config/apolloconfig"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/redisstorage"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeStorage keeps the L1 deposits and the claim txs of the claim tx managers in memory
+type fakeStorage struct {
+	storageInterface
+
+	mu       sync.Mutex
+	deposits []*etherman.Deposit
+	claims   []*etherman.Claim
+	claimTxs []ctmtypes.MonitoredTx
+}
+
+func (s *fakeStorage) GetClaimBySource(ctx context.Context, depositCount, networkID uint, mainnetFlag bool, rollupIndex uint, dbTx pgx.Tx) (*etherman.Claim, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, claim := range s.claims {
+		if claim.Index == depositCount && claim.NetworkID == networkID && claim.MainnetFlag == mainnetFlag && claim.RollupIndex == uint64(rollupIndex) {
+			return claim, nil
+		}
+	}
+	return nil, gerror.ErrStorageNotFound
+}
+
+func (s *fakeStorage) UpdateL1DepositsStatusXLayer(ctx context.Context, exitRoot []byte, destNetwork uint, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var updated []*etherman.Deposit
+	for _, deposit := range s.deposits {
+		if deposit.DestinationNetwork == destNetwork && !deposit.ReadyForClaim {
+			deposit.ReadyForClaim = true
+			updated = append(updated, deposit)
+		}
+	}
+	return updated, nil
+}
+
+func (s *fakeStorage) AddClaimTx(ctx context.Context, mTx ctmtypes.MonitoredTx, dbTx pgx.Tx) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.claimTxs = append(s.claimTxs, mTx)
+	return nil
+}
+
+func (s *fakeStorage) UpdateClaimTx(ctx context.Context, mTx ctmtypes.MonitoredTx, dbTx pgx.Tx) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.claimTxs {
+		if s.claimTxs[i].DepositID == mTx.DepositID && s.claimTxs[i].NetworkID == mTx.NetworkID {
+			s.claimTxs[i] = mTx
+			return nil
+		}
+	}
+	return gerror.ErrStorageNotFound
+}
+
+// claimTxsOf returns the deposit counts of the claim txs of the network
+func (s *fakeStorage) claimTxsOf(networkID uint) []uint {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var depositCounts []uint
+	for _, mTx := range s.claimTxs {
+		if mTx.NetworkID == networkID {
+			depositCounts = append(depositCounts, mTx.DepositID)
+		}
+	}
+	return depositCounts
+}
+
+type fakeBridgeService struct{}
+
+func (fakeBridgeService) GetClaimProof(depositCnt, networkID uint, dbTx pgx.Tx) (*etherman.GlobalExitRoot, [][bridgectrl.KeyLen]byte, [][bridgectrl.KeyLen]byte, error) {
+	ger := &etherman.GlobalExitRoot{ExitRoots: []common.Hash{{}, {}}}
+	return ger, make([][bridgectrl.KeyLen]byte, mtHeight), make([][bridgectrl.KeyLen]byte, mtHeight), nil
+}
+
+func (fakeBridgeService) GetDepositStatus(ctx context.Context, depositCount uint, destNetworkID uint) (string, error) {
+	return "", nil
+}
+
+type fakeRedisStorage struct {
+	redisstorage.RedisStorage
+}
+
+func (fakeRedisStorage) DeleteBlockDeposit(ctx context.Context, deposit *etherman.Deposit) error {
+	return nil
+}
+
+// newFakeL2Node starts a node that estimates the gas of every tx
+func newFakeL2Node(t *testing.T) *utils.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, "eth_estimateGas", req.Method)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": "0x5208"})
+	}))
+	t.Cleanup(server.Close)
+	client, err := utils.NewClient(context.Background(), server.URL, common.HexToAddress("0x2a3DD3EB832aF982ec71669E178424b10Dca2EDe"))
+	require.NoError(t, err)
+	return client
+}
+
+func newTestClaimTxManager(t *testing.T, l2NetworkID uint, storage storageInterface) *ClaimTxManager {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	auth, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(1))
+	require.NoError(t, err)
+	return &ClaimTxManager{
+		ctx:           context.Background(),
+		l2Node:        newFakeL2Node(t),
+		l2NetworkID:   l2NetworkID,
+		rollupID:      l2NetworkID,
+		bridgeService: fakeBridgeService{},
+		storage:       storage,
+		redisStorage:  fakeRedisStorage{},
+		cfg:           Config{RetryNumber: 1},
+		policy:        apolloconfig.NewJSONEntry("test.policy", ClaimPolicy{}),
+		signers: &signerPool{
+			signers:  []*claimSigner{{auth: auth}},
+			draining: apolloconfig.NewStringSliceEntry("test.drainingSigners", nil),
+		},
+	}
+}
+
+func TestProcessL1DepositsMultipleNetworks(t *testing.T) {
+	storage := &fakeStorage{}
+	for i, destNetwork := range []uint{1, 2, 1} {
+		storage.deposits = append(storage.deposits, &etherman.Deposit{
+			LeafType:           uint8(utils.LeafTypeAsset),
+			Amount:             big.NewInt(1),
+			DestinationNetwork: destNetwork,
+			DepositCount:       uint(i),
+		})
+	}
+	managers := []*ClaimTxManager{newTestClaimTxManager(t, 2, storage), newTestClaimTxManager(t, 1, storage)}
+
+	// Every claim tx manager gets the trusted global exit root, and creates the claims of its own network
+	ger := &etherman.GlobalExitRoot{ExitRoots: []common.Hash{common.HexToHash("0x1"), {}}}
+	for _, tm := range managers {
+		require.NoError(t, tm.processDepositStatusXLayer(ger, nil))
+	}
+	assert.Equal(t, []uint{0, 2}, storage.claimTxsOf(1))
+	assert.Equal(t, []uint{1}, storage.claimTxsOf(2))
+}
+
+func TestAddL1ClaimTxs(t *testing.T) {
+	storage := &fakeStorage{
+		// the deposits of the rollups 1 and 2 with the same deposit count, the one of the rollup 2 is claimed on L1
+		claims: []*etherman.Claim{{Index: 1, NetworkID: 0, RollupIndex: 1}, {Index: 2, NetworkID: 0, RollupIndex: 0}},
+	}
+	tm := newTestClaimTxManager(t, 1, storage)
+	tm.cfg.L1Claim.LeafTypes = []uint8{uint8(utils.LeafTypeAsset)}
+	l1Claimer := newTestClaimTxManager(t, 0, storage)
+	l1Claimer.depositNetworkID, l1Claimer.rollupID = 1, 1
+	tm.SetL1Claimer(l1Claimer)
+
+	var deposits []*etherman.Deposit
+	for _, depositCount := range []uint{1, 2} {
+		deposits = append(deposits, &etherman.Deposit{
+			LeafType:     uint8(utils.LeafTypeAsset),
+			Amount:       big.NewInt(1),
+			NetworkID:    1,
+			DepositCount: depositCount,
+		})
+	}
+	autoClaimed, err := tm.addL1ClaimTxs(deposits, nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[uint]bool{1: true}, autoClaimed)
+	assert.Equal(t, []uint{1}, storage.claimTxsOf(0))
+}
+
+func TestClaimTxWithoutActiveSigner(t *testing.T) {
+	storage := &fakeStorage{
+		deposits: []*etherman.Deposit{{LeafType: uint8(utils.LeafTypeAsset), Amount: big.NewInt(1), DestinationNetwork: 1, DepositCount: 3}},
+	}
+	tm := newTestClaimTxManager(t, 1, storage)
+	signer := tm.signers.first().auth.From
+	tm.signers.draining = apolloconfig.NewStringSliceEntry("test.drainingSigners", addressesToStrings([]common.Address{signer}))
+
+	// all the signers are draining, the claim is kept without signer instead of being lost
+	ger := &etherman.GlobalExitRoot{ExitRoots: []common.Hash{common.HexToHash("0x1"), {}}}
+	require.NoError(t, tm.processDepositStatusXLayer(ger, nil))
+	require.Len(t, storage.claimTxs, 1)
+	mTx := storage.claimTxs[0]
+	assert.Equal(t, common.Address{}, mTx.From)
+
+	_, err := tm.moveToActiveSigner(context.Background(), &mTx, nil)
+	require.ErrorIs(t, err, ErrNoActiveSigner)
+	assert.Equal(t, common.Address{}, storage.claimTxs[0].From)
+
+	// the claim is moved to the signer once it's active again, the sent attempts of a removed signer are reset
+	tm.signers.draining = apolloconfig.NewStringSliceEntry("test.drainingSigners", nil)
+	mTx.History = map[common.Hash]bool{common.HexToHash("0x2"): true}
+	moved, err := tm.moveToActiveSigner(context.Background(), &mTx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, signer, moved.auth.From)
+	assert.Equal(t, signer, storage.claimTxs[0].From)
+	assert.Empty(t, storage.claimTxs[0].History)
+}
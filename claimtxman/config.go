@@ -26,4 +26,133 @@ type Config struct {
 	FreeGas bool `mapstructure:"FreeGas"`
 	// OptClaim enabled store claimTx into storage every deposit
 	OptClaim bool `mapstructure:"OptClaim"`
+	// PrivateKeys defines the pool of key store files used to sign the claim txs,
+	// each key has its own nonce lane. If it is empty, PrivateKey is used
+	PrivateKeys []types.KeystoreFileConfig `mapstructure:"PrivateKeys"`
+	// DrainingSigners are the addresses of the pool that must not get new claims,
+	// they only finish their pending txs so they can be removed from the pool
+	DrainingSigners []common.Address `mapstructure:"DrainingSigners"`
+	// DynamicFee is the EIP-1559 fee policy used to send the claim txs
+	DynamicFee DynamicFeeConfig `mapstructure:"DynamicFee"`
+	// Escalation is the policy to replace the claim txs stuck in the pool with higher priced ones
+	Escalation EscalationConfig `mapstructure:"Escalation"`
+	// BalanceMonitor is the configuration of the claim signers balance watcher
+	BalanceMonitor BalanceMonitorConfig `mapstructure:"BalanceMonitor"`
+	// SimulateClaims enabled the claims are executed with eth_call before being sent,
+	// the ones that would revert are not sent
+	SimulateClaims bool `mapstructure:"SimulateClaims"`
+	// L1Claim is the configuration of the auto-claim on L1 of the deposits bridged from the rollup
+	L1Claim L1ClaimConfig `mapstructure:"L1Claim"`
+	// Policy is the set of rules that decides which deposits are claimed automatically. It can be
+	// hot reloaded with the apollo key claimtxman.policy, as a JSON object
+	Policy ClaimPolicy `mapstructure:"Policy"`
+	// RateLimit is the configuration of the auto-claim rate limits. It can be hot reloaded with
+	// the apollo key claimtxman.rateLimit, as a JSON object
+	RateLimit RateLimitConfig `mapstructure:"RateLimit"`
+}
+
+// RateLimitConfig is the configuration of the auto-claim rate limits. The counters are kept in redis,
+// so the limits hold across replicas. The claims over the limits are deferred to a later time window
+type RateLimitConfig struct {
+	// Enabled whether to rate limit the claims
+	Enabled bool `mapstructure:"Enabled" json:"enabled"`
+	// Window is the time window of the per address limits
+	Window types.Duration `mapstructure:"Window" json:"window"`
+	// PerDestinationAddress is the max number of claims per destination address in a window, 0 means no limit
+	PerDestinationAddress uint64 `mapstructure:"PerDestinationAddress" json:"perDestinationAddress"`
+	// PerOriginAddress is the max number of claims per original token address in a window, 0 means no limit
+	PerOriginAddress uint64 `mapstructure:"PerOriginAddress" json:"perOriginAddress"`
+	// GlobalPerMinute is the max number of claims sent per minute, 0 means no limit
+	GlobalPerMinute uint64 `mapstructure:"GlobalPerMinute" json:"globalPerMinute"`
+}
+
+// ClaimPolicy is the set of rules evaluated to decide whether a deposit is claimed automatically.
+// A deposit is claimed only if it passes all the rules, an empty rule always passes
+type ClaimPolicy struct {
+	// Enabled whether to evaluate the policy
+	Enabled bool `mapstructure:"Enabled" json:"enabled"`
+	// LeafTypes are the leaf types (0 asset, 1 message) that are claimed, empty means all
+	LeafTypes []uint8 `mapstructure:"LeafTypes" json:"leafTypes"`
+	// TokenAllowlist are the original addresses of the tokens that are claimed, empty means all
+	TokenAllowlist []common.Address `mapstructure:"TokenAllowlist" json:"tokenAllowlist"`
+	// TokenDenylist are the original addresses of the tokens that are never claimed
+	TokenDenylist []common.Address `mapstructure:"TokenDenylist" json:"tokenDenylist"`
+	// DestinationDenylist are the destination addresses that are never claimed
+	DestinationDenylist []common.Address `mapstructure:"DestinationDenylist" json:"destinationDenylist"`
+	// MinUSDValue is the minimum value (USD) of the bridged tokens to claim a deposit, 0 disables the rule
+	MinUSDValue float64 `mapstructure:"MinUSDValue" json:"minUsdValue"`
+	// AllowUnpriced whether the deposits of tokens without price or decimals pass the MinUSDValue rule
+	AllowUnpriced bool `mapstructure:"AllowUnpriced" json:"allowUnpriced"`
+	// DailyQuotaPerAddress is the max number of claims per destination address in a UTC day, 0 means no limit
+	DailyQuotaPerAddress uint64 `mapstructure:"DailyQuotaPerAddress" json:"dailyQuotaPerAddress"`
+}
+
+// L1ClaimConfig is the configuration of the auto-claim on L1 of the deposits bridged from the rollup.
+// The L1 claims have their own signer and gas policy, the rest of the settings are shared with the L2 claims
+type L1ClaimConfig struct {
+	// Enabled whether to claim on L1 the deposits bridged from the rollup
+	Enabled bool `mapstructure:"Enabled"`
+	// FrequencyToMonitorTxs frequency of the resending failed L1 claim txs
+	FrequencyToMonitorTxs types.Duration `mapstructure:"FrequencyToMonitorTxs"`
+	// PrivateKey defines the key store file of the signer of the L1 claim txs
+	PrivateKey types.KeystoreFileConfig `mapstructure:"PrivateKey" apollo:"keystoreFileConfig"`
+	// DynamicFee is the EIP-1559 fee policy used to send the L1 claim txs
+	DynamicFee DynamicFeeConfig `mapstructure:"DynamicFee"`
+	// Escalation is the policy to replace the L1 claim txs stuck in the pool with higher priced ones
+	Escalation EscalationConfig `mapstructure:"Escalation"`
+	// LeafTypes are the leaf types (0 asset, 1 message) of the deposits claimed on L1
+	LeafTypes []uint8 `mapstructure:"LeafTypes"`
+	// Tokens are the original addresses of the tokens claimed on L1, empty means all the tokens
+	Tokens []common.Address `mapstructure:"Tokens"`
+	// Destinations are the destination addresses of the deposits claimed on L1, empty means all the addresses
+	Destinations []common.Address `mapstructure:"Destinations"`
+}
+
+// DynamicFeeConfig is the configuration of the EIP-1559 fee policy for the claim txs.
+// All the amounts are expressed in wei
+type DynamicFeeConfig struct {
+	// Enabled whether to send the claim txs as DynamicFeeTx instead of LegacyTx
+	Enabled bool `mapstructure:"Enabled"`
+	// GasTipCapMultiplier multiplies the priority fee suggested by the network
+	GasTipCapMultiplier uint64 `mapstructure:"GasTipCapMultiplier"`
+	// MinGasTipCap is the lowest priority fee used for a claim tx
+	MinGasTipCap uint64 `mapstructure:"MinGasTipCap"`
+	// MaxGasTipCap is the highest priority fee used for a claim tx, 0 means no limit
+	MaxGasTipCap uint64 `mapstructure:"MaxGasTipCap"`
+	// BaseFeeMultiplier multiplies the latest base fee to leave room for the base fee growth
+	// until the tx is mined
+	BaseFeeMultiplier uint64 `mapstructure:"BaseFeeMultiplier"`
+	// MaxGasFeeCap is the highest max fee per gas used for a claim tx, 0 means no limit
+	MaxGasFeeCap uint64 `mapstructure:"MaxGasFeeCap"`
+	// BumpPercentage is the minimum increase of the fee caps when a tx is replaced using the same nonce
+	BumpPercentage uint64 `mapstructure:"BumpPercentage"`
+}
+
+// EscalationConfig is the configuration of the gas price escalation for the pending claim txs.
+// A pending tx is replaced when any of the wait thresholds is reached
+type EscalationConfig struct {
+	// Enabled whether to replace the pending claim txs
+	Enabled bool `mapstructure:"Enabled"`
+	// BlocksToWait is the number of blocks to wait for a tx to be mined before replacing it, 0 disables it
+	BlocksToWait uint64 `mapstructure:"BlocksToWait"`
+	// TimeToWait is the time to wait for a tx to be mined before replacing it, 0 disables it
+	TimeToWait types.Duration `mapstructure:"TimeToWait"`
+	// BumpPercentage is the percentage the gas price (or the fee caps) is increased in each replacement
+	BumpPercentage uint64 `mapstructure:"BumpPercentage"`
+	// MaxGasPrice is the ceiling (wei) of the gas price (or the gas fee cap), 0 means no limit
+	MaxGasPrice uint64 `mapstructure:"MaxGasPrice"`
+	// MaxAttempts is the max number of replacements for a monitored tx, 0 means no limit
+	MaxAttempts uint64 `mapstructure:"MaxAttempts"`
+}
+
+// BalanceMonitorConfig is the configuration of the claim signers balance watcher
+type BalanceMonitorConfig struct {
+	// Enabled whether to check the balance of the claim signers
+	Enabled bool `mapstructure:"Enabled"`
+	// CheckInterval is the time between each balance check
+	CheckInterval types.Duration `mapstructure:"CheckInterval"`
+	// MinBalance is the balance (wei, as a decimal string) below which a signer stops getting new claims
+	MinBalance string `mapstructure:"MinBalance"`
+	// AlertTopic is the topic the alerts are sent to, the producer default topic is used if it is empty
+	AlertTopic string `mapstructure:"AlertTopic"`
 }
@@ -0,0 +1,101 @@
+package claimtxman
+
+import (
+	"context"
+	"math/big"
+
+	ctmtypes "github.com/0xPolygonHermez/zkevm-bridge-service/claimtxman/types"
+	"github.com/pkg/errors"
+)
+
+const percentBase = 100
+
+// suggestDynamicFee returns the gas tip cap and the gas fee cap that should be used
+// to send a claim tx according to the current state of the network and the configured policy
+func (tm *ClaimTxManager) suggestDynamicFee(ctx context.Context) (*big.Int, *big.Int, error) {
+	if tm.cfg.FreeGas {
+		return big.NewInt(0), big.NewInt(0), nil
+	}
+	gasTipCap, err := tm.l2Node.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "SuggestGasTipCap err")
+	}
+	header, err := tm.l2Node.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "HeaderByNumber err")
+	}
+	if header.BaseFee == nil {
+		return nil, nil, errors.New("the network doesn't support dynamic fee txs, latest header has no base fee")
+	}
+	gasTipCap, gasFeeCap := calcDynamicFee(tm.cfg.DynamicFee, gasTipCap, header.BaseFee)
+	return gasTipCap, gasFeeCap, nil
+}
+
+// calcDynamicFee applies the fee policy to the suggested tip and the latest base fee
+func calcDynamicFee(cfg DynamicFeeConfig, suggestedTipCap, baseFee *big.Int) (*big.Int, *big.Int) {
+	gasTipCap := new(big.Int).Set(suggestedTipCap)
+	if cfg.GasTipCapMultiplier > 0 {
+		gasTipCap.Mul(gasTipCap, new(big.Int).SetUint64(cfg.GasTipCapMultiplier))
+	}
+	if minTipCap := new(big.Int).SetUint64(cfg.MinGasTipCap); gasTipCap.Cmp(minTipCap) < 0 {
+		gasTipCap = minTipCap
+	}
+	gasTipCap = capFee(gasTipCap, cfg.MaxGasTipCap)
+
+	baseFeeMultiplier := cfg.BaseFeeMultiplier
+	if baseFeeMultiplier == 0 {
+		baseFeeMultiplier = 1
+	}
+	gasFeeCap := new(big.Int).Mul(baseFee, new(big.Int).SetUint64(baseFeeMultiplier))
+	gasFeeCap.Add(gasFeeCap, gasTipCap)
+	gasFeeCap = capFee(gasFeeCap, cfg.MaxGasFeeCap)
+
+	// the tip can never be higher than the max fee per gas
+	if gasTipCap.Cmp(gasFeeCap) > 0 {
+		gasTipCap = new(big.Int).Set(gasFeeCap)
+	}
+	return gasTipCap, gasFeeCap
+}
+
+// bumpFee returns the suggested fee, raised if needed so it is at least bumpPercentage
+// higher than the previous fee. It is used to replace a tx that was sent with the same nonce
+func bumpFee(prev, suggested *big.Int, bumpPercentage uint64) *big.Int {
+	if prev == nil {
+		return suggested
+	}
+	minFee := new(big.Int).Mul(prev, new(big.Int).SetUint64(percentBase+bumpPercentage))
+	minFee.Div(minFee, big.NewInt(percentBase))
+	if suggested.Cmp(minFee) < 0 {
+		return minFee
+	}
+	return suggested
+}
+
+// capFee limits the fee to the max value, 0 means no limit
+func capFee(fee *big.Int, max uint64) *big.Int {
+	if max == 0 {
+		return fee
+	}
+	if maxFee := new(big.Int).SetUint64(max); fee.Cmp(maxFee) > 0 {
+		return maxFee
+	}
+	return fee
+}
+
+// applyDynamicFeeBump makes sure the replacement of a tx that reuses the nonce of the
+// previous attempt is priced high enough to be accepted by the pool. If the previous attempt
+// was a legacy tx, both caps are bumped against its gas price
+func (tm *ClaimTxManager) applyDynamicFeeBump(mTx *ctmtypes.MonitoredTx, prevNonce uint64, prevGasPrice, prevTipCap, prevFeeCap *big.Int) {
+	if mTx.Nonce != prevNonce || len(mTx.History) == 0 {
+		return
+	}
+	if prevTipCap == nil && prevFeeCap == nil {
+		prevTipCap, prevFeeCap = prevGasPrice, prevGasPrice
+	}
+	cfg := tm.cfg.DynamicFee
+	mTx.GasTipCap = capFee(bumpFee(prevTipCap, mTx.GasTipCap, cfg.BumpPercentage), cfg.MaxGasTipCap)
+	mTx.GasFeeCap = capFee(bumpFee(prevFeeCap, mTx.GasFeeCap, cfg.BumpPercentage), cfg.MaxGasFeeCap)
+	if mTx.GasTipCap.Cmp(mTx.GasFeeCap) > 0 {
+		mTx.GasTipCap = new(big.Int).Set(mTx.GasFeeCap)
+	}
+}
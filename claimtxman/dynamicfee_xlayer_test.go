@@ -0,0 +1,63 @@
+package claimtxman
+
+import (
+	"math/big"
+	"testing"
+
+	ctmtypes "github.com/0xPolygonHermez/zkevm-bridge-service/claimtxman/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalcDynamicFee(t *testing.T) {
+	cfg := DynamicFeeConfig{
+		GasTipCapMultiplier: 2,
+		MinGasTipCap:        100,
+		BaseFeeMultiplier:   2,
+	}
+	tip, feeCap := calcDynamicFee(cfg, big.NewInt(10), big.NewInt(1000))
+	assert.Equal(t, big.NewInt(100), tip)
+	assert.Equal(t, big.NewInt(2100), feeCap)
+
+	tip, feeCap = calcDynamicFee(cfg, big.NewInt(200), big.NewInt(1000))
+	assert.Equal(t, big.NewInt(400), tip)
+	assert.Equal(t, big.NewInt(2400), feeCap)
+
+	cfg.MaxGasTipCap = 300
+	cfg.MaxGasFeeCap = 250
+	tip, feeCap = calcDynamicFee(cfg, big.NewInt(200), big.NewInt(1000))
+	assert.Equal(t, big.NewInt(250), tip)
+	assert.Equal(t, big.NewInt(250), feeCap)
+}
+
+func TestApplyDynamicFeeBump(t *testing.T) {
+	tm := &ClaimTxManager{cfg: Config{DynamicFee: DynamicFeeConfig{BumpPercentage: 10}}}
+	mTx := ctmtypes.MonitoredTx{
+		Nonce:     5,
+		GasTipCap: big.NewInt(100),
+		GasFeeCap: big.NewInt(1000),
+		History:   map[common.Hash]bool{common.HexToHash("0x1"): true},
+	}
+	// Same nonce, the suggested fees are lower than the previous ones
+	tm.applyDynamicFeeBump(&mTx, 5, nil, big.NewInt(200), big.NewInt(2000))
+	assert.Equal(t, big.NewInt(220), mTx.GasTipCap)
+	assert.Equal(t, big.NewInt(2200), mTx.GasFeeCap)
+
+	// Same nonce, the suggested fees are already high enough
+	mTx.GasTipCap, mTx.GasFeeCap = big.NewInt(500), big.NewInt(5000)
+	tm.applyDynamicFeeBump(&mTx, 5, nil, big.NewInt(200), big.NewInt(2000))
+	assert.Equal(t, big.NewInt(500), mTx.GasTipCap)
+	assert.Equal(t, big.NewInt(5000), mTx.GasFeeCap)
+
+	// New nonce, nothing to replace
+	mTx.GasTipCap, mTx.GasFeeCap = big.NewInt(100), big.NewInt(1000)
+	tm.applyDynamicFeeBump(&mTx, 4, nil, big.NewInt(200), big.NewInt(2000))
+	assert.Equal(t, big.NewInt(100), mTx.GasTipCap)
+	assert.Equal(t, big.NewInt(1000), mTx.GasFeeCap)
+
+	// Same nonce, the previous attempt was a legacy tx: both caps are bumped against its gas price
+	mTx.GasTipCap, mTx.GasFeeCap = big.NewInt(100), big.NewInt(1000)
+	tm.applyDynamicFeeBump(&mTx, 5, big.NewInt(1500), nil, nil)
+	assert.Equal(t, big.NewInt(1650), mTx.GasTipCap)
+	assert.Equal(t, big.NewInt(1650), mTx.GasFeeCap)
+}
@@ -0,0 +1,158 @@
+package claimtxman
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	ctmtypes "github.com/0xPolygonHermez/zkevm-bridge-service/claimtxman/types"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/metrics"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+)
+
+var errFeeCeilingReached = errors.New("the price of the tx can't be raised anymore")
+
+// shouldEscalate returns whether the last tx sent for the monitored tx has been waiting
+// in the pool long enough to be replaced by a higher priced one
+func shouldEscalate(cfg EscalationConfig, mTx ctmtypes.MonitoredTx, blockNumber uint64, now time.Time) bool {
+	if !cfg.Enabled || mTx.LastTxHash == (common.Hash{}) {
+		return false
+	}
+	if cfg.MaxAttempts > 0 && mTx.EscalationCount >= cfg.MaxAttempts {
+		return false
+	}
+	if cfg.BlocksToWait > 0 && mTx.LastSentBlock > 0 && blockNumber >= mTx.LastSentBlock+cfg.BlocksToWait {
+		return true
+	}
+	if cfg.TimeToWait.Duration > 0 && !mTx.LastSentAt.IsZero() && now.Sub(mTx.LastSentAt) >= cfg.TimeToWait.Duration {
+		return true
+	}
+	return false
+}
+
+// bumpMonitoredTxFee raises the gas price (or the fee caps) of the monitored tx by the
+// configured percentage, limited by the ceiling. It returns false if the price can't be raised
+func bumpMonitoredTxFee(cfg EscalationConfig, mTx *ctmtypes.MonitoredTx) bool {
+	if mTx.IsDynamicFee() {
+		gasFeeCap := capFee(bumpFee(mTx.GasFeeCap, mTx.GasFeeCap, cfg.BumpPercentage), cfg.MaxGasPrice)
+		if gasFeeCap.Cmp(mTx.GasFeeCap) <= 0 {
+			return false
+		}
+		gasTipCap := bumpFee(mTx.GasTipCap, mTx.GasTipCap, cfg.BumpPercentage)
+		if gasTipCap.Cmp(gasFeeCap) > 0 {
+			gasTipCap = new(big.Int).Set(gasFeeCap)
+		}
+		mTx.GasTipCap, mTx.GasFeeCap = gasTipCap, gasFeeCap
+		return true
+	}
+	if mTx.GasPrice == nil {
+		return false
+	}
+	gasPrice := capFee(bumpFee(mTx.GasPrice, mTx.GasPrice, cfg.BumpPercentage), cfg.MaxGasPrice)
+	if gasPrice.Cmp(mTx.GasPrice) <= 0 {
+		return false
+	}
+	mTx.GasPrice = gasPrice
+	return true
+}
+
+// escalateMonitoredTx replaces the pending tx of the monitored tx with a higher priced one
+// signed with the same nonce, if it has been waiting in the pool for too long
+func (tm *ClaimTxManager) escalateMonitoredTx(ctx context.Context, signer *claimSigner, mTx *ctmtypes.MonitoredTx, dbTx pgx.Tx) error {
+	if !tm.cfg.Escalation.Enabled {
+		return nil
+	}
+	blockNumber, err := tm.l2Node.BlockNumber(ctx)
+	if err != nil {
+		return errors.Wrap(err, "BlockNumber err")
+	}
+	if !shouldEscalate(tm.cfg.Escalation, *mTx, blockNumber, time.Now()) {
+		return nil
+	}
+
+	err = tm.replaceMonitoredTx(ctx, signer, mTx, blockNumber, dbTx)
+	if errors.Is(err, errFeeCeilingReached) {
+		log.WithFields("monitoredTx", mTx.DepositID).Warnf("tx %s is still pending but its price can't be raised anymore", mTx.LastTxHash.String())
+		return nil
+	}
+	return err
+}
+
+// replaceMonitoredTx raises the price of the monitored tx and sends it again with the same nonce
+func (tm *ClaimTxManager) replaceMonitoredTx(ctx context.Context, signer *claimSigner, mTx *ctmtypes.MonitoredTx, blockNumber uint64, dbTx pgx.Tx) error {
+	mTxLog := log.WithFields("monitoredTx", mTx.DepositID)
+	err := tm.fillMissingGasPrice(ctx, mTx)
+	if err != nil {
+		return err
+	}
+	prevGasPrice, prevGasTipCap, prevGasFeeCap := mTx.GasPrice, mTx.GasTipCap, mTx.GasFeeCap
+	if !bumpMonitoredTxFee(tm.cfg.Escalation, mTx) {
+		return errFeeCeilingReached
+	}
+
+	signedTx, err := signer.auth.Signer(mTx.From, mTx.Tx())
+	if err != nil {
+		mTx.GasPrice, mTx.GasTipCap, mTx.GasFeeCap = prevGasPrice, prevGasTipCap, prevGasFeeCap
+		return errors.Wrap(err, "failed to sign the replacement tx")
+	}
+	err = mTx.AddHistory(signedTx)
+	if err != nil {
+		mTx.GasPrice, mTx.GasTipCap, mTx.GasFeeCap = prevGasPrice, prevGasTipCap, prevGasFeeCap
+		return errors.Wrap(err, "failed to add the replacement tx to the history")
+	}
+	err = tm.l2Node.SendTransaction(ctx, signedTx)
+	if err != nil {
+		mTx.RemoveHistory(signedTx)
+		mTx.GasPrice, mTx.GasTipCap, mTx.GasFeeCap = prevGasPrice, prevGasTipCap, prevGasFeeCap
+		return errors.Wrapf(err, "failed to send the replacement tx %s", signedTx.Hash().String())
+	}
+	mTxLog.Infof("tx %s replaced by %s with nonce %d, escalation %d", mTx.LastTxHash.String(), signedTx.Hash().String(), signedTx.Nonce(), mTx.EscalationCount+1)
+	mTx.EscalationCount++
+	metrics.RecordMonitoredTxEscalation()
+
+	err = tm.markTxSent(ctx, mTx, signedTx, blockNumber, true, dbTx)
+	if err != nil {
+		return err
+	}
+	return tm.storage.UpdateClaimTx(ctx, *mTx, dbTx)
+}
+
+// fillMissingGasPrice sets the gas price suggested by the network on a legacy monitored tx
+// stored before its gas price was kept, so it can still be raised
+func (tm *ClaimTxManager) fillMissingGasPrice(ctx context.Context, mTx *ctmtypes.MonitoredTx) error {
+	if mTx.IsDynamicFee() || mTx.GasPrice != nil {
+		return nil
+	}
+	gasPrice, err := tm.l2Node.SuggestGasPrice(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get suggested gasPrice")
+	}
+	mTx.GasPrice = gasPrice
+	return nil
+}
+
+// markTxSent keeps track of the last tx sent to the network for the monitored tx and records the attempt
+func (tm *ClaimTxManager) markTxSent(ctx context.Context, mTx *ctmtypes.MonitoredTx, signedTx *types.Transaction, blockNumber uint64, escalation bool, dbTx pgx.Tx) error {
+	mTx.LastTxHash = signedTx.Hash()
+	mTx.LastSentAt = time.Now().UTC()
+	mTx.LastSentBlock = blockNumber
+	err := tm.storage.AddClaimTxAttempt(ctx, ctmtypes.MonitoredTxAttempt{
+		DepositID:   mTx.DepositID,
+		NetworkID:   mTx.NetworkID,
+		TxHash:      signedTx.Hash(),
+		Nonce:       signedTx.Nonce(),
+		GasPrice:    mTx.GasPrice,
+		GasTipCap:   mTx.GasTipCap,
+		GasFeeCap:   mTx.GasFeeCap,
+		BlockNumber: blockNumber,
+		Escalation:  escalation,
+	}, dbTx)
+	if err != nil {
+		return errors.Wrap(err, "failed to add the claim tx attempt")
+	}
+	return nil
+}
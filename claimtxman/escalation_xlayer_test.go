@@ -0,0 +1,99 @@
+package claimtxman
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	ctmtypes "github.com/0xPolygonHermez/zkevm-bridge-service/claimtxman/types"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db/pgstorage"
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldEscalate(t *testing.T) {
+	now := time.Now()
+	cfg := EscalationConfig{
+		Enabled:      true,
+		BlocksToWait: 10,
+		TimeToWait:   types.NewDuration(time.Minute),
+		MaxAttempts:  2,
+	}
+	mTx := ctmtypes.MonitoredTx{
+		LastTxHash:    common.HexToHash("0x1"),
+		LastSentAt:    now.Add(-time.Second),
+		LastSentBlock: 100,
+	}
+	assert.False(t, shouldEscalate(cfg, mTx, 105, now))
+	assert.True(t, shouldEscalate(cfg, mTx, 110, now))
+	assert.True(t, shouldEscalate(cfg, mTx, 105, now.Add(time.Minute)))
+
+	mTx.EscalationCount = 2
+	assert.False(t, shouldEscalate(cfg, mTx, 110, now))
+
+	mTx.EscalationCount = 0
+	mTx.LastTxHash = common.Hash{}
+	assert.False(t, shouldEscalate(cfg, mTx, 110, now))
+
+	cfg.Enabled = false
+	assert.False(t, shouldEscalate(cfg, mTx, 110, now))
+}
+
+func TestBumpMonitoredTxFee(t *testing.T) {
+	cfg := EscalationConfig{BumpPercentage: 20, MaxGasPrice: 1300}
+	mTx := ctmtypes.MonitoredTx{GasPrice: big.NewInt(1000)}
+	assert.True(t, bumpMonitoredTxFee(cfg, &mTx))
+	assert.Equal(t, big.NewInt(1200), mTx.GasPrice)
+	assert.True(t, bumpMonitoredTxFee(cfg, &mTx))
+	assert.Equal(t, big.NewInt(1300), mTx.GasPrice)
+	assert.False(t, bumpMonitoredTxFee(cfg, &mTx))
+	assert.Equal(t, big.NewInt(1300), mTx.GasPrice)
+
+	mTx = ctmtypes.MonitoredTx{GasTipCap: big.NewInt(100), GasFeeCap: big.NewInt(1000)}
+	assert.True(t, bumpMonitoredTxFee(cfg, &mTx))
+	assert.Equal(t, big.NewInt(120), mTx.GasTipCap)
+	assert.Equal(t, big.NewInt(1200), mTx.GasFeeCap)
+}
+
+func TestBumpReloadedMonitoredTxFee(t *testing.T) {
+	ctx := context.Background()
+	dbCfg := pgstorage.NewConfigFromEnv()
+	err := pgstorage.InitOrReset(dbCfg)
+	require.NoError(t, err)
+	pg, err := pgstorage.NewPostgresStorage(dbCfg)
+	require.NoError(t, err)
+
+	toAdr := common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+	mTx := ctmtypes.MonitoredTx{
+		DepositID: 1,
+		NetworkID: 1,
+		From:      common.HexToAddress("0x6B175474E89094C44Da98b954EedeAC495271d0F"),
+		To:        &toAdr,
+		Nonce:     1,
+		Value:     big.NewInt(1000000),
+		Data:      common.FromHex("0x0"),
+		Gas:       1000000,
+		GasPrice:  big.NewInt(1000),
+		Status:    ctmtypes.MonitoredTxStatusCreated,
+		History:   make(map[common.Hash]bool),
+	}
+	require.NoError(t, pg.AddClaimTx(ctx, mTx, nil))
+
+	cfg := EscalationConfig{BumpPercentage: 20}
+	reloaded, err := pg.GetClaimTxById(ctx, mTx.DepositID, mTx.NetworkID, nil)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1000), reloaded.GasPrice)
+	require.True(t, bumpMonitoredTxFee(cfg, reloaded))
+	require.Equal(t, big.NewInt(1200), reloaded.GasPrice)
+	require.NoError(t, pg.UpdateClaimTx(ctx, *reloaded, nil))
+
+	reloaded, err = pg.GetClaimTxById(ctx, mTx.DepositID, mTx.NetworkID, nil)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1200), reloaded.GasPrice)
+	require.Nil(t, reloaded.GasFeeCap)
+	require.True(t, bumpMonitoredTxFee(cfg, reloaded))
+	require.Equal(t, big.NewInt(1440), reloaded.GasPrice)
+}
@@ -2,10 +2,13 @@ package claimtxman
 
 import (
 	"context"
+	"time"
 
 	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/claimtxman/types"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/pushoutbox"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/jackc/pgx/v4"
 )
 
@@ -22,13 +25,26 @@ type storageInterface interface {
 	Commit(ctx context.Context, dbTx pgx.Tx) error
 
 	// XLayer
-	UpdateL1DepositsStatusXLayer(ctx context.Context, exitRoot []byte, dbTx pgx.Tx) ([]*etherman.Deposit, error)
+	UpdateL1DepositsStatusXLayer(ctx context.Context, exitRoot []byte, destNetwork uint, dbTx pgx.Tx) ([]*etherman.Deposit, error)
 	UpdateL2DepositsStatusXLayer(ctx context.Context, exitRoot []byte, rollupID, networkID uint, dbTx pgx.Tx) ([]*etherman.Deposit, error)
-	GetL1Deposits(ctx context.Context, exitRoot []byte, dbTx pgx.Tx) ([]*etherman.Deposit, error)
+	GetL1Deposits(ctx context.Context, exitRoot []byte, destNetwork uint, dbTx pgx.Tx) ([]*etherman.Deposit, error)
 	UpdateL1DepositStatus(ctx context.Context, depositCount uint, dbTx pgx.Tx) error
 	GetDeposit(ctx context.Context, depositCounterUser uint, networkID uint, dbTx pgx.Tx) (*etherman.Deposit, error)
-	GetClaim(ctx context.Context, depositCount, networkID uint, dbTx pgx.Tx) (*etherman.Claim, error)
-	GetClaimTxsByStatusWithLimit(ctx context.Context, statuses []types.MonitoredTxStatus, limit, offset uint, dbTx pgx.Tx) ([]types.MonitoredTx, error)
+	GetClaimBySource(ctx context.Context, depositCount, networkID uint, mainnetFlag bool, rollupIndex uint, dbTx pgx.Tx) (*etherman.Claim, error)
+	GetClaimTxsByStatusAndNetwork(ctx context.Context, statuses []types.MonitoredTxStatus, networkID uint, limit, offset uint, dbTx pgx.Tx) ([]types.MonitoredTx, error)
+	AddClaimTxAttempt(ctx context.Context, attempt types.MonitoredTxAttempt, dbTx pgx.Tx) error
+	GetClaimTxById(ctx context.Context, id uint, networkID uint, dbTx pgx.Tx) (*types.MonitoredTx, error)
+	GetClaimTxOperationsByStatus(ctx context.Context, status types.MonitoredTxOperationStatus, dbTx pgx.Tx) ([]types.MonitoredTxOperation, error)
+	UpdateClaimTxOperation(ctx context.Context, op types.MonitoredTxOperation, dbTx pgx.Tx) error
+	CountClaimTxsByDestAddress(ctx context.Context, destAddr common.Address, networkID uint, since time.Time, dbTx pgx.Tx) (uint64, error)
+	AddPushOutboxMessage(ctx context.Context, msg *pushoutbox.Message, dbTx pgx.Tx) error
+	CheckFencingToken(ctx context.Context, leaseKey string, token int64, dbTx pgx.Tx) (bool, error)
+}
+
+type leaderInterface interface {
+	IsLeader() bool
+	LeaseKey() string
+	FencingToken() int64
 }
 
 type bridgeServiceInterface interface {
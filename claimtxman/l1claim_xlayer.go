@@ -0,0 +1,108 @@
+package claimtxman
+
+import (
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/messagepush"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/redisstorage"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+)
+
+// NewL1ClaimTxManager creates the claim transaction manager that claims on L1 the deposits
+// bridged from the L2 network. The claims are tracked in the monitored txs with the L1 network id
+func NewL1ClaimTxManager(cfg Config, l1NodeURL string, l1BridgeAddr common.Address, l2NetworkID uint, bridgeService bridgeServiceInterface,
+	storage interface{}, producer messagepush.KafkaProducer, redisStorage redisstorage.RedisStorage, rollupID uint) (*ClaimTxManager, error) {
+	tm, err := NewClaimTxManager(l1ClaimConfig(cfg), nil, nil, l1NodeURL, 0, l1BridgeAddr, bridgeService, storage, producer, redisStorage, rollupID)
+	if err != nil {
+		return nil, err
+	}
+	tm.depositNetworkID = l2NetworkID
+	return tm, nil
+}
+
+// SetL1Claimer sets the claim tx manager used to claim on L1 the deposits of this L2 network
+func (tm *ClaimTxManager) SetL1Claimer(l1Claimer *ClaimTxManager) {
+	tm.l1Claimer = l1Claimer
+	l1Claimer.leader = tm.leader
+}
+
+// l1ClaimConfig returns the configuration of the L1 claim tx manager, the signer and the gas
+// policy are the L1 ones and the rest of the settings are shared with the L2 claims
+func l1ClaimConfig(cfg Config) Config {
+	l1Cfg := cfg
+	if cfg.L1Claim.FrequencyToMonitorTxs.Duration > 0 {
+		l1Cfg.FrequencyToMonitorTxs = cfg.L1Claim.FrequencyToMonitorTxs
+	}
+	l1Cfg.PrivateKey = cfg.L1Claim.PrivateKey
+	l1Cfg.PrivateKeys = nil
+	l1Cfg.DrainingSigners = nil
+	l1Cfg.FreeGas = false
+	l1Cfg.OptClaim = false
+	l1Cfg.DynamicFee = cfg.L1Claim.DynamicFee
+	l1Cfg.Escalation = cfg.L1Claim.Escalation
+	l1Cfg.BalanceMonitor = BalanceMonitorConfig{}
+	l1Cfg.L1Claim = L1ClaimConfig{}
+	return l1Cfg
+}
+
+// isL1ClaimAllowed returns whether the deposit passes the filters of the L1 claims
+func isL1ClaimAllowed(cfg L1ClaimConfig, deposit *etherman.Deposit) bool {
+	if deposit.DestinationNetwork != 0 {
+		return false
+	}
+	if !containsLeafType(cfg.LeafTypes, deposit.LeafType) {
+		return false
+	}
+	return isAddressAllowed(cfg.Tokens, deposit.OriginalAddress) && isAddressAllowed(cfg.Destinations, deposit.DestinationAddress)
+}
+
+// isAddressAllowed returns whether the address is in the allowlist, an empty allowlist allows all the addresses
+func isAddressAllowed(allowlist []common.Address, addr common.Address) bool {
+	return len(allowlist) == 0 || containsAddress(allowlist, addr)
+}
+
+// addL1ClaimTxs creates the L1 claim txs of the L2 deposits that became ready to be claimed.
+// It returns the deposit counts of the deposits that are going to be claimed automatically
+func (tm *ClaimTxManager) addL1ClaimTxs(deposits []*etherman.Deposit, dbTx pgx.Tx) (map[uint]bool, error) {
+	autoClaimed := make(map[uint]bool)
+	if tm.l1Claimer == nil {
+		return autoClaimed, nil
+	}
+	for _, deposit := range deposits {
+		if !isL1ClaimAllowed(tm.cfg.L1Claim, deposit) {
+			continue
+		}
+		claimHash, err := tm.l1Claimer.getClaimTxHash(tm.ctx, deposit.DepositCount, dbTx)
+		if err != nil {
+			log.Errorf("error getting deposit status for deposit %d. Error: %v", deposit.DepositCount, err)
+			return nil, err
+		}
+		if len(claimHash) > 0 {
+			log.Infof("Ignoring L1 claim of deposit: %d, claimHash: %s", deposit.DepositCount, claimHash)
+			continue
+		}
+		rejected, err := tm.isRejectedByPolicy(deposit, dbTx)
+		if err != nil {
+			return nil, err
+		}
+		if rejected {
+			continue
+		}
+		err = tm.l1Claimer.createClaimTxXLayer(deposit, dbTx)
+		if err != nil {
+			return nil, err
+		}
+		autoClaimed[deposit.DepositCount] = true
+	}
+	return autoClaimed, nil
+}
+
+// l2DepositReadyStatus returns the status pushed to the FE when a L2 deposit is ready to be claimed
+func l2DepositReadyStatus(autoClaimed bool) uint32 {
+	if autoClaimed {
+		return uint32(pb.TransactionStatus_TX_PENDING_AUTO_CLAIM)
+	}
+	return uint32(pb.TransactionStatus_TX_PENDING_USER_CLAIM)
+}
@@ -0,0 +1,76 @@
+package claimtxman
+
+import (
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsL1ClaimAllowed(t *testing.T) {
+	token := common.HexToAddress("0x1")
+	dest := common.HexToAddress("0x2")
+	deposit := &etherman.Deposit{
+		LeafType:           0,
+		OriginalAddress:    token,
+		DestinationNetwork: 0,
+		DestinationAddress: dest,
+	}
+
+	cfg := L1ClaimConfig{LeafTypes: []uint8{0}}
+	assert.True(t, isL1ClaimAllowed(cfg, deposit))
+
+	// only the deposits bridged to L1 are claimed on L1
+	deposit.DestinationNetwork = 1
+	assert.False(t, isL1ClaimAllowed(cfg, deposit))
+	deposit.DestinationNetwork = 0
+
+	deposit.LeafType = 1
+	assert.False(t, isL1ClaimAllowed(cfg, deposit))
+	cfg.LeafTypes = []uint8{0, 1}
+	assert.True(t, isL1ClaimAllowed(cfg, deposit))
+
+	cfg.Tokens = []common.Address{common.HexToAddress("0x3")}
+	assert.False(t, isL1ClaimAllowed(cfg, deposit))
+	cfg.Tokens = append(cfg.Tokens, token)
+	assert.True(t, isL1ClaimAllowed(cfg, deposit))
+
+	cfg.Destinations = []common.Address{common.HexToAddress("0x4")}
+	assert.False(t, isL1ClaimAllowed(cfg, deposit))
+	cfg.Destinations = append(cfg.Destinations, dest)
+	assert.True(t, isL1ClaimAllowed(cfg, deposit))
+}
+
+func TestL1ClaimConfig(t *testing.T) {
+	cfg := Config{
+		FrequencyToMonitorTxs: types.NewDuration(time.Second),
+		PrivateKey:            types.KeystoreFileConfig{Path: "l2.keystore"},
+		PrivateKeys:           []types.KeystoreFileConfig{{Path: "l2-pool.keystore"}},
+		FreeGas:               true,
+		RetryNumber:           3,
+		BalanceMonitor:        BalanceMonitorConfig{Enabled: true},
+		L1Claim: L1ClaimConfig{
+			Enabled:    true,
+			PrivateKey: types.KeystoreFileConfig{Path: "l1.keystore"},
+			DynamicFee: DynamicFeeConfig{Enabled: true},
+			Escalation: EscalationConfig{Enabled: true},
+		},
+	}
+
+	l1Cfg := l1ClaimConfig(cfg)
+	assert.Equal(t, time.Second, l1Cfg.FrequencyToMonitorTxs.Duration)
+	assert.Equal(t, "l1.keystore", l1Cfg.PrivateKey.Path)
+	assert.Empty(t, l1Cfg.PrivateKeys)
+	assert.False(t, l1Cfg.FreeGas)
+	assert.Equal(t, 3, l1Cfg.RetryNumber)
+	assert.False(t, l1Cfg.BalanceMonitor.Enabled)
+	assert.True(t, l1Cfg.DynamicFee.Enabled)
+	assert.True(t, l1Cfg.Escalation.Enabled)
+	assert.False(t, l1Cfg.L1Claim.Enabled)
+
+	cfg.L1Claim.FrequencyToMonitorTxs = types.NewDuration(12 * time.Second)
+	assert.Equal(t, 12*time.Second, l1ClaimConfig(cfg).FrequencyToMonitorTxs.Duration)
+}
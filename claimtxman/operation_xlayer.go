@@ -0,0 +1,181 @@
+package claimtxman
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	ctmtypes "github.com/0xPolygonHermez/zkevm-bridge-service/claimtxman/types"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+)
+
+// nonceFillGas is the gas of the empty tx sent to fill the nonce of a canceled claim
+const nonceFillGas = 21000
+
+// processOperationsXLayer executes the operations requested by the operators on the monitored
+// txs sent to the network of this claim tx manager and signed by it. The operations on txs
+// handled by other managers are skipped
+func (tm *ClaimTxManager) processOperationsXLayer(ctx context.Context, dbTx pgx.Tx) error {
+	ops, err := tm.storage.GetClaimTxOperationsByStatus(ctx, ctmtypes.MonitoredTxOperationStatusPending, dbTx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get the pending operations")
+	}
+	for _, op := range ops {
+		op := op // force variable shadowing to avoid pointer conflicts
+		if op.NetworkID != tm.l2NetworkID {
+			continue
+		}
+		opLog := log.WithFields("monitoredTx", op.DepositID, "operation", op.ID)
+		mTx, err := tm.storage.GetClaimTxById(ctx, op.DepositID, op.NetworkID, dbTx)
+		if err != nil && !errors.Is(err, gerror.ErrStorageNotFound) {
+			opLog.Errorf("failed to get the monitored tx: %v", err)
+			continue
+		}
+		if err != nil {
+			op.Status = ctmtypes.MonitoredTxOperationStatusRejected
+			op.Result = "monitored tx not found"
+		} else {
+			signer := tm.signers.get(mTx.From)
+			if signer == nil {
+				continue
+			}
+			tm.executeOperation(ctx, signer, &op, mTx, dbTx)
+		}
+		opLog.Infof("%s operation requested by %s is %s: %s", op.Action, op.Operator, op.Status, op.Result)
+		err = tm.storage.UpdateClaimTxOperation(ctx, op, dbTx)
+		if err != nil {
+			opLog.Errorf("failed to update the operation: %v", err)
+		}
+	}
+	return nil
+}
+
+// executeOperation applies the operation to the monitored tx and sets the outcome in the operation
+func (tm *ClaimTxManager) executeOperation(ctx context.Context, signer *claimSigner, op *ctmtypes.MonitoredTxOperation, mTx *ctmtypes.MonitoredTx, dbTx pgx.Tx) {
+	if !op.Action.IsAllowed(mTx.Status) {
+		op.Status = ctmtypes.MonitoredTxOperationStatusRejected
+		op.Result = fmt.Sprintf("action %s is not allowed on a %s monitored tx", op.Action, mTx.Status)
+		return
+	}
+	var err error
+	switch op.Action {
+	case ctmtypes.MonitoredTxActionRequeue:
+		mTx.Reset()
+		op.Result = "claim requeued"
+	case ctmtypes.MonitoredTxActionResend:
+		err = tm.resendMonitoredTx(ctx, signer, mTx, op, dbTx)
+	case ctmtypes.MonitoredTxActionCancel:
+		err = tm.cancelMonitoredTx(ctx, signer, mTx, op, dbTx)
+	}
+	if err == nil {
+		err = tm.storage.UpdateClaimTx(ctx, *mTx, dbTx)
+	}
+	if err != nil {
+		op.Status = ctmtypes.MonitoredTxOperationStatusFailed
+		op.Result = err.Error()
+		return
+	}
+	op.Status = ctmtypes.MonitoredTxOperationStatusDone
+}
+
+// resendMonitoredTx replaces the pending tx of the monitored tx with a higher priced one right away.
+// If there is no pending tx, the claim is requeued
+func (tm *ClaimTxManager) resendMonitoredTx(ctx context.Context, signer *claimSigner, mTx *ctmtypes.MonitoredTx, op *ctmtypes.MonitoredTxOperation, dbTx pgx.Tx) error {
+	pending, err := tm.hasPendingTx(ctx, *mTx)
+	if err != nil {
+		return err
+	}
+	if !pending {
+		mTx.Reset()
+		op.Result = "there is no pending tx, claim requeued"
+		return nil
+	}
+	blockNumber, err := tm.l2Node.BlockNumber(ctx)
+	if err != nil {
+		return errors.Wrap(err, "BlockNumber err")
+	}
+	mTx.Status = ctmtypes.MonitoredTxStatusCreated
+	err = tm.replaceMonitoredTx(ctx, signer, mTx, blockNumber, dbTx)
+	if err != nil {
+		return err
+	}
+	op.TxHash = mTx.LastTxHash
+	op.Result = fmt.Sprintf("pending tx replaced with nonce %d", mTx.Nonce)
+	return nil
+}
+
+// cancelMonitoredTx stops claiming the deposit. If a tx of the monitored tx is still pending, its
+// nonce is filled with an empty higher priced tx, so the claim can't be mined anymore
+func (tm *ClaimTxManager) cancelMonitoredTx(ctx context.Context, signer *claimSigner, mTx *ctmtypes.MonitoredTx, op *ctmtypes.MonitoredTxOperation, dbTx pgx.Tx) error {
+	pending, err := tm.hasPendingTx(ctx, *mTx)
+	if err != nil {
+		return err
+	}
+	if !pending {
+		mTx.Status = ctmtypes.MonitoredTxStatusCanceled
+		op.Result = "there is no pending tx, claim canceled"
+		return nil
+	}
+	blockNumber, err := tm.l2Node.BlockNumber(ctx)
+	if err != nil {
+		return errors.Wrap(err, "BlockNumber err")
+	}
+	err = tm.fillMissingGasPrice(ctx, mTx)
+	if err != nil {
+		return err
+	}
+	fillTx := ctmtypes.MonitoredTx{
+		From:      mTx.From,
+		To:        &mTx.From,
+		Nonce:     mTx.Nonce,
+		Value:     big.NewInt(0),
+		Gas:       nonceFillGas,
+		GasPrice:  mTx.GasPrice,
+		GasTipCap: mTx.GasTipCap,
+		GasFeeCap: mTx.GasFeeCap,
+	}
+	if !bumpMonitoredTxFee(tm.cfg.Escalation, &fillTx) {
+		return errFeeCeilingReached
+	}
+	signedTx, err := signer.auth.Signer(mTx.From, fillTx.Tx())
+	if err != nil {
+		return errors.Wrap(err, "failed to sign the nonce fill tx")
+	}
+	err = tm.l2Node.SendTransaction(ctx, signedTx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to send the nonce fill tx %s", signedTx.Hash().String())
+	}
+	err = tm.storage.AddClaimTxAttempt(ctx, ctmtypes.MonitoredTxAttempt{
+		DepositID:   mTx.DepositID,
+		NetworkID:   mTx.NetworkID,
+		TxHash:      signedTx.Hash(),
+		Nonce:       signedTx.Nonce(),
+		GasPrice:    fillTx.GasPrice,
+		GasTipCap:   fillTx.GasTipCap,
+		GasFeeCap:   fillTx.GasFeeCap,
+		BlockNumber: blockNumber,
+		Escalation:  true,
+	}, dbTx)
+	if err != nil {
+		return errors.Wrap(err, "failed to add the nonce fill tx attempt")
+	}
+	mTx.Status = ctmtypes.MonitoredTxStatusCanceled
+	op.TxHash = signedTx.Hash()
+	op.Result = fmt.Sprintf("nonce %d filled with an empty tx", mTx.Nonce)
+	return nil
+}
+
+// hasPendingTx returns whether a tx of the monitored tx was sent and its nonce is not consumed yet
+func (tm *ClaimTxManager) hasPendingTx(ctx context.Context, mTx ctmtypes.MonitoredTx) (bool, error) {
+	if len(mTx.History) == 0 {
+		return false, nil
+	}
+	nonce, err := tm.l2Node.NonceAt(ctx, mTx.From, nil)
+	if err != nil {
+		return false, errors.Wrap(err, "NonceAt err")
+	}
+	return nonce <= mTx.Nonce, nil
+}
@@ -0,0 +1,143 @@
+package claimtxman
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/metrics"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/server/tokenlogoinfo"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/messagebridge"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+)
+
+// Rules of the claim policy, they are reported when a deposit is not claimed automatically
+const (
+	policyRuleLeafType    = "leaf_type"
+	policyRuleToken       = "token"
+	policyRuleDestination = "destination"
+	policyRuleMinValue    = "min_value"
+	policyRuleDailyQuota  = "daily_quota"
+)
+
+// isRejectedByPolicy returns whether the claim policy rejects the auto-claim of the deposit
+func (tm *ClaimTxManager) isRejectedByPolicy(deposit *etherman.Deposit, dbTx pgx.Tx) (bool, error) {
+	rule, err := tm.evaluateClaimPolicy(tm.ctx, deposit, dbTx)
+	if err != nil {
+		log.Errorf("error evaluating the claim policy for deposit %d. Error: %v", deposit.DepositCount, err)
+		return false, err
+	}
+	if rule == "" {
+		return false, nil
+	}
+	log.Infof("Ignoring deposit: %d, rejected by the claim policy rule: %s", deposit.DepositCount, rule)
+	metrics.RecordClaimPolicyRejection(rule)
+	return true, nil
+}
+
+// evaluateClaimPolicy returns the rule of the claim policy that rejects the deposit, empty if
+// the deposit can be claimed automatically
+func (tm *ClaimTxManager) evaluateClaimPolicy(ctx context.Context, deposit *etherman.Deposit, dbTx pgx.Tx) (string, error) {
+	policy := tm.policy.Get()
+	if !policy.Enabled {
+		return "", nil
+	}
+	// the message bridges of the known tokens are evaluated as the bridged token and the actual receiver
+	bridged := *deposit
+	messagebridge.ReplaceDepositInfo(&bridged, true)
+	receiver := *deposit
+	messagebridge.ReplaceDepositDestAddresses(&receiver)
+	if rule := checkPolicyRules(policy, &bridged, receiver.DestinationAddress); rule != "" {
+		return rule, nil
+	}
+
+	isAsset := deposit.LeafType == uint8(utils.LeafTypeAsset)
+	if policy.MinUSDValue > 0 && (isAsset || bridged.OriginalAddress != deposit.OriginalAddress) {
+		value, ok := tm.depositUSDValue(ctx, &bridged)
+		if !ok && !policy.AllowUnpriced || ok && value < policy.MinUSDValue {
+			return policyRuleMinValue, nil
+		}
+	}
+
+	// the destination of the message bridges is the bridge contract, so the quota only applies to the assets
+	if policy.DailyQuotaPerAddress > 0 && isAsset {
+		since := time.Now().UTC().Truncate(24 * time.Hour) //nolint:gomnd
+		count, err := tm.storage.CountClaimTxsByDestAddress(ctx, deposit.DestinationAddress, deposit.NetworkID, since, dbTx)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to count the claims of the destination address")
+		}
+		if count >= policy.DailyQuotaPerAddress {
+			return policyRuleDailyQuota, nil
+		}
+	}
+	return "", nil
+}
+
+// checkPolicyRules evaluates the rules of the policy that only depend on the deposit itself
+func checkPolicyRules(policy ClaimPolicy, deposit *etherman.Deposit, receiver common.Address) string {
+	if len(policy.LeafTypes) > 0 && !containsLeafType(policy.LeafTypes, deposit.LeafType) {
+		return policyRuleLeafType
+	}
+	if !isAddressAllowed(policy.TokenAllowlist, deposit.OriginalAddress) || containsAddress(policy.TokenDenylist, deposit.OriginalAddress) {
+		return policyRuleToken
+	}
+	if containsAddress(policy.DestinationDenylist, deposit.DestinationAddress) || containsAddress(policy.DestinationDenylist, receiver) {
+		return policyRuleDestination
+	}
+	return ""
+}
+
+// depositUSDValue returns the value (USD) of the tokens bridged by the deposit, using the coin
+// prices and the token decimals cached in redis. It returns false if the value is unknown
+func (tm *ClaimTxManager) depositUSDValue(ctx context.Context, deposit *etherman.Deposit) (float64, bool) {
+	if tm.redisStorage == nil || deposit.Amount == nil {
+		return 0, false
+	}
+	chainID := utils.GetChainIdByNetworkId(deposit.OriginalNetwork)
+	token := deposit.OriginalAddress.Hex()
+	logoInfo, err := tm.redisStorage.GetTokenLogoInfo(ctx, tokenlogoinfo.GetTokenLogoMapKey(token, chainID))
+	if err != nil {
+		log.Debugf("token decimals not found for token %s, chain %d: %v", token, chainID, err)
+		return 0, false
+	}
+	prices, err := tm.redisStorage.GetCoinPrice(ctx, []*pb.SymbolInfo{{ChainId: uint64(chainID), Address: token}})
+	if err != nil || len(prices) == 0 || prices[0].Price == 0 {
+		log.Debugf("coin price not found for token %s, chain %d: %v", token, chainID, err)
+		return 0, false
+	}
+	return usdValue(deposit.Amount, logoInfo.Unit, prices[0].Price), true
+}
+
+// usdValue converts an amount of tokens, expressed in the smallest unit, to USD
+func usdValue(amount *big.Int, decimals uint32, price float64) float64 {
+	value := new(big.Float).SetInt(amount)
+	value.Quo(value, new(big.Float).SetFloat64(math.Pow10(int(decimals))))
+	value.Mul(value, big.NewFloat(price))
+	f, _ := value.Float64()
+	return f
+}
+
+func containsLeafType(leafTypes []uint8, leafType uint8) bool {
+	for _, t := range leafTypes {
+		if t == leafType {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAddress(list []common.Address, addr common.Address) bool {
+	for _, a := range list {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+package claimtxman
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckPolicyRules(t *testing.T) {
+	token := common.HexToAddress("0x1")
+	dest := common.HexToAddress("0x2")
+	receiver := common.HexToAddress("0x3")
+	deposit := &etherman.Deposit{
+		LeafType:           0,
+		OriginalAddress:    token,
+		DestinationAddress: dest,
+	}
+
+	policy := ClaimPolicy{Enabled: true}
+	assert.Empty(t, checkPolicyRules(policy, deposit, dest))
+
+	policy.LeafTypes = []uint8{1}
+	assert.Equal(t, policyRuleLeafType, checkPolicyRules(policy, deposit, dest))
+	policy.LeafTypes = []uint8{0, 1}
+	assert.Empty(t, checkPolicyRules(policy, deposit, dest))
+
+	policy.TokenAllowlist = []common.Address{common.HexToAddress("0x4")}
+	assert.Equal(t, policyRuleToken, checkPolicyRules(policy, deposit, dest))
+	policy.TokenAllowlist = append(policy.TokenAllowlist, token)
+	assert.Empty(t, checkPolicyRules(policy, deposit, dest))
+	policy.TokenDenylist = []common.Address{token}
+	assert.Equal(t, policyRuleToken, checkPolicyRules(policy, deposit, dest))
+	policy.TokenDenylist = nil
+
+	// both the destination of the deposit and the receiver of a message bridge are checked
+	policy.DestinationDenylist = []common.Address{receiver}
+	assert.Empty(t, checkPolicyRules(policy, deposit, dest))
+	assert.Equal(t, policyRuleDestination, checkPolicyRules(policy, deposit, receiver))
+	policy.DestinationDenylist = []common.Address{dest}
+	assert.Equal(t, policyRuleDestination, checkPolicyRules(policy, deposit, receiver))
+}
+
+func TestUSDValue(t *testing.T) {
+	amount, _ := new(big.Int).SetString("2500000000000000000", 10)
+	assert.InDelta(t, 5000.0, usdValue(amount, 18, 2000), 1e-9)
+	assert.InDelta(t, 1.5, usdValue(big.NewInt(1500000), 6, 1), 1e-9)
+	assert.Zero(t, usdValue(big.NewInt(0), 6, 1))
+}
+
+func TestClaimPolicyJSON(t *testing.T) {
+	var policy ClaimPolicy
+	err := json.Unmarshal([]byte(`{
+		"enabled": true,
+		"leafTypes": [0, 1],
+		"tokenDenylist": ["0x0000000000000000000000000000000000000001"],
+		"minUsdValue": 10.5,
+		"dailyQuotaPerAddress": 3
+	}`), &policy)
+	require.NoError(t, err)
+	assert.True(t, policy.Enabled)
+	assert.Equal(t, []uint8{0, 1}, policy.LeafTypes)
+	assert.Equal(t, []common.Address{common.HexToAddress("0x1")}, policy.TokenDenylist)
+	assert.Equal(t, 10.5, policy.MinUSDValue)
+	assert.Equal(t, uint64(3), policy.DailyQuotaPerAddress)
+}
@@ -0,0 +1,110 @@
+package claimtxman
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ctmtypes "github.com/0xPolygonHermez/zkevm-bridge-service/claimtxman/types"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/metrics"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/messagebridge"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+)
+
+// Scopes of the auto-claim rate limits
+const (
+	rateLimitScopeDestination = "destination"
+	rateLimitScopeOrigin      = "origin"
+	rateLimitScopeGlobal      = "global"
+)
+
+// claimRateLimit is a rate limit that applies to a claim
+type claimRateLimit struct {
+	scope  string
+	key    string
+	limit  uint64
+	window time.Duration
+}
+
+// claimRateLimits returns the rate limits that apply to the claim of the deposit, the global limit is
+// the last one, so the claims deferred by the address limits don't use the global budget
+func claimRateLimits(cfg RateLimitConfig, networkID uint, deposit *etherman.Deposit, receiver common.Address) []claimRateLimit {
+	var limits []claimRateLimit
+	if window := cfg.Window.Duration; window > 0 {
+		if cfg.PerDestinationAddress > 0 {
+			limits = append(limits, claimRateLimit{
+				scope:  rateLimitScopeDestination,
+				key:    fmt.Sprintf("claim_%d_destination_%s", networkID, receiver.Hex()),
+				limit:  cfg.PerDestinationAddress,
+				window: window,
+			})
+		}
+		if cfg.PerOriginAddress > 0 {
+			limits = append(limits, claimRateLimit{
+				scope:  rateLimitScopeOrigin,
+				key:    fmt.Sprintf("claim_%d_origin_%d_%s", networkID, deposit.OriginalNetwork, deposit.OriginalAddress.Hex()),
+				limit:  cfg.PerOriginAddress,
+				window: window,
+			})
+		}
+	}
+	if cfg.GlobalPerMinute > 0 {
+		limits = append(limits, claimRateLimit{
+			scope:  rateLimitScopeGlobal,
+			key:    fmt.Sprintf("claim_%d_global", networkID),
+			limit:  cfg.GlobalPerMinute,
+			window: time.Minute,
+		})
+	}
+	return limits
+}
+
+// acquireClaimRateLimits takes a unit of all the rate limits of the claim of the monitored tx. It returns
+// the limits taken, to be released if the claim is not sent after all, and the scope of the exhausted
+// rate limit, or empty if the claim can be sent now
+func (tm *ClaimTxManager) acquireClaimRateLimits(ctx context.Context, mTx ctmtypes.MonitoredTx, dbTx pgx.Tx) ([]claimRateLimit, string, error) {
+	cfg := tm.rateLimit.Get()
+	if !cfg.Enabled || tm.redisStorage == nil {
+		return nil, "", nil
+	}
+	deposit, err := tm.storage.GetDeposit(ctx, mTx.DepositID, tm.depositNetworkID, dbTx)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to get the deposit of the monitored tx")
+	}
+	// the message bridges of the known tokens are limited as the bridged token and the actual receiver
+	bridged := *deposit
+	messagebridge.ReplaceDepositInfo(&bridged, true)
+	receiver := *deposit
+	messagebridge.ReplaceDepositDestAddresses(&receiver)
+
+	limits := claimRateLimits(cfg, tm.l2NetworkID, &bridged, receiver.DestinationAddress)
+	for i, l := range limits {
+		allowed, count, err := tm.redisStorage.IncrRateLimit(ctx, l.key, l.limit, l.window)
+		if err == nil && l.scope == rateLimitScopeGlobal {
+			metrics.RecordClaimGlobalBudget(uint32(tm.l2NetworkID), count)
+		}
+		if err != nil || !allowed {
+			tm.releaseClaimRateLimits(ctx, limits[:i])
+			if err != nil {
+				return nil, "", errors.Wrapf(err, "failed to increment the %s rate limit", l.scope)
+			}
+			metrics.RecordClaimRateLimited(uint32(tm.l2NetworkID), l.scope)
+			return nil, l.scope, nil
+		}
+	}
+	return limits, "", nil
+}
+
+// releaseClaimRateLimits gives back the units taken from the rate limits
+func (tm *ClaimTxManager) releaseClaimRateLimits(ctx context.Context, limits []claimRateLimit) {
+	for _, l := range limits {
+		err := tm.redisStorage.DecrRateLimit(ctx, l.key, l.window)
+		if err != nil {
+			log.Warnf("failed to release the %s rate limit %s: %v", l.scope, l.key, err)
+		}
+	}
+}
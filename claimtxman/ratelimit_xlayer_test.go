@@ -0,0 +1,65 @@
+package claimtxman
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimRateLimits(t *testing.T) {
+	deposit := &etherman.Deposit{
+		OriginalNetwork: 0,
+		OriginalAddress: common.HexToAddress("0x1"),
+	}
+	receiver := common.HexToAddress("0x2")
+
+	cfg := RateLimitConfig{Enabled: true}
+	assert.Empty(t, claimRateLimits(cfg, 1, deposit, receiver))
+
+	// the address limits need a window
+	cfg.PerDestinationAddress = 5
+	cfg.PerOriginAddress = 10
+	cfg.GlobalPerMinute = 100
+	limits := claimRateLimits(cfg, 1, deposit, receiver)
+	require.Len(t, limits, 1)
+	assert.Equal(t, rateLimitScopeGlobal, limits[0].scope)
+
+	cfg.Window = types.NewDuration(time.Hour)
+	limits = claimRateLimits(cfg, 1, deposit, receiver)
+	require.Len(t, limits, 3)
+	assert.Equal(t, claimRateLimit{
+		scope:  rateLimitScopeDestination,
+		key:    "claim_1_destination_" + receiver.Hex(),
+		limit:  5,
+		window: time.Hour,
+	}, limits[0])
+	assert.Equal(t, claimRateLimit{
+		scope:  rateLimitScopeOrigin,
+		key:    "claim_1_origin_0_" + deposit.OriginalAddress.Hex(),
+		limit:  10,
+		window: time.Hour,
+	}, limits[1])
+	assert.Equal(t, claimRateLimit{
+		scope:  rateLimitScopeGlobal,
+		key:    "claim_1_global",
+		limit:  100,
+		window: time.Minute,
+	}, limits[2])
+}
+
+func TestRateLimitConfigJSON(t *testing.T) {
+	var cfg RateLimitConfig
+	err := json.Unmarshal([]byte(`{"enabled": true, "window": "30m", "perDestinationAddress": 3, "globalPerMinute": 60}`), &cfg)
+	require.NoError(t, err)
+	assert.True(t, cfg.Enabled)
+	assert.Equal(t, 30*time.Minute, cfg.Window.Duration)
+	assert.Equal(t, uint64(3), cfg.PerDestinationAddress)
+	assert.Zero(t, cfg.PerOriginAddress)
+	assert.Equal(t, uint64(60), cfg.GlobalPerMinute)
+}
@@ -0,0 +1,204 @@
+package claimtxman
+
+import (
+	"context"
+	"strings"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
+	ctmtypes "github.com/0xPolygonHermez/zkevm-bridge-service/claimtxman/types"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/metrics"
+	"github.com/0xPolygonHermez/zkevm-node/etherman/smartcontracts/polygonzkevmbridge"
+	"github.com/0xPolygonHermez/zkevm-node/state/runtime"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+)
+
+const (
+	// revertAlreadyClaimed is the bridge error returned when the deposit was already claimed
+	revertAlreadyClaimed = "AlreadyClaimed"
+)
+
+var (
+	// bridgeErrors maps the selectors of the bridge contract custom errors to their names
+	bridgeErrors = loadBridgeErrors()
+
+	// retryableReverts are the bridge errors that may not happen anymore later, so the claim
+	// is kept to be simulated again in the next cycle
+	retryableReverts = map[string]bool{
+		"GlobalExitRootInvalid": true,
+		"OnlyNotEmergencyState": true,
+	}
+)
+
+func loadBridgeErrors() map[[4]byte]string {
+	bridgeABI, err := polygonzkevmbridge.PolygonzkevmbridgeMetaData.GetAbi()
+	if err != nil {
+		log.Errorf("failed to parse the bridge abi, the custom errors won't be decoded: %v", err)
+		return nil
+	}
+	errs := make(map[[4]byte]string, len(bridgeABI.Errors))
+	for name, e := range bridgeABI.Errors {
+		var selector [4]byte
+		copy(selector[:], e.ID[:4])
+		errs[selector] = name
+	}
+	return errs
+}
+
+// decodeRevertData returns the name of the bridge custom error or the message of the
+// Error(string) revert encoded in the data, empty if it can't be decoded
+func decodeRevertData(data []byte) string {
+	if len(data) < 4 { //nolint:gomnd
+		return ""
+	}
+	var selector [4]byte
+	copy(selector[:], data[:4])
+	if name, ok := bridgeErrors[selector]; ok {
+		return name
+	}
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return reason
+	}
+	return ""
+}
+
+// decodeRevertError returns the reason of a reverted call and whether the error is a revert
+func decodeRevertError(err error) (string, bool) {
+	if err == nil {
+		return "", false
+	}
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		if hexData, ok := dataErr.ErrorData().(string); ok {
+			data, decodeErr := hexutil.Decode(hexData)
+			if decodeErr == nil {
+				if reason := decodeRevertData(data); reason != "" {
+					return reason, true
+				}
+			}
+		}
+	}
+	if strings.Contains(err.Error(), runtime.ErrExecutionReverted.Error()) {
+		return err.Error(), true
+	}
+	return "", false
+}
+
+// revertMetricLabel returns the bridge error name to be used as metric label, the other
+// reasons are grouped to keep the label cardinality low
+func revertMetricLabel(reason string) string {
+	for _, name := range bridgeErrors {
+		if name == reason {
+			return reason
+		}
+	}
+	return "other"
+}
+
+// simulateClaim executes the claim with eth_call against the latest state before it's broadcast.
+// It returns the decoded reason if the claim would revert, empty otherwise
+func (tm *ClaimTxManager) simulateClaim(ctx context.Context, mTx ctmtypes.MonitoredTx) (string, error) {
+	msg := ethereum.CallMsg{
+		From:  mTx.From,
+		To:    mTx.To,
+		Value: mTx.Value,
+		Data:  mTx.Data,
+	}
+	_, err := tm.l2Node.CallContract(ctx, msg, nil)
+	if err == nil {
+		return "", nil
+	}
+	if reason, ok := decodeRevertError(err); ok {
+		return reason, nil
+	}
+	return "", errors.Wrap(err, "CallContract err")
+}
+
+// decodeReceiptRevert replays the failed tx at the block it was mined to get the reason why it was
+// reverted, empty if it can't be known
+func (tm *ClaimTxManager) decodeReceiptRevert(ctx context.Context, mTx ctmtypes.MonitoredTx, receipt *types.Receipt) string {
+	if receipt == nil {
+		return ""
+	}
+	msg := ethereum.CallMsg{
+		From:  mTx.From,
+		To:    mTx.To,
+		Value: mTx.Value,
+		Data:  mTx.Data,
+		Gas:   mTx.Gas,
+	}
+	_, err := tm.l2Node.CallContract(ctx, msg, receipt.BlockNumber)
+	reason, _ := decodeRevertError(err)
+	return reason
+}
+
+// preflightMonitoredTx simulates the claim and returns whether it can be sent. If it would revert,
+// the monitored tx is confirmed when the deposit is already claimed, kept for the next cycle when the
+// revert may be temporary, or moved to the dead-letter status otherwise
+func (tm *ClaimTxManager) preflightMonitoredTx(ctx context.Context, mTx *ctmtypes.MonitoredTx, dbTx pgx.Tx) bool {
+	mTxLog := log.WithFields("monitoredTx", mTx.DepositID)
+	reason, err := tm.simulateClaim(ctx, *mTx)
+	if err != nil {
+		mTxLog.Errorf("failed to simulate the claim: %v", err)
+		return false
+	}
+	if reason == "" {
+		return true
+	}
+	metrics.RecordClaimSimulationRevert(revertMetricLabel(reason))
+	switch {
+	case reason == revertAlreadyClaimed:
+		mTxLog.Infof("simulation shows the deposit has already been claimed")
+		mTx.Status = ctmtypes.MonitoredTxStatusConfirmed
+		err = tm.storage.UpdateClaimTx(ctx, *mTx, dbTx)
+		if err != nil {
+			mTxLog.Errorf("failed to update tx status to confirmed: %v", err)
+		}
+		metrics.RecordMonitoredTxsResult(string(mTx.Status))
+	case retryableReverts[reason]:
+		mTxLog.Warnf("simulation reverted with %s, the claim will be simulated again later", reason)
+		if mTx.RevertReason != reason {
+			mTx.RevertReason = reason
+			err = tm.storage.UpdateClaimTx(ctx, *mTx, dbTx)
+			if err != nil {
+				mTxLog.Errorf("failed to update the revert reason: %v", err)
+			}
+		}
+	default:
+		mTxLog.Infof("moved to dead-letter because the simulation reverted with %s", reason)
+		tm.moveToDeadLetter(ctx, mTx, reason, dbTx)
+	}
+	return false
+}
+
+// moveToDeadLetter parks the monitored tx with the revert reason until an operator reviews it,
+// and notifies the user that the deposit must be claimed manually
+func (tm *ClaimTxManager) moveToDeadLetter(ctx context.Context, mTx *ctmtypes.MonitoredTx, reason string, dbTx pgx.Tx) {
+	mTxLog := log.WithFields("monitoredTx", mTx.DepositID)
+	mTx.Status = ctmtypes.MonitoredTxStatusDeadLetter
+	mTx.RevertReason = reason
+	// update monitored tx changes into storage
+	err := tm.storage.UpdateClaimTx(ctx, *mTx, dbTx)
+	if err != nil {
+		mTxLog.Errorf("failed to update monitored tx to dead-letter: %v", err)
+	}
+	metrics.RecordMonitoredTxsResult(string(mTx.Status))
+
+	// Notify FE that tx is pending user claim
+	// Retrieve the deposit info from the network it was made
+	deposit, err := tm.storage.GetDeposit(ctx, mTx.DepositID, tm.depositNetworkID, dbTx)
+	if err != nil {
+		mTxLog.Errorf("push message: GetDeposit error: %v", err)
+		return
+	}
+	err = tm.addPushMessage(deposit, uint32(pb.TransactionStatus_TX_PENDING_USER_CLAIM), dbTx)
+	if err != nil {
+		mTxLog.Errorf("failed to add the push message: %v", err)
+	}
+}
@@ -0,0 +1,59 @@
+package claimtxman
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type revertDataError struct {
+	data string
+}
+
+func (e revertDataError) Error() string          { return "execution reverted" }
+func (e revertDataError) ErrorCode() int         { return 3 } //nolint:gomnd
+func (e revertDataError) ErrorData() interface{} { return e.data }
+
+func TestDecodeRevertData(t *testing.T) {
+	alreadyClaimed := crypto.Keccak256([]byte("AlreadyClaimed()"))[:4]
+	assert.Equal(t, revertAlreadyClaimed, decodeRevertData(alreadyClaimed))
+
+	invalidProof := crypto.Keccak256([]byte("InvalidSmtProof()"))[:4]
+	assert.Equal(t, "InvalidSmtProof", decodeRevertData(invalidProof))
+
+	// Error(string) with the message "not enough balance"
+	errorString := hexutil.MustDecode("0x08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000012" +
+		"6e6f7420656e6f7567682062616c616e63650000000000000000000000000000")
+	assert.Equal(t, "not enough balance", decodeRevertData(errorString))
+
+	assert.Empty(t, decodeRevertData([]byte{0x01, 0x02}))
+	assert.Empty(t, decodeRevertData([]byte{0x01, 0x02, 0x03, 0x04}))
+}
+
+func TestDecodeRevertError(t *testing.T) {
+	reason, ok := decodeRevertError(nil)
+	assert.False(t, ok)
+	assert.Empty(t, reason)
+
+	data := hexutil.Encode(crypto.Keccak256([]byte("GlobalExitRootInvalid()"))[:4])
+	reason, ok = decodeRevertError(errors.Wrap(revertDataError{data: data}, "call failed"))
+	assert.True(t, ok)
+	assert.Equal(t, "GlobalExitRootInvalid", reason)
+
+	reason, ok = decodeRevertError(errors.New("execution reverted"))
+	assert.True(t, ok)
+	assert.Equal(t, "execution reverted", reason)
+
+	_, ok = decodeRevertError(errors.New("connection refused"))
+	assert.False(t, ok)
+}
+
+func TestRevertMetricLabel(t *testing.T) {
+	assert.Equal(t, revertAlreadyClaimed, revertMetricLabel(revertAlreadyClaimed))
+	assert.Equal(t, "other", revertMetricLabel("execution reverted: not enough balance"))
+}
@@ -0,0 +1,162 @@
+package claimtxman
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/config/apolloconfig"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils"
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrNoActiveSigner is returned when all the claim signers are draining
+	ErrNoActiveSigner = errors.New("there is no active claim signer")
+)
+
+// claimSigner is a key used to sign claim txs. Every signer has its own nonce lane,
+// the nonces are cached per address and the monitored txs are partitioned by the
+// from address, so a signer only signs the txs it created
+type claimSigner struct {
+	auth        *bind.TransactOpts
+	nonceMutex  sync.Mutex
+	drained     bool
+	underfunded bool
+}
+
+// signerPool is the set of keys used to sign the claim txs. The new claims are spread
+// across the active signers in a round-robin way. A draining signer doesn't get new
+// claims but keeps monitoring its pending txs until all of them are finished. An
+// underfunded signer doesn't get new claims until its balance is restored
+type signerPool struct {
+	mutex    sync.Mutex
+	signers  []*claimSigner
+	next     int
+	draining apolloconfig.Entry[[]string]
+}
+
+// newSignerPool loads the keystore files of the pool. If no pool is configured, the single
+// PrivateKey is used
+func newSignerPool(ctx context.Context, client *utils.Client, cfg Config) (*signerPool, error) {
+	keys := cfg.PrivateKeys
+	if len(keys) == 0 {
+		keys = []types.KeystoreFileConfig{cfg.PrivateKey}
+	}
+	pool := &signerPool{
+		draining: apolloconfig.NewStringSliceEntry("claimtxman.drainingSigners", addressesToStrings(cfg.DrainingSigners)),
+	}
+	for _, ks := range keys {
+		auth, err := client.GetSignerFromKeystore(ctx, ks)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load claim signer keystore %s", ks.Path)
+		}
+		if pool.get(auth.From) != nil {
+			log.Warnf("claim signer %s is duplicated in the pool, ignoring it", auth.From.String())
+			continue
+		}
+		log.Infof("claim signer %s added to the pool", auth.From.String())
+		pool.signers = append(pool.signers, &claimSigner{auth: auth})
+	}
+	return pool, nil
+}
+
+// first returns the first signer of the pool
+func (p *signerPool) first() *claimSigner {
+	return p.signers[0]
+}
+
+// get returns the signer of the address, nil if it's not in the pool
+func (p *signerPool) get(from common.Address) *claimSigner {
+	for _, s := range p.signers {
+		if s.auth.From == from {
+			return s
+		}
+	}
+	return nil
+}
+
+// pick returns the next active signer that should be used for a new claim. If all the signers that aren't
+// draining are underfunded, one of them is returned, so the claim is deferred: the monitor doesn't send it
+// until the signer is funded again
+func (p *signerPool) pick() (*claimSigner, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	underfunded := -1
+	for i := 0; i < len(p.signers); i++ {
+		idx := p.next % len(p.signers)
+		s := p.signers[idx]
+		p.next = (p.next + 1) % len(p.signers)
+		if p.isDraining(s.auth.From) {
+			continue
+		}
+		if !s.underfunded {
+			return s, nil
+		}
+		if underfunded < 0 {
+			underfunded = idx
+		}
+	}
+	if underfunded < 0 {
+		return nil, ErrNoActiveSigner
+	}
+	p.next = (underfunded + 1) % len(p.signers)
+	return p.signers[underfunded], nil
+}
+
+// setUnderfunded updates the funding state of the signer, it returns whether the state changed
+func (p *signerPool) setUnderfunded(s *claimSigner, underfunded bool) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if s.underfunded == underfunded {
+		return false
+	}
+	s.underfunded = underfunded
+	return true
+}
+
+// isUnderfunded returns whether the signer balance is below the threshold
+func (p *signerPool) isUnderfunded(s *claimSigner) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return s.underfunded
+}
+
+// isDraining returns whether the signer must not get new claims
+func (p *signerPool) isDraining(from common.Address) bool {
+	for _, addr := range p.draining.Get() {
+		if strings.EqualFold(addr, from.String()) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkDrained logs the draining signers that have no pending monitored txs anymore,
+// so they can be safely removed from the configuration
+func (p *signerPool) checkDrained(pendingBySigner map[common.Address]int) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for _, s := range p.signers {
+		if !p.isDraining(s.auth.From) {
+			s.drained = false
+			continue
+		}
+		if pendingBySigner[s.auth.From] == 0 && !s.drained {
+			s.drained = true
+			log.Infof("claim signer %s is drained, it can be removed from the pool", s.auth.From.String())
+		}
+	}
+}
+
+func addressesToStrings(addrs []common.Address) []string {
+	result := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		result = append(result, addr.String())
+	}
+	return result
+}
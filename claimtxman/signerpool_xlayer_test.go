@@ -0,0 +1,64 @@
+package claimtxman
+
+import (
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/config/apolloconfig"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignerPoolPick(t *testing.T) {
+	addrs := []common.Address{
+		common.HexToAddress("0x1"),
+		common.HexToAddress("0x2"),
+		common.HexToAddress("0x3"),
+	}
+	pool := &signerPool{
+		draining: apolloconfig.NewStringSliceEntry("test.drainingSigners", addressesToStrings(addrs[1:2])),
+	}
+	for _, addr := range addrs {
+		pool.signers = append(pool.signers, &claimSigner{auth: &bind.TransactOpts{From: addr}})
+	}
+
+	// The draining signer is skipped
+	for _, expected := range []common.Address{addrs[0], addrs[2], addrs[0], addrs[2]} {
+		s, err := pool.pick()
+		require.NoError(t, err)
+		assert.Equal(t, expected, s.auth.From)
+	}
+
+	// The draining signer is still available to monitor its txs
+	assert.NotNil(t, pool.get(addrs[1]))
+	assert.Nil(t, pool.get(common.HexToAddress("0x4")))
+
+	pool.checkDrained(map[common.Address]int{addrs[1]: 1})
+	assert.False(t, pool.get(addrs[1]).drained)
+	pool.checkDrained(map[common.Address]int{})
+	assert.True(t, pool.get(addrs[1]).drained)
+
+	// The underfunded signer is skipped until it is funded again
+	assert.True(t, pool.setUnderfunded(pool.get(addrs[0]), true))
+	assert.False(t, pool.setUnderfunded(pool.get(addrs[0]), true))
+	for i := 0; i < 2; i++ {
+		s, err := pool.pick()
+		require.NoError(t, err)
+		assert.Equal(t, addrs[2], s.auth.From)
+	}
+
+	// The claims are deferred to the underfunded signers if all of them are underfunded
+	assert.True(t, pool.setUnderfunded(pool.get(addrs[2]), true))
+	for _, expected := range []common.Address{addrs[0], addrs[2], addrs[0]} {
+		s, err := pool.pick()
+		require.NoError(t, err)
+		assert.Equal(t, expected, s.auth.From)
+	}
+	assert.True(t, pool.setUnderfunded(pool.get(addrs[0]), false))
+	assert.False(t, pool.isUnderfunded(pool.get(addrs[0])))
+
+	pool.draining = apolloconfig.NewStringSliceEntry("test.drainingSigners", addressesToStrings(addrs))
+	_, err := pool.pick()
+	assert.ErrorIs(t, err, ErrNoActiveSigner)
+}
@@ -21,6 +21,14 @@ const (
 	// MonitoredTxStatusConfirmed means the tx was already mined and the receipt
 	// status is Successful
 	MonitoredTxStatusConfirmed = MonitoredTxStatus("confirmed")
+
+	// MonitoredTxStatusDeadLetter means the tx couldn't be claimed automatically and
+	// it's parked with the revert reason until an operator requeues, cancels or resends it
+	MonitoredTxStatusDeadLetter = MonitoredTxStatus("dead_letter")
+
+	// MonitoredTxStatusCanceled means the claim was canceled by an operator, the nonce
+	// of its pending tx, if any, was filled with an empty tx
+	MonitoredTxStatusCanceled = MonitoredTxStatus("canceled")
 )
 
 var (
@@ -36,12 +44,22 @@ func (s MonitoredTxStatus) String() string {
 	return string(s)
 }
 
+// IsAutoClaimStopped returns whether the claim tx manager stopped trying to claim the deposit,
+// so the user may need to claim it manually
+func (s MonitoredTxStatus) IsAutoClaimStopped() bool {
+	return s == MonitoredTxStatusFailed || s == MonitoredTxStatusDeadLetter || s == MonitoredTxStatusCanceled
+}
+
 // MonitoredTx represents a set of information used to build tx
 // plus information to monitor if the transactions was sent successfully
 type MonitoredTx struct {
 	// DepositID is the tx identifier controller by the caller
 	DepositID uint
 
+	// NetworkID is the network where the claim is sent, the monitored txs are keyed by
+	// the deposit id and the destination network
+	NetworkID uint
+
 	// From is a sender of the tx, used to identify which private key should be used to sing the tx
 	From common.Address
 
@@ -63,6 +81,13 @@ type MonitoredTx struct {
 	// GasPrice is the tx gas price
 	GasPrice *big.Int
 
+	// GasTipCap is the max priority fee per gas of a dynamic fee tx
+	GasTipCap *big.Int
+
+	// GasFeeCap is the max fee per gas of a dynamic fee tx, when it is set
+	// the tx is built as a DynamicFeeTx instead of a LegacyTx
+	GasFeeCap *big.Int
+
 	// Status of this monitoring
 	Status MonitoredTxStatus
 
@@ -76,10 +101,70 @@ type MonitoredTx struct {
 
 	// UpdatedAt last date time it was updated
 	UpdatedAt time.Time
+
+	// EscalationCount is the number of times a pending tx was replaced
+	// by a higher priced one using the same nonce
+	EscalationCount uint64
+
+	// LastTxHash is the hash of the last tx sent to the network
+	LastTxHash common.Hash
+
+	// LastSentAt date time the last tx was sent to the network
+	LastSentAt time.Time
+
+	// LastSentBlock is the network block number when the last tx was sent
+	LastSentBlock uint64
+
+	// RevertReason is the reason why the claim was moved to the dead-letter status
+	RevertReason string
+}
+
+// MonitoredTxAttempt represents a tx sent to the network for a monitored tx
+type MonitoredTxAttempt struct {
+	// DepositID is the monitored tx identifier
+	DepositID uint
+
+	// NetworkID is the destination network of the monitored tx
+	NetworkID uint
+
+	// TxHash is the hash of the signed tx
+	TxHash common.Hash
+
+	// Nonce used to sign the tx
+	Nonce uint64
+
+	// GasPrice is the gas price of a legacy tx
+	GasPrice *big.Int
+
+	// GasTipCap is the max priority fee per gas of a dynamic fee tx
+	GasTipCap *big.Int
+
+	// GasFeeCap is the max fee per gas of a dynamic fee tx
+	GasFeeCap *big.Int
+
+	// BlockNumber is the network block number when the tx was sent
+	BlockNumber uint64
+
+	// Escalation is true when the tx replaced a pending tx with the same nonce
+	Escalation bool
+
+	// CreatedAt date time it was sent
+	CreatedAt time.Time
 }
 
 // Tx uses the current information to build a tx
 func (mTx MonitoredTx) Tx() *types.Transaction {
+	if mTx.IsDynamicFee() {
+		return types.NewTx(&types.DynamicFeeTx{
+			To:        mTx.To,
+			Nonce:     mTx.Nonce,
+			Value:     mTx.Value,
+			Data:      mTx.Data,
+			Gas:       mTx.Gas,
+			GasTipCap: mTx.GasTipCap,
+			GasFeeCap: mTx.GasFeeCap,
+		})
+	}
 	tx := types.NewTx(&types.LegacyTx{
 		To:       mTx.To,
 		Nonce:    mTx.Nonce,
@@ -92,6 +177,11 @@ func (mTx MonitoredTx) Tx() *types.Transaction {
 	return tx
 }
 
+// IsDynamicFee returns whether the tx is sent as an EIP-1559 dynamic fee tx
+func (mTx MonitoredTx) IsDynamicFee() bool {
+	return mTx.GasFeeCap != nil
+}
+
 // AddHistory adds a transaction to the monitoring history
 func (mTx MonitoredTx) AddHistory(tx *types.Transaction) error {
 	if _, found := mTx.History[tx.Hash()]; found {
@@ -106,6 +196,23 @@ func (mTx MonitoredTx) RemoveHistory(tx *types.Transaction) {
 	delete(mTx.History, tx.Hash())
 }
 
+// IsReplaced returns whether the tx hash belongs to an attempt that was already
+// replaced by a higher priced one
+func (mTx MonitoredTx) IsReplaced(txHash common.Hash) bool {
+	return mTx.EscalationCount > 0 && txHash != mTx.LastTxHash
+}
+
+// Reset clears the history of the tx so it's claimed again from scratch with a new nonce
+func (mTx *MonitoredTx) Reset() {
+	mTx.Status = MonitoredTxStatusCreated
+	mTx.History = make(map[common.Hash]bool)
+	mTx.EscalationCount = 0
+	mTx.LastTxHash = common.Hash{}
+	mTx.LastSentAt = time.Time{}
+	mTx.LastSentBlock = 0
+	mTx.RevertReason = ""
+}
+
 // HistoryHashSlice returns the current history field as a string slice
 func (mTx *MonitoredTx) HistoryHashSlice() [][]byte {
 	history := make([][]byte, 0, len(mTx.History))
@@ -45,3 +45,44 @@ func TestHistoryHashSlice(t *testing.T) {
 	assert.Equal(t, txs[1].Hash(), common.BytesToHash(history[0]))
 	t.Log("TEST3: ", txs[1].Hash(), common.BytesToHash(history[0]))
 }
+
+func TestTxDynamicFee(t *testing.T) {
+	to := common.HexToAddress("0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266")
+	mTx := MonitoredTx{
+		To:       &to,
+		Nonce:    1,
+		Value:    big.NewInt(0),
+		Gas:      100000,
+		GasPrice: big.NewInt(1000000000),
+	}
+	tx := mTx.Tx()
+	assert.Equal(t, uint8(types.LegacyTxType), tx.Type())
+	assert.Equal(t, mTx.GasPrice, tx.GasPrice())
+
+	mTx.GasPrice = nil
+	mTx.GasTipCap = big.NewInt(1000000000)
+	mTx.GasFeeCap = big.NewInt(3000000000)
+	tx = mTx.Tx()
+	assert.Equal(t, uint8(types.DynamicFeeTxType), tx.Type())
+	assert.Equal(t, mTx.GasTipCap, tx.GasTipCap())
+	assert.Equal(t, mTx.GasFeeCap, tx.GasFeeCap())
+	assert.Equal(t, mTx.Nonce, tx.Nonce())
+}
+
+func TestReset(t *testing.T) {
+	mTx := MonitoredTx{
+		Status:          MonitoredTxStatusDeadLetter,
+		History:         map[common.Hash]bool{common.HexToHash("0x01"): true},
+		EscalationCount: 2,
+		LastTxHash:      common.HexToHash("0x01"),
+		LastSentBlock:   10,
+		RevertReason:    "execution reverted",
+	}
+	mTx.Reset()
+	assert.Equal(t, MonitoredTxStatusCreated, mTx.Status)
+	assert.Empty(t, mTx.History)
+	assert.Equal(t, uint64(0), mTx.EscalationCount)
+	assert.Equal(t, common.Hash{}, mTx.LastTxHash)
+	assert.Equal(t, uint64(0), mTx.LastSentBlock)
+	assert.Empty(t, mTx.RevertReason)
+}
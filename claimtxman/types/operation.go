@@ -0,0 +1,103 @@
+package types
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// MonitoredTxActionRequeue claims the deposit again from scratch with a new nonce
+	MonitoredTxActionRequeue = MonitoredTxAction("requeue")
+
+	// MonitoredTxActionCancel stops claiming the deposit, if a tx is still pending its
+	// nonce is filled with an empty tx
+	MonitoredTxActionCancel = MonitoredTxAction("cancel")
+
+	// MonitoredTxActionResend replaces the pending tx with a higher priced one right away,
+	// or requeues the claim if there is no pending tx
+	MonitoredTxActionResend = MonitoredTxAction("resend")
+
+	// MonitoredTxOperationStatusPending means the operation is waiting for the claim tx manager
+	MonitoredTxOperationStatusPending = MonitoredTxOperationStatus("pending")
+
+	// MonitoredTxOperationStatusDone means the operation was executed
+	MonitoredTxOperationStatusDone = MonitoredTxOperationStatus("done")
+
+	// MonitoredTxOperationStatusRejected means the operation can't be applied to the monitored tx
+	MonitoredTxOperationStatusRejected = MonitoredTxOperationStatus("rejected")
+
+	// MonitoredTxOperationStatusFailed means the operation failed while being executed
+	MonitoredTxOperationStatusFailed = MonitoredTxOperationStatus("failed")
+)
+
+// MonitoredTxAction is an action requested by an operator on a monitored tx
+type MonitoredTxAction string
+
+// String returns a string representation of the action
+func (a MonitoredTxAction) String() string {
+	return string(a)
+}
+
+// IsValid returns whether the action is known
+func (a MonitoredTxAction) IsValid() bool {
+	return a == MonitoredTxActionRequeue || a == MonitoredTxActionCancel || a == MonitoredTxActionResend
+}
+
+// IsAllowed returns whether the action can be applied to a monitored tx with the status
+func (a MonitoredTxAction) IsAllowed(status MonitoredTxStatus) bool {
+	switch a {
+	case MonitoredTxActionRequeue:
+		return status == MonitoredTxStatusFailed || status == MonitoredTxStatusDeadLetter || status == MonitoredTxStatusCanceled
+	case MonitoredTxActionCancel, MonitoredTxActionResend:
+		return status == MonitoredTxStatusCreated || status == MonitoredTxStatusFailed || status == MonitoredTxStatusDeadLetter
+	default:
+		return false
+	}
+}
+
+// MonitoredTxOperationStatus represents the status of an operation
+type MonitoredTxOperationStatus string
+
+// String returns a string representation of the status
+func (s MonitoredTxOperationStatus) String() string {
+	return string(s)
+}
+
+// MonitoredTxOperation is an action requested by an operator on a monitored tx. The
+// operations are executed by the claim tx manager that owns the signer of the tx and
+// they are kept as the audit trail of the monitored tx
+type MonitoredTxOperation struct {
+	// ID is the operation identifier
+	ID uint64
+
+	// DepositID is the monitored tx identifier
+	DepositID uint
+
+	// NetworkID is the destination network of the monitored tx
+	NetworkID uint
+
+	// Action requested
+	Action MonitoredTxAction
+
+	// Operator is who requested the action
+	Operator string
+
+	// Reason is why the action was requested
+	Reason string
+
+	// Status of the operation
+	Status MonitoredTxOperationStatus
+
+	// Result describes the outcome of the operation
+	Result string
+
+	// TxHash is the hash of the tx sent to execute the operation, if any
+	TxHash common.Hash
+
+	// CreatedAt date time it was requested
+	CreatedAt time.Time
+
+	// UpdatedAt last date time it was updated
+	UpdatedAt time.Time
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMonitoredTxActionIsAllowed(t *testing.T) {
+	testCases := []struct {
+		action  MonitoredTxAction
+		status  MonitoredTxStatus
+		allowed bool
+	}{
+		{MonitoredTxActionRequeue, MonitoredTxStatusDeadLetter, true},
+		{MonitoredTxActionRequeue, MonitoredTxStatusFailed, true},
+		{MonitoredTxActionRequeue, MonitoredTxStatusCanceled, true},
+		{MonitoredTxActionRequeue, MonitoredTxStatusCreated, false},
+		{MonitoredTxActionRequeue, MonitoredTxStatusConfirmed, false},
+		{MonitoredTxActionCancel, MonitoredTxStatusCreated, true},
+		{MonitoredTxActionCancel, MonitoredTxStatusDeadLetter, true},
+		{MonitoredTxActionCancel, MonitoredTxStatusCanceled, false},
+		{MonitoredTxActionCancel, MonitoredTxStatusConfirmed, false},
+		{MonitoredTxActionResend, MonitoredTxStatusCreated, true},
+		{MonitoredTxActionResend, MonitoredTxStatusConfirmed, false},
+		{MonitoredTxAction("unknown"), MonitoredTxStatusCreated, false},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.allowed, tc.action.IsAllowed(tc.status), "%s on %s", tc.action, tc.status)
+	}
+	assert.True(t, MonitoredTxActionResend.IsValid())
+	assert.False(t, MonitoredTxAction("unknown").IsValid())
+}
@@ -37,6 +37,37 @@ func main() {
 		},
 	}
 
+	snapshotFileFlag := &cli.StringFlag{
+		Name:     flagFile,
+		Aliases:  []string{"f"},
+		Usage:    "Snapshot `FILE`",
+		Required: true,
+	}
+
+	verifyFlags := []cli.Flag{
+		&cli.UintFlag{
+			Name:  flagSamples,
+			Usage: "Number of deposits of each network whose roots are checked against the storage and the bridge",
+			Value: 100, //nolint:gomnd
+		},
+		&cli.UintFlag{
+			Name:  flagClaims,
+			Usage: "Number of random claims of each network checked against the bridge",
+			Value: 100, //nolint:gomnd
+		},
+		&cli.UintFlag{
+			Name:  flagExitRoots,
+			Usage: "Number of random L1 global exit roots checked against the global exit root manager",
+			Value: 100, //nolint:gomnd
+		},
+		&cli.StringFlag{
+			Name:    flagOutput,
+			Aliases: []string{"o"},
+			Usage:   "Report `FILE`, - for the standard output",
+			Value:   stdoutFileName,
+		},
+	}
+
 	app.Commands = []*cli.Command{
 		{
 			Name:    "version",
@@ -72,6 +103,27 @@ func main() {
 			Action:  runPushTask,
 			Flags:   flags,
 		},
+		{
+			Name:    "snapshot",
+			Aliases: []string{},
+			Usage:   "Export the synced state (blocks, deposits, claims, exit roots and merkle trees) to a snapshot file",
+			Action:  snapshotCmd,
+			Flags:   append(flags, snapshotFileFlag),
+		},
+		{
+			Name:    "restore",
+			Aliases: []string{},
+			Usage:   "Restore a snapshot file into a new database, the sync resumes from the last block of the snapshot",
+			Action:  restoreCmd,
+			Flags:   append(flags, snapshotFileFlag),
+		},
+		{
+			Name:    "verify",
+			Aliases: []string{},
+			Usage:   "Check the synced trees, claims and exit roots against the chains and write a report of the discrepancies",
+			Action:  verifyCmd,
+			Flags:   append(flags, verifyFlags...),
+		},
 	}
 
 	err := app.Run(os.Args)
@@ -0,0 +1,364 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/claimtxman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/config"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/messagepush"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/networkregistry"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/redisstorage"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils"
+	"github.com/0xPolygonHermez/zkevm-node/jsonrpc/client"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// newNetworkRegistry creates the registry with the L1 network and the L2 networks of the configuration. It
+// returns the L2 networks in the order of the configuration and their ethermans, the first one is the
+// rollup of the service
+func newNetworkRegistry(ctx context.Context, c *config.Config, l1Etherman *etherman.Client) (*networkregistry.Registry, []networkregistry.Network, []*etherman.Client, error) {
+	l1NetworkID, err := l1Etherman.GetNetworkID(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	log.Infof("main network id: %d", l1NetworkID)
+	registry := networkregistry.NewRegistry()
+	err = registry.Add(networkregistry.Network{
+		ID:            l1NetworkID,
+		ChainID:       c.Etherman.L1ChainId,
+		RPCURL:        c.Etherman.L1URL,
+		WSURL:         c.Etherman.L1WSURL,
+		BridgeAddress: c.NetworkConfig.PolygonBridgeAddress,
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	l2Networks := append([]networkregistry.Network{}, c.NetworkRegistry.Networks...)
+	legacy := len(l2Networks) == 0
+	if legacy {
+		if l2Networks, err = legacyL2Networks(c); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	if len(l2Networks) == 0 {
+		return nil, nil, nil, fmt.Errorf("no L2 network configured")
+	}
+	var l2Ethermans []*etherman.Client
+	for i := range l2Networks {
+		l2Etherman, networkID, err := newL2Etherman(ctx, l2Networks[i], l1Etherman.GetRollupID())
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		// The network IDs of the legacy configuration are read from the bridge contracts
+		if legacy {
+			l2Networks[i].ID = networkID
+		} else if networkID != l2Networks[i].ID {
+			return nil, nil, nil, fmt.Errorf("the bridge of network %d at %s has network ID %d", l2Networks[i].ID, l2Networks[i].RPCURL, networkID)
+		}
+		log.Infof("l2 network id: %d", networkID)
+		if err = registry.Add(l2Networks[i]); err != nil {
+			return nil, nil, nil, err
+		}
+		l2Ethermans = append(l2Ethermans, l2Etherman)
+	}
+	registry.SetRollupNetworkID(l2Networks[0].ID)
+	return registry, l2Networks, l2Ethermans, nil
+}
+
+// legacyL2Networks returns the L2 networks of the parallel arrays of the configuration, their IDs are not known
+func legacyL2Networks(c *config.Config) ([]networkregistry.Network, error) {
+	if len(c.L2PolygonBridgeAddresses) != len(c.Etherman.L2URLs) {
+		return nil, fmt.Errorf("environment configuration error. zkevm bridge addresses and zkevm node urls mismatch")
+	}
+	var networks []networkregistry.Network
+	for i, url := range c.Etherman.L2URLs {
+		n := networkregistry.Network{RPCURL: url, BridgeAddress: c.L2PolygonBridgeAddresses[i], AutoClaim: true}
+		if i < len(c.Etherman.L2ChainIds) {
+			n.ChainID = c.Etherman.L2ChainIds[i]
+		}
+		if i < len(c.Etherman.L2WSURLs) {
+			n.WSURL = c.Etherman.L2WSURLs[i]
+		}
+		networks = append(networks, n)
+	}
+	return networks, nil
+}
+
+// newL2Etherman creates the etherman of the L2 network and reads the network ID of its bridge
+func newL2Etherman(ctx context.Context, n networkregistry.Network, rollupID uint) (*etherman.Client, uint, error) {
+	l2Etherman, err := etherman.NewL2Client(n.RPCURL, n.BridgeAddress, rollupID)
+	if err != nil {
+		log.Error("L2 etherman ", n.RPCURL, ", error: ", err)
+		return nil, 0, err
+	}
+	networkID, err := l2Etherman.GetNetworkID(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	if n.IsSovereign() {
+		l2Etherman.AddSovereignGlobalExitRootManager(n.GlobalExitRootAddress)
+	}
+	return l2Etherman, networkID, nil
+}
+
+// newNodeClient creates the client used to send the manual claims to the L2 network
+func newNodeClient(ctx context.Context, c *config.Config, n networkregistry.Network) (*utils.Client, *bind.TransactOpts, error) {
+	nodeClient, err := utils.NewClient(ctx, n.RPCURL, n.BridgeAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+	auth, err := nodeClient.GetSignerFromKeystore(ctx, c.ClaimTxManager.PrivateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return nodeClient, auth, nil
+}
+
+// watchNetworks calls onAdded and onRemoved with the L2 networks added and removed from the registry at runtime
+func watchNetworks(ctx context.Context, events <-chan networkregistry.Event, onAdded func(networkregistry.Network) error, onRemoved func(networkregistry.Network)) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e := <-events:
+			switch e.Type {
+			case networkregistry.NetworkAdded:
+				log.Infof("network %d added to the registry", e.Network.ID)
+				if err := onAdded(e.Network); err != nil {
+					log.Errorf("error starting network %d. Error: %v", e.Network.ID, err)
+				}
+			case networkregistry.NetworkRemoved:
+				log.Infof("network %d removed from the registry", e.Network.ID)
+				onRemoved(e.Network)
+			}
+		}
+	}
+}
+
+// networkTasks runs the synchronizer and the claim tx manager of each L2 network, so they can be started and
+// stopped with the networks of the registry
+type networkTasks struct {
+	c                   *config.Config
+	registry            *networkregistry.Registry
+	rollupID            uint
+	bridgeController    *bridgectrl.BridgeController
+	storage             db.Storage
+	zkEVMClient         *client.Client
+	chExitRootEvent     chan *etherman.GlobalExitRoot
+	chSynced            chan uint
+	claimEvents         *claimEvents
+	messagePushProducer messagepush.KafkaProducer
+	redisStorage        redisstorage.RedisStorage
+	// newClaimTxManager creates the claim tx manager of the network with its own event channels
+	newClaimTxManager func(n networkregistry.Network, chExitRootEvent chan *etherman.GlobalExitRoot, chSynced chan uint) (*claimtxman.ClaimTxManager, error)
+
+	mu      sync.Mutex
+	running map[uint]*runningNetwork
+	leading bool
+	pending []pendingNetwork
+}
+
+// runningNetwork is a network whose tasks are running, wg tracks the goroutines of its tasks
+type runningNetwork struct {
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// pendingNetwork is a network added before this replica was elected as the leader
+type pendingNetwork struct {
+	network    networkregistry.Network
+	l2Etherman *etherman.Client
+}
+
+// start starts the tasks of the network, or keeps it until this replica is elected as the leader
+func (t *networkTasks) start(ctx context.Context, n networkregistry.Network, l2Etherman *etherman.Client) error {
+	t.mu.Lock()
+	if !t.leading {
+		t.pending = append(t.pending, pendingNetwork{network: n, l2Etherman: l2Etherman})
+		t.mu.Unlock()
+		return nil
+	}
+	t.mu.Unlock()
+	return t.run(ctx, n, l2Etherman)
+}
+
+// lead starts the tasks of the networks added while this replica was waiting to be elected as the leader
+func (t *networkTasks) lead(ctx context.Context) {
+	t.mu.Lock()
+	t.leading = true
+	pending := t.pending
+	t.pending = nil
+	t.mu.Unlock()
+	for _, p := range pending {
+		if err := t.run(ctx, p.network, p.l2Etherman); err != nil {
+			log.Fatalf("error starting the tasks of L2 network %d. Error: %v", p.network.ID, err)
+		}
+	}
+}
+
+// run runs the tasks of the network, the etherman is created if it's nil
+func (t *networkTasks) run(ctx context.Context, n networkregistry.Network, l2Etherman *etherman.Client) error {
+	if l2Etherman == nil {
+		var (
+			networkID uint
+			err       error
+		)
+		l2Etherman, networkID, err = newL2Etherman(ctx, n, t.rollupID)
+		if err != nil {
+			return err
+		}
+		if networkID != n.ID {
+			return fmt.Errorf("the bridge of network %d at %s has network ID %d", n.ID, n.RPCURL, networkID)
+		}
+		if err = t.bridgeController.AddNetwork(ctx, n.ID); err != nil {
+			return err
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.running[n.ID]; ok {
+		return fmt.Errorf("network %d is already running", n.ID)
+	}
+	var claimTxManager *claimtxman.ClaimTxManager
+	networkCtx, cancel := context.WithCancel(ctx)
+	if t.claimEvents != nil && n.AutoClaim {
+		chExitRootEvent, chSynced := t.claimEvents.subscribe(networkCtx, n)
+		var err error
+		claimTxManager, err = t.newClaimTxManager(n, chExitRootEvent, chSynced)
+		if err != nil {
+			cancel()
+			return err
+		}
+	}
+	running := &runningNetwork{cancel: cancel}
+	t.running[n.ID] = running
+
+	if n.WSURL != "" {
+		l2Etherman.Subscribe(networkCtx, n.WSURL)
+	}
+	running.wg.Add(1)
+	go func() {
+		defer running.wg.Done()
+		runSynchronizer(networkCtx, 0, t.bridgeController, l2Etherman, t.c.Synchronizer, t.storage, t.zkEVMClient, t.chExitRootEvent, t.chSynced, t.messagePushProducer, t.redisStorage)
+	}()
+	if claimTxManager != nil {
+		running.wg.Add(1)
+		go func() {
+			defer running.wg.Done()
+			claimTxManager.StartXLayer()
+		}()
+		go func() {
+			<-networkCtx.Done()
+			claimTxManager.Stop()
+		}()
+	}
+	return nil
+}
+
+// stop stops the tasks of the network and waits for them to exit, so a changed network is not started again
+// while the tasks of the old one are still writing
+func (t *networkTasks) stop(networkID uint) {
+	t.mu.Lock()
+	running, ok := t.running[networkID]
+	if ok {
+		running.cancel()
+		delete(t.running, networkID)
+	}
+	for i, p := range t.pending {
+		if p.network.ID == networkID {
+			t.pending = append(t.pending[:i], t.pending[i+1:]...)
+			break
+		}
+	}
+	t.mu.Unlock()
+	if ok {
+		running.wg.Wait()
+		log.Infof("the tasks of network %d are stopped", networkID)
+	}
+}
+
+// claimEvents forwards the global exit roots and the synced networks to the claim tx managers of the L2
+// networks, every manager gets its own channels
+type claimEvents struct {
+	mu          sync.Mutex
+	subscribers []*claimSubscriber
+}
+
+type claimSubscriber struct {
+	ctx             context.Context
+	network         networkregistry.Network
+	chExitRootEvent chan *etherman.GlobalExitRoot
+	chSynced        chan uint
+}
+
+// subscribe returns the channels of the claim tx manager of the network, the events stop being sent when the
+// context is done
+func (e *claimEvents) subscribe(ctx context.Context, n networkregistry.Network) (chan *etherman.GlobalExitRoot, chan uint) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	s := &claimSubscriber{ctx: ctx, network: n, chExitRootEvent: make(chan *etherman.GlobalExitRoot), chSynced: make(chan uint)}
+	e.subscribers = append(e.subscribers, s)
+	return s.chExitRootEvent, s.chSynced
+}
+
+// accepts returns whether the global exit root is sent to the claim tx manager of the subscriber. The global exit
+// roots synced from L1 go to all the managers. The ones injected on a sovereign chain only go to its manager, and
+// the trusted ones of the rollup to the rest, since the deposits to a sovereign chain are claimed with the
+// global exit roots injected on it
+func (s *claimSubscriber) accepts(ger *etherman.GlobalExitRoot) bool {
+	if ger.BlockID != 0 {
+		return true
+	}
+	if ger.NetworkID != 0 {
+		return ger.NetworkID == s.network.ID
+	}
+	return !s.network.IsSovereign()
+}
+
+// activeSubscribers returns the subscribers whose context is not done, the rest are removed
+func (e *claimEvents) activeSubscribers() []*claimSubscriber {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	active := e.subscribers[:0]
+	for _, s := range e.subscribers {
+		if s.ctx.Err() == nil {
+			active = append(active, s)
+		}
+	}
+	e.subscribers = active
+	return append([]*claimSubscriber{}, active...)
+}
+
+func (e *claimEvents) run(ctx context.Context, chExitRootEvent chan *etherman.GlobalExitRoot, chSynced chan uint) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ger := <-chExitRootEvent:
+			for _, s := range e.activeSubscribers() {
+				if !s.accepts(ger) {
+					continue
+				}
+				select {
+				case s.chExitRootEvent <- ger:
+				case <-s.ctx.Done():
+				}
+			}
+		case networkID := <-chSynced:
+			for _, s := range e.activeSubscribers() {
+				select {
+				case s.chSynced <- networkID:
+				case <-s.ctx.Done():
+				}
+			}
+		}
+	}
+}
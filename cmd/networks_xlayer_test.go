@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/networkregistry"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClaimEventsRouting(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	chExitRootEvent := make(chan *etherman.GlobalExitRoot)
+	events := &claimEvents{}
+	go events.run(ctx, chExitRootEvent, make(chan uint))
+
+	networks := []networkregistry.Network{
+		{ID: 1},
+		{ID: 2},
+		{ID: 3, GlobalExitRootAddress: common.HexToAddress("0x3")},
+	}
+	var subscribers []chan *etherman.GlobalExitRoot
+	for _, n := range networks {
+		ch, _ := events.subscribe(ctx, n)
+		subscribers = append(subscribers, ch)
+	}
+	// received returns the global exit roots the managers got, nil if the manager didn't get it
+	received := func(ger *etherman.GlobalExitRoot) []*etherman.GlobalExitRoot {
+		results := make(chan []*etherman.GlobalExitRoot)
+		go func() {
+			got := make([]*etherman.GlobalExitRoot, len(subscribers))
+			for i, ch := range subscribers {
+				select {
+				case got[i] = <-ch:
+				case <-time.After(100 * time.Millisecond):
+				}
+			}
+			results <- got
+		}()
+		chExitRootEvent <- ger
+		return <-results
+	}
+
+	// The global exit roots synced from L1 go to all the managers
+	l1GER := &etherman.GlobalExitRoot{BlockID: 1}
+	assert.Equal(t, []*etherman.GlobalExitRoot{l1GER, l1GER, l1GER}, received(l1GER))
+
+	// The trusted global exit roots don't go to the sovereign chain
+	trustedGER := &etherman.GlobalExitRoot{GlobalExitRoot: common.HexToHash("0x1")}
+	assert.Equal(t, []*etherman.GlobalExitRoot{trustedGER, trustedGER, nil}, received(trustedGER))
+
+	// The injected global exit roots only go to their sovereign chain
+	sovereignGER := &etherman.GlobalExitRoot{GlobalExitRoot: common.HexToHash("0x2"), NetworkID: 3}
+	assert.Equal(t, []*etherman.GlobalExitRoot{nil, nil, sovereignGER}, received(sovereignGER))
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"os"
 	"os/signal"
 
@@ -12,10 +13,14 @@ import (
 	"github.com/0xPolygonHermez/zkevm-bridge-service/db"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/estimatetime"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/leaderelection"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/localcache"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/messagepush"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/metrics"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/networkregistry"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/priceoracle"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/pushoutbox"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/pushtask"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/redisstorage"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/sentinel"
@@ -25,6 +30,7 @@ import (
 	"github.com/0xPolygonHermez/zkevm-bridge-service/utils"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/messagebridge"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/webhook"
 	"github.com/0xPolygonHermez/zkevm-node/jsonrpc/client"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/urfave/cli/v2"
@@ -95,50 +101,29 @@ func startServer(ctx *cli.Context, opts ...runOptionFunc) error {
 	messagebridge.InitWstETHProcessor(c.BusinessConfig.WstETHContractAddresses, c.BusinessConfig.WstETHTokenAddresses)
 	messagebridge.InitEURCProcessor(c.BusinessConfig.EURCContractAddresses, c.BusinessConfig.EURCTokenAddresses)
 
-	l1ChainId := c.Etherman.L1ChainId
-	l2ChainIds := c.Etherman.L2ChainIds
-	var chainIDs = []uint{l1ChainId}
-	chainIDs = append(chainIDs, l2ChainIds...)
-	l1Etherman, l2Ethermans, err := newEthermans(c)
+	l1Etherman, err := etherman.NewClient(c.Etherman, c.NetworkConfig.PolygonBridgeAddress, c.NetworkConfig.PolygonZkEVMGlobalExitRootAddress, c.NetworkConfig.PolygonRollupManagerAddress, c.NetworkConfig.PolygonZkEvmAddress)
 	if err != nil {
-		log.Error(err)
+		log.Error("L1 etherman error: ", err)
 		return err
 	}
-
-	networkID, err := l1Etherman.GetNetworkID(ctx.Context)
-	log.Infof("main network id: %d", networkID)
+	registry, l2Networks, l2Ethermans, err := newNetworkRegistry(ctx.Context, c, l1Etherman)
 	if err != nil {
 		log.Error(err)
 		return err
 	}
-
-	var networkIDs = []uint{networkID}
-	for _, cl := range l2Ethermans {
-		networkID, err := cl.GetNetworkID(ctx.Context)
+	networkregistry.InitDefault(registry)
+	rollupNetwork, _ := registry.Get(registry.RollupNetworkID())
+
+	var networkIDs = []uint{registry.Networks()[0].ID}
+	l2NodeClients := make([]*utils.Client, len(l2Networks))
+	l2Auths := make([]*bind.TransactOpts, len(l2Networks))
+	for i, n := range l2Networks {
+		networkIDs = append(networkIDs, n.ID)
+		l2NodeClients[i], l2Auths[i], err = newNodeClient(ctx.Context, c, n)
 		if err != nil {
 			log.Error(err)
 			return err
 		}
-		log.Infof("l2 network id: %d", networkID)
-		networkIDs = append(networkIDs, networkID)
-		utils.InitRollupNetworkId(networkID)
-	}
-
-	l2NodeClients := make([]*utils.Client, len(c.Etherman.L2URLs))
-	l2Auths := make([]*bind.TransactOpts, len(c.Etherman.L2URLs))
-	for i := range c.Etherman.L2URLs {
-		nodeClient, err := utils.NewClient(ctx.Context, c.Etherman.L2URLs[i], c.NetworkConfig.L2PolygonBridgeAddresses[i])
-		if err != nil {
-			log.Error(err)
-			return err
-		}
-		auth, err := nodeClient.GetSignerFromKeystore(ctx.Context, c.ClaimTxManager.PrivateKey)
-		if err != nil {
-			log.Error(err)
-			return err
-		}
-		l2NodeClients[i] = nodeClient
-		l2Auths[i] = auth
 	}
 
 	storage, err := db.NewStorage(c.SyncDB)
@@ -172,6 +157,17 @@ func startServer(ctx *cli.Context, opts ...runOptionFunc) error {
 		return err
 	}
 
+	// The coin prices of the API, the claim policy and the large transactions filter are aggregated from the sources
+	var priceOracle *priceoracle.Oracle
+	if c.PriceOracle.Enabled {
+		priceOracle, err = priceoracle.NewOracle(c.PriceOracle, redisStorage, l1Etherman)
+		if err != nil {
+			log.Error(err)
+			return err
+		}
+		redisStorage = priceoracle.NewRedisStorage(redisStorage, priceOracle, c.BridgeServer.Redis.EnablePrice)
+	}
+
 	err = localcache.InitDefaultCache(apiStorage)
 	if err != nil {
 		log.Error(err)
@@ -199,18 +195,28 @@ func startServer(ctx *cli.Context, opts ...runOptionFunc) error {
 			}
 		}()
 	}
+	var transactionPublishers []messagepush.TransactionPublisher
+	if c.MessagePushProducer.StreamEnabled {
+		transactionPublishers = append(transactionPublishers, redisStorage)
+	}
+	var webhookDispatcher *webhook.Dispatcher
+	if c.Webhook.Enabled {
+		webhookDispatcher = webhook.NewDispatcher(c.Webhook, storage)
+		transactionPublishers = append(transactionPublishers, webhookDispatcher)
+	}
+	if len(transactionPublishers) > 0 {
+		messagePushProducer = messagepush.NewStreamProducer(messagePushProducer, transactionPublishers...)
+	}
 
 	// Start metrics
 	if c.Metrics.Enabled {
 		go metrics.StartMetricsHttpServer(c.Metrics)
 	}
 
-	// Initialize chainId manager
-	utils.InitChainIdManager(networkIDs, chainIDs)
-
 	rollupID := l1Etherman.GetRollupID()
 	bridgeService := server.NewBridgeService(c.BridgeServer, c.BridgeController.Height, networkIDs, l2NodeClients, l2Auths, apiStorage, rollupID).
-		WithRedisStorage(redisStorage).WithMainCoinsCache(localcache.GetDefaultCache()).WithMessagePushProducer(messagePushProducer)
+		WithRedisStorage(redisStorage).WithMainCoinsCache(localcache.GetDefaultCache()).WithMessagePushProducer(messagePushProducer).
+		WithPriceOracle(priceOracle).WithWebhookDispatcher(webhookDispatcher)
 
 	// Initialize inner chain id conf
 	utils.InnitOkInnerChainIdMapper(c.BusinessConfig)
@@ -231,6 +237,17 @@ func startServer(ctx *cli.Context, opts ...runOptionFunc) error {
 		iprestriction.InitClient(c.IPRestriction)
 		tokenlogoinfo.InitClient(c.TokenLogoServiceConfig)
 
+		if c.MessagePushProducer.StreamEnabled {
+			transactionUpdates, err := redisStorage.SubscribeTransactionUpdates(ctx.Context)
+			if err != nil {
+				log.Error(err)
+				return err
+			}
+			transactionHub := messagepush.NewTransactionHub()
+			go transactionHub.Run(transactionUpdates)
+			bridgeService.WithTransactionHub(transactionHub)
+		}
+
 		err = server.RunServer(c.BridgeServer, bridgeService)
 		if err != nil {
 			log.Error(err)
@@ -246,46 +263,78 @@ func startServer(ctx *cli.Context, opts ...runOptionFunc) error {
 			log.Error(err)
 			return err
 		}
-		go l1BlockNumTask.Start(ctx.Context)
 
 		// Initialize the push task for sync l2 commit batch
-		syncCommitBatchTask, err := pushtask.NewCommittedBatchHandler(c.Etherman.L2URLs[0], apiStorage, redisStorage, messagePushProducer, rollupID)
+		syncCommitBatchTask, err := pushtask.NewCommittedBatchHandler(rollupNetwork.RPCURL, apiStorage, redisStorage, messagePushProducer, rollupID)
 		if err != nil {
 			log.Error(err)
 			return err
 		}
-		go syncCommitBatchTask.Start(ctx.Context)
 
 		// Initialize the push task for sync verify batch
-		syncVerifyBatchTask, err := pushtask.NewVerifiedBatchHandler(c.Etherman.L2URLs[0], redisStorage)
+		syncVerifyBatchTask, err := pushtask.NewVerifiedBatchHandler(rollupNetwork.RPCURL, redisStorage)
 		if err != nil {
 			log.Error(err)
 			return err
 		}
-		go syncVerifyBatchTask.Start(ctx.Context)
+
+		// The push tasks only run on the leader replica, the campaign doesn't block the rest of the roles
+		go func() {
+			if _, err := campaignLeadership(ctx.Context, c.LeaderElection, "push_tasks", redisStorage); err != nil {
+				log.Error(err)
+				return
+			}
+			go l1BlockNumTask.Start(ctx.Context)
+			go syncCommitBatchTask.Start(ctx.Context)
+			go syncVerifyBatchTask.Start(ctx.Context)
+		}()
 	}
 
 	// ---------- Run synchronizer tasks ----------
+	var tasks *networkTasks
 	if opt.runTasks {
-		log.Debug("trusted sequencer URL ", c.Etherman.L2URLs[0])
-		zkEVMClient := client.NewClient(c.Etherman.L2URLs[0])
+		// the elector is set before the tasks of the networks are started, when this replica is elected
+		var elector *leaderelection.Elector
+		log.Debug("trusted sequencer URL ", rollupNetwork.RPCURL)
+		zkEVMClient := client.NewClient(rollupNetwork.RPCURL)
 		chExitRootEvent := make(chan *etherman.GlobalExitRoot)
 		chSynced := make(chan uint)
-		go runSynchronizer(ctx.Context, c.NetworkConfig.GenBlockNumber, bridgeController, l1Etherman, c.Synchronizer, storage, zkEVMClient, chExitRootEvent, chSynced, messagePushProducer, redisStorage)
-		for _, cl := range l2Ethermans {
-			go runSynchronizer(ctx.Context, 0, bridgeController, cl, c.Synchronizer, storage, zkEVMClient, chExitRootEvent, chSynced, messagePushProducer, redisStorage)
-		}
 
-		if c.ClaimTxManager.Enabled {
-			for i := 0; i < len(c.Etherman.L2URLs); i++ {
-				// we should match the orders of L2URLs between etherman and claimtxman
-				// since we are using the networkIDs in the same order
-				claimTxManager, err := claimtxman.NewClaimTxManager(c.ClaimTxManager, chExitRootEvent, chSynced, c.Etherman.L2URLs[i], networkIDs[i+1], c.NetworkConfig.L2PolygonBridgeAddresses[i], bridgeService, storage, messagePushProducer, redisStorage, rollupID)
+		tasks = &networkTasks{
+			c:                   c,
+			registry:            registry,
+			rollupID:            rollupID,
+			bridgeController:    bridgeController,
+			storage:             storage,
+			zkEVMClient:         zkEVMClient,
+			chExitRootEvent:     chExitRootEvent,
+			chSynced:            chSynced,
+			messagePushProducer: messagePushProducer,
+			redisStorage:        redisStorage,
+			running:             make(map[uint]*runningNetwork),
+			newClaimTxManager: func(n networkregistry.Network, chExitRootEvent chan *etherman.GlobalExitRoot, chSynced chan uint) (*claimtxman.ClaimTxManager, error) {
+				// the exit root of every network is settled on L1 with its network ID as rollup ID
+				claimTxManager, err := claimtxman.NewClaimTxManager(c.ClaimTxManager, chExitRootEvent, chSynced, n.RPCURL, n.ID, n.BridgeAddress, bridgeService, storage, messagePushProducer, redisStorage, n.ID)
 				if err != nil {
-					log.Fatalf("error creating claim tx manager for L2 %s. Error: %v", c.Etherman.L2URLs[i], err)
+					return nil, err
 				}
-				go claimTxManager.StartXLayer()
-			}
+				// the L2->L1 deposits are claimed on L1 only for the rollup of this bridge service
+				if c.ClaimTxManager.L1Claim.Enabled && n.ID == registry.RollupNetworkID() {
+					l1ClaimTxManager, err := claimtxman.NewL1ClaimTxManager(c.ClaimTxManager, c.Etherman.L1URL, c.NetworkConfig.PolygonBridgeAddress, n.ID, bridgeService, storage, messagePushProducer, redisStorage, rollupID)
+					if err != nil {
+						return nil, err
+					}
+					claimTxManager.SetL1Claimer(l1ClaimTxManager)
+				}
+				if elector != nil {
+					claimTxManager.SetLeader(elector)
+				}
+				return claimTxManager, nil
+			},
+		}
+		if c.ClaimTxManager.Enabled {
+			tasks.claimEvents = &claimEvents{}
+			go tasks.claimEvents.run(ctx.Context, chExitRootEvent, chSynced)
 		} else {
 			log.Warn("ClaimTxManager not configured")
 			go func() {
@@ -302,20 +351,26 @@ func startServer(ctx *cli.Context, opts ...runOptionFunc) error {
 				}
 			}()
 		}
+		// the tasks of the networks start when this replica is elected as the leader
+		for i, n := range l2Networks {
+			if err = tasks.start(ctx.Context, n, l2Ethermans[i]); err != nil {
+				log.Fatalf("error starting the tasks of L2 network %d. Error: %v", n.ID, err)
+			}
+		}
 
 		// init token logo client
 		tokenlogoinfo.InitClient(c.TokenLogoServiceConfig)
 
-		if len(c.CoinKafkaConsumer.Brokers) > 0 {
-			// Start the coin middleware kafka consumer
+		var coinKafkaConsumer coinmiddleware.KafkaConsumer
+		if c.CoinKafkaConsumer.IsEnabled() {
+			// Start the coin middleware consumer
 			log.Debugf("start initializing kafka consumer...")
-			coinKafkaConsumer, err := coinmiddleware.NewKafkaConsumer(c.CoinKafkaConsumer, redisStorage)
+			coinKafkaConsumer, err = coinmiddleware.NewKafkaConsumer(c.CoinKafkaConsumer, redisStorage)
 			if err != nil {
 				log.Error(err)
 				return err
 			}
 			log.Debugf("finish initializing kafka consumer")
-			go coinKafkaConsumer.Start(ctx.Context)
 			defer func() {
 				err := coinKafkaConsumer.Close()
 				if err != nil {
@@ -323,8 +378,54 @@ func startServer(ctx *cli.Context, opts ...runOptionFunc) error {
 				}
 			}()
 		}
+
+		// The tasks only run on the leader replica, the campaign doesn't block the API and the registry watch of
+		// the standby replicas
+		go func() {
+			var err error
+			elector, err = campaignLeadership(ctx.Context, c.LeaderElection, "tasks", redisStorage)
+			if err != nil {
+				log.Error(err)
+				return
+			}
+			if c.Etherman.L1WSURL != "" {
+				l1Etherman.Subscribe(ctx.Context, c.Etherman.L1WSURL)
+			}
+			go runSynchronizer(ctx.Context, c.NetworkConfig.GenBlockNumber, bridgeController, l1Etherman, c.Synchronizer, storage, zkEVMClient, chExitRootEvent, chSynced, messagePushProducer, redisStorage)
+			tasks.lead(ctx.Context)
+
+			if webhookDispatcher != nil {
+				go webhookDispatcher.Start(ctx.Context)
+			}
+			// The synchronizers and the claim tx managers store the transaction updates in the outbox, the relay pushes them
+			if messagePushProducer != nil {
+				go pushoutbox.NewRelay(c.PushOutbox, messagePushProducer, storage, redisStorage).Start(ctx.Context)
+			}
+			if coinKafkaConsumer != nil {
+				go coinKafkaConsumer.Start(ctx.Context)
+			}
+		}()
 	}
 
+	// ---------- Watch the networks of the registry ----------
+	go watchNetworks(ctx.Context, registry.Subscribe(), func(n networkregistry.Network) error {
+		nodeClient, auth, err := newNodeClient(ctx.Context, c, n)
+		if err != nil {
+			return err
+		}
+		bridgeService.AddNetwork(n.ID, nodeClient, auth)
+		if tasks != nil {
+			return tasks.start(ctx.Context, n, nil)
+		}
+		return nil
+	}, func(n networkregistry.Network) {
+		bridgeService.RemoveNetwork(n.ID)
+		if tasks != nil {
+			tasks.stop(n.ID)
+		}
+	})
+	go registry.Watch(ctx.Context, l2Networks, []uint{networkIDs[0], rollupNetwork.ID}, c.NetworkRegistry.RefreshInterval.Duration)
+
 	// Wait for an in interrupt.
 	ch := make(chan os.Signal, 1)
 	signal.Notify(ch, os.Interrupt)
@@ -333,6 +434,26 @@ func startServer(ctx *cli.Context, opts ...runOptionFunc) error {
 	return nil
 }
 
+// campaignLeadership blocks until this replica is elected as the leader of the role, so only one replica
+// runs the tasks of the role, it's called in a goroutine to keep serving the rest of the roles meanwhile. If the leadership is lost, the process exits to be restarted as a standby
+// replica. It returns nil if the leader election is disabled
+func campaignLeadership(ctx context.Context, cfg leaderelection.Config, role string, redisStorage redisstorage.RedisStorage) (*leaderelection.Elector, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	elector := leaderelection.NewElector(cfg, role, redisStorage)
+	log.Infof("waiting to be elected as the %s leader", role)
+	err := elector.Campaign(ctx)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		<-elector.Lost()
+		log.Fatalf("%s leadership lost, exiting to restart as a standby replica", role)
+	}()
+	return elector, nil
+}
+
 func initCommon(ctx *cli.Context) (*config.Config, error) {
 	configFilePath := ctx.String(flagCfg)
 	network := ctx.String(flagNetwork)
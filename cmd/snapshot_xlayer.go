@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/config"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db/pgstorage"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/snapshot"
+	"github.com/urfave/cli/v2"
+)
+
+const flagFile = "file"
+
+// snapshotCmd exports the synced state to a snapshot file
+func snapshotCmd(ctx *cli.Context) error {
+	storage, err := newSnapshotStorage(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer storage.Close()
+
+	path := ctx.String(flagFile)
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	manifest, err := snapshot.Export(ctx.Context, storage.Pool, f)
+	if err != nil {
+		_ = f.Close()
+		_ = os.Remove(path)
+		log.Error(err)
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	log.Infof("snapshot written to %s at migration %s", path, manifest.Migration)
+	for _, b := range manifest.LastBlocks {
+		log.Infof("network %d synced up to block %d (%s)", b.NetworkID, b.BlockNumber, b.BlockHash)
+	}
+	return nil
+}
+
+// restoreCmd loads a snapshot file into a new db, the synchronizer resumes from the last blocks of the snapshot
+func restoreCmd(ctx *cli.Context) error {
+	storage, err := newSnapshotStorage(ctx, true)
+	if err != nil {
+		return err
+	}
+	defer storage.Close()
+
+	f, err := os.Open(ctx.String(flagFile))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	manifest, err := snapshot.Restore(ctx.Context, storage.Pool, f)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	log.Infof("snapshot taken at %s restored", manifest.CreatedAt)
+	for _, b := range manifest.LastBlocks {
+		log.Infof("network %d will resume the sync from block %d (%s)", b.NetworkID, b.BlockNumber, b.BlockHash)
+	}
+	return nil
+}
+
+func newSnapshotStorage(ctx *cli.Context, runMigrations bool) (*pgstorage.PostgresStorage, error) {
+	c, err := config.Load(ctx.String(flagCfg), ctx.String(flagNetwork))
+	if err != nil {
+		return nil, err
+	}
+	setupLog(c.Log)
+	if runMigrations {
+		err = db.RunMigrations(c.SyncDB)
+		if err != nil {
+			log.Error(err)
+			return nil, err
+		}
+	}
+	return newPostgresStorage(c)
+}
+
+// newPostgresStorage creates the storage of the commands that work on the postgres db directly
+func newPostgresStorage(c *config.Config) (*pgstorage.PostgresStorage, error) {
+	storage, err := db.NewStorage(c.SyncDB)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	pg, ok := storage.(*pgstorage.PostgresStorage)
+	if !ok {
+		return nil, fmt.Errorf("the %s storage is not supported by this command", c.SyncDB.Database)
+	}
+	return pg, nil
+}
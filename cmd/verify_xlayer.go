@@ -0,0 +1,84 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/config"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/integrity"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagSamples    = "samples"
+	flagClaims     = "claims"
+	flagExitRoots  = "exit-roots"
+	flagOutput     = "output"
+	stdoutFileName = "-"
+)
+
+// verifyCmd recomputes the exit trees from the synced deposits and checks the synced state against the
+// chains, the discrepancies are written as a json report
+func verifyCmd(ctx *cli.Context) error {
+	c, err := config.Load(ctx.String(flagCfg), ctx.String(flagNetwork))
+	if err != nil {
+		return err
+	}
+	setupLog(c.Log)
+	storage, err := newPostgresStorage(c)
+	if err != nil {
+		return err
+	}
+	defer storage.Close()
+
+	l1Etherman, l2Ethermans, err := newEthermans(c)
+	if err != nil {
+		return err
+	}
+	// The first network is L1, its global exit root manager checks the synced global exit roots
+	var networks []integrity.Network
+	for _, client := range append([]*etherman.Client{l1Etherman}, l2Ethermans...) {
+		networkID, err := client.GetNetworkID(ctx.Context)
+		if err != nil {
+			log.Error(err)
+			return err
+		}
+		networks = append(networks, integrity.Network{ID: networkID, Bridge: client})
+	}
+
+	checker := integrity.NewChecker(integrity.Config{
+		Height:                c.BridgeController.Height,
+		Samples:               ctx.Uint(flagSamples),
+		ClaimSamples:          ctx.Uint(flagClaims),
+		GlobalExitRootSamples: ctx.Uint(flagExitRoots),
+	}, storage, l1Etherman, networks)
+	report, err := checker.Run(ctx.Context)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if path := ctx.String(flagOutput); path != stdoutFileName {
+		f, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err = encoder.Encode(report); err != nil {
+		return err
+	}
+	if len(report.Discrepancies) > 0 {
+		return fmt.Errorf("%d discrepancies found", len(report.Discrepancies))
+	}
+	log.Info("no discrepancies found")
+	return nil
+}
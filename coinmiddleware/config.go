@@ -1,7 +1,13 @@
 package coinmiddleware
 
+import "github.com/0xPolygonHermez/zkevm-bridge-service/messagequeue"
+
 // Config handles the kafka consumer config
 type Config struct {
+	// Backend is the message queue the prices are consumed from: kafka, redis (Redis Streams) or nats. The
+	// topics are the names of the streams or the subjects, kafka by default
+	Backend string `mapstructure:"Backend"`
+
 	// Brokers is the list of address of the kafka brokers
 	Brokers []string `mapstructure:"Brokers"`
 
@@ -11,7 +17,8 @@ type Config struct {
 	// ConsumerGroupID is the name of the consumer group
 	ConsumerGroupID string `mapstructure:"ConsumerGroupID"`
 
-	// InitialOffset is the offset to use if there's no previously committed offset
+	// InitialOffset is the offset to use if there's no previously committed offset, it's also used to create
+	// the Redis streams consumer groups and the NATS JetStream consumers
 	// -1: Newest
 	// -2: Oldest
 	InitialOffset int64 `mapstructure:"InitialOffset"`
@@ -22,4 +29,34 @@ type Config struct {
 
 	// RootCAPath points to the CA cert used for authentication
 	RootCAPath string `mapstructure:"RootCAPath"`
+
+	// RedisStreams and NATS are the connections of the other backends
+	RedisStreams messagequeue.RedisStreamsConfig `mapstructure:"RedisStreams"`
+	NATS         messagequeue.NATSConfig         `mapstructure:"NATS"`
+}
+
+// IsEnabled returns whether the connection of the backend is configured
+func (cfg Config) IsEnabled() bool {
+	switch cfg.Backend {
+	case messagequeue.BackendRedisStreams:
+		return len(cfg.RedisStreams.Addrs) > 0
+	case messagequeue.BackendNATS:
+		return cfg.NATS.URL != ""
+	default:
+		return len(cfg.Brokers) > 0
+	}
+}
+
+func (cfg Config) messageQueueConfig() messagequeue.Config {
+	return messagequeue.Config{
+		Backend: cfg.Backend,
+		Kafka: messagequeue.KafkaConfig{
+			Brokers:    cfg.Brokers,
+			Username:   cfg.Username,
+			Password:   cfg.Password,
+			RootCAPath: cfg.RootCAPath,
+		},
+		RedisStreams: cfg.RedisStreams,
+		NATS:         cfg.NATS,
+	}
 }
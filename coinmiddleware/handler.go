@@ -7,8 +7,8 @@ import (
 
 	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/messagequeue"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/redisstorage"
-	"github.com/IBM/sarama"
 	"github.com/pkg/errors"
 )
 
@@ -17,52 +17,36 @@ const (
 	retryBackoff = 3 * time.Second
 )
 
-// MessageHandler implements sarama.ConsumerGroupHandler, handles the messages from kafka and populate the Redis storage
+// MessageHandler handles the messages from the message queue and populate the Redis storage
 type MessageHandler struct {
 	storage redisstorage.RedisStorage
 }
 
-func NewMessageHandler(redisStorage redisstorage.RedisStorage) sarama.ConsumerGroupHandler {
+func NewMessageHandler(redisStorage redisstorage.RedisStorage) *MessageHandler {
 	return &MessageHandler{storage: redisStorage}
 }
 
-func (h *MessageHandler) Setup(sarama.ConsumerGroupSession) error {
-	return nil
-}
-
-func (h *MessageHandler) Cleanup(sarama.ConsumerGroupSession) error {
-	return nil
-}
-
-func (h *MessageHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
-	for {
-		select {
-		case message, ok := <-claim.Messages():
-			if !ok {
-				log.Info("message channel was closed")
-				return nil
-			}
-			log.Infof("message received topic[%v] partition[%v] offset[%v]", message.Topic, message.Partition, message.Offset)
-
-			// Retry for 5 times, if still fails, ignore this message
-			for i := 0; i < maxRetries; i++ {
-				err := h.handleMessage(message)
-				if err == nil {
-					break
-				}
-				log.Errorf("handle kafka message error[%v] retryCnt[%v]", err, i)
-				time.Sleep(retryBackoff)
-			}
-			session.MarkMessage(message, "")
-		case <-session.Context().Done():
+// Handle handles the message, retrying for 5 times. If it still fails, the message is ignored
+func (h *MessageHandler) Handle(ctx context.Context, message *messagequeue.Message) error {
+	var err error
+	for i := 0; i < maxRetries; i++ {
+		err = h.handleMessage(ctx, message.Value)
+		if err == nil {
 			return nil
 		}
+		log.Errorf("handle coin message error[%v] retryCnt[%v]", err, i)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(retryBackoff):
+		}
 	}
+	return err
 }
 
-func (h *MessageHandler) handleMessage(message *sarama.ConsumerMessage) error {
+func (h *MessageHandler) handleMessage(ctx context.Context, value []byte) error {
 	body := &MessageBody{}
-	err := json.Unmarshal(message.Value, body)
+	err := json.Unmarshal(value, body)
 	if err != nil {
 		return errors.Wrap(err, "unmarshal message body error")
 	}
@@ -71,7 +55,7 @@ func (h *MessageHandler) handleMessage(message *sarama.ConsumerMessage) error {
 		return errors.New("message data is nil")
 	}
 	pbPriceList := h.convertToPbPriceList(body.Data.PriceList)
-	return h.storage.SetCoinPrice(context.Background(), pbPriceList)
+	return h.storage.SetCoinPrice(ctx, pbPriceList)
 }
 
 func (h *MessageHandler) convertToPbPriceList(priceList []*PriceInfo) []*pb.SymbolPrice {
@@ -2,11 +2,9 @@ package coinmiddleware
 
 import (
 	"context"
-	"crypto/tls"
-	"crypto/x509"
-	"os"
 
 	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/messagequeue"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/redisstorage"
 	"github.com/IBM/sarama"
 	"github.com/pkg/errors"
@@ -19,75 +17,36 @@ type KafkaConsumer interface {
 }
 
 type kafkaConsumerImpl struct {
-	topics  []string
-	client  sarama.ConsumerGroup
-	handler sarama.ConsumerGroupHandler
+	consumer messagequeue.Consumer
+	handler  *MessageHandler
 }
 
+// NewKafkaConsumer creates the consumer of the coin prices on the message queue backend of the config
 func NewKafkaConsumer(cfg Config, redisStorage redisstorage.RedisStorage) (KafkaConsumer, error) {
-	config := sarama.NewConfig()
-	config.Consumer.Offsets.Initial = cfg.InitialOffset
-
-	// Enable SASL authentication
-	if cfg.Username != "" && cfg.Password != "" && cfg.RootCAPath != "" {
-		config.Net.SASL.Enable = true
-		config.Net.SASL.User = cfg.Username
-		config.Net.SASL.Password = cfg.Password
-
-		// Read the CA cert from file
-		rootCA, err := os.ReadFile(cfg.RootCAPath)
-		if err != nil {
-			return nil, errors.Wrap(err, "Kafka consumer: read root CA cert fail")
-		}
-
-		caCertPool := x509.NewCertPool()
-		if ok := caCertPool.AppendCertsFromPEM([]byte(rootCA)); !ok {
-			return nil, errors.New("NewKafkaConsumer caCertPool.AppendCertsFromPEM")
-		}
-
-		config.Net.TLS.Enable = true
-		config.Net.TLS.Config = &tls.Config{RootCAs: caCertPool, InsecureSkipVerify: true} // #nosec
-	}
-
-	client, err := sarama.NewConsumerGroup(cfg.Brokers, cfg.ConsumerGroupID, config)
+	consumer, err := messagequeue.NewConsumer(cfg.messageQueueConfig(), messagequeue.ConsumerConfig{
+		Topics:     cfg.Topics,
+		Group:      cfg.ConsumerGroupID,
+		FromOldest: cfg.InitialOffset == sarama.OffsetOldest,
+	})
 	if err != nil {
-		return nil, errors.Wrap(err, "kafka consumer group init error")
+		return nil, errors.Wrap(err, "coin consumer init error")
 	}
 
 	return &kafkaConsumerImpl{
-		topics:  cfg.Topics,
-		client:  client,
-		handler: NewMessageHandler(redisStorage),
+		consumer: consumer,
+		handler:  NewMessageHandler(redisStorage),
 	}, nil
 }
 
 func (c *kafkaConsumerImpl) Start(ctx context.Context) {
-	log.Debug("starting kafka consumer")
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
-
-	for {
-		log.Debugf("start consume")
-		err := c.client.Consume(ctx, c.topics, c.handler)
-		if err != nil {
-			log.Errorf("kafka consumer error: %v", err)
-			//if errors.Is(err, sarama.ErrClosedConsumerGroup) {
-			//	err = nil
-			//}
-			//err = errors.Wrap(err, "kafka consumer error")
-			//panic(err)
-			return
-		}
-		if err = ctx.Err(); err != nil {
-			log.Errorf("kafka consumer ctx error: %v", err)
-			//err = errors.Wrap(err, "kafka consumer ctx error")
-			//panic(err)
-			return
-		}
+	log.Debug("starting coin consumer")
+	err := c.consumer.Consume(ctx, c.handler.Handle)
+	if err != nil && ctx.Err() == nil {
+		log.Errorf("coin consumer error: %v", err)
 	}
 }
 
 func (c *kafkaConsumerImpl) Close() error {
-	log.Debug("closing kafka consumer...")
-	return c.client.Close()
+	log.Debug("closing coin consumer...")
+	return c.consumer.Close()
 }
@@ -1,6 +1,7 @@
 package apolloconfig
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -70,6 +71,11 @@ func NewStringSliceEntry(key string, defaultValue []string, opts ...entryOption[
 	return newEntry(key, defaultValue, getStringSlice, opts...)
 }
 
+// NewJSONEntry returns an entry whose value is stored in Apollo as a JSON object
+func NewJSONEntry[T any](key string, defaultValue T, opts ...entryOption[T]) Entry[T] {
+	return newEntry(key, defaultValue, getJSON[T], opts...)
+}
+
 func (e *entryImpl[T]) String() string {
 	return fmt.Sprintf("%v", e.Get())
 }
@@ -163,3 +169,13 @@ func getBool(client *agollo.Client, namespace, key string) (bool, error) {
 	}
 	return strconv.ParseBool(s)
 }
+
+func getJSON[T any](client *agollo.Client, namespace, key string) (T, error) {
+	var v T
+	s, err := getString(client, namespace, key)
+	if err != nil {
+		return v, err
+	}
+	err = json.Unmarshal([]byte(s), &v)
+	return v, err
+}
@@ -13,14 +13,19 @@ import (
 	"github.com/0xPolygonHermez/zkevm-bridge-service/config/businessconfig"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/db"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/leaderelection"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/messagepush"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/metrics"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/nacos"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/networkregistry"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/priceoracle"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/pushoutbox"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/server"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/server/iprestriction"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/server/tokenlogoinfo"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/synchronizer"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/webhook"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 )
@@ -43,6 +48,11 @@ type Config struct {
 	Metrics                metrics.Config        `apollo:"Metrics"`
 	IPRestriction          iprestriction.Config  `apollo:"IPRestriction"`
 	TokenLogoServiceConfig tokenlogoinfo.Config  `apollo:"TokenLogoServiceConfig"`
+	LeaderElection         leaderelection.Config `apollo:"LeaderElection"`
+	NetworkRegistry        networkregistry.Config
+	Webhook                webhook.Config
+	PushOutbox             pushoutbox.Config
+	PriceOracle            priceoracle.Config
 }
 
 // Load loads the configuration
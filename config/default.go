@@ -22,6 +22,95 @@ PrivateKey = {Path = "./test/test.keystore", Password = "testonly"}
 RetryInterval = "1s"
 RetryNumber = 10
 AuthorizedClaimMessageAddresses = []
+PrivateKeys = []
+DrainingSigners = []
+SimulateClaims = true
+    [ClaimTxManager.DynamicFee]
+    Enabled = false
+    GasTipCapMultiplier = 1
+    MinGasTipCap = 0
+    MaxGasTipCap = 0
+    BaseFeeMultiplier = 2
+    MaxGasFeeCap = 0
+    BumpPercentage = 10
+    [ClaimTxManager.Escalation]
+    Enabled = false
+    BlocksToWait = 0
+    TimeToWait = "60s"
+    BumpPercentage = 20
+    MaxGasPrice = 0
+    MaxAttempts = 5
+    [ClaimTxManager.BalanceMonitor]
+    Enabled = false
+    CheckInterval = "1m"
+    MinBalance = "0"
+    AlertTopic = ""
+    [ClaimTxManager.L1Claim]
+    Enabled = false
+    FrequencyToMonitorTxs = "12s"
+    PrivateKey = {Path = "./test/test.keystore", Password = "testonly"}
+    LeafTypes = [0]
+    Tokens = []
+    Destinations = []
+        [ClaimTxManager.L1Claim.DynamicFee]
+        Enabled = true
+        GasTipCapMultiplier = 1
+        MinGasTipCap = 1000000000
+        MaxGasTipCap = 0
+        BaseFeeMultiplier = 2
+        MaxGasFeeCap = 0
+        BumpPercentage = 10
+        [ClaimTxManager.L1Claim.Escalation]
+        Enabled = true
+        BlocksToWait = 5
+        TimeToWait = "0s"
+        BumpPercentage = 20
+        MaxGasPrice = 0
+        MaxAttempts = 5
+    [ClaimTxManager.Policy]
+    Enabled = false
+    LeafTypes = []
+    TokenAllowlist = []
+    TokenDenylist = []
+    DestinationDenylist = []
+    MinUSDValue = 0
+    AllowUnpriced = true
+    DailyQuotaPerAddress = 0
+    [ClaimTxManager.RateLimit]
+    Enabled = false
+    Window = "1h"
+    PerDestinationAddress = 0
+    PerOriginAddress = 0
+    GlobalPerMinute = 0
+
+[LeaderElection]
+Enabled = false
+Key = "bridge_leader"
+LeaseDuration = "10s"
+RenewInterval = "3s"
+RetryInterval = "2s"
+
+[Webhook]
+Enabled = false
+PollInterval = "2s"
+RefreshInterval = "1m"
+RequestTimeout = "10s"
+MaxAttempts = 8
+InitialBackoff = "10s"
+MaxBackoff = "1h"
+BatchSize = 50
+AllowPrivateHosts = false
+
+[PushOutbox]
+PollInterval = "1s"
+BatchSize = 100
+Retention = "72h"
+
+[PriceOracle]
+Enabled = false
+MaxPriceAge = "10m"
+CacheDuration = "1m"
+RequestTimeout = "3s"
 
 [Etherman]
 L1URL = "http://localhost:8545"
@@ -30,6 +119,13 @@ L2URLs = [""]
 [Synchronizer]
 SyncInterval = "2s"
 SyncChunkSize = 100
+FetchWorkers = 1
+SyncTargets = []
+TrustedL2Deposits = false
+
+[NetworkRegistry]
+Networks = []
+RefreshInterval = "1m"
 
 [BridgeController]
 Store = "postgres"
@@ -0,0 +1,9 @@
+-- +migrate Down
+
+ALTER TABLE sync.monitored_txs DROP COLUMN IF EXISTS gas_tip_cap;
+ALTER TABLE sync.monitored_txs DROP COLUMN IF EXISTS gas_fee_cap;
+
+-- +migrate Up
+
+ALTER TABLE sync.monitored_txs ADD COLUMN IF NOT EXISTS gas_tip_cap VARCHAR;
+ALTER TABLE sync.monitored_txs ADD COLUMN IF NOT EXISTS gas_fee_cap VARCHAR;
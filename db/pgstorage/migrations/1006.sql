@@ -0,0 +1,30 @@
+-- +migrate Down
+
+DROP TABLE IF EXISTS sync.monitored_txs_attempt;
+
+ALTER TABLE sync.monitored_txs DROP COLUMN IF EXISTS escalation_count;
+ALTER TABLE sync.monitored_txs DROP COLUMN IF EXISTS last_tx_hash;
+ALTER TABLE sync.monitored_txs DROP COLUMN IF EXISTS last_sent_at;
+ALTER TABLE sync.monitored_txs DROP COLUMN IF EXISTS last_sent_block;
+
+-- +migrate Up
+
+ALTER TABLE sync.monitored_txs ADD COLUMN IF NOT EXISTS escalation_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE sync.monitored_txs ADD COLUMN IF NOT EXISTS last_tx_hash BYTEA;
+ALTER TABLE sync.monitored_txs ADD COLUMN IF NOT EXISTS last_sent_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE sync.monitored_txs ADD COLUMN IF NOT EXISTS last_sent_block BIGINT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS sync.monitored_txs_attempt
+(
+    id          SERIAL PRIMARY KEY,
+    deposit_id  BIGINT NOT NULL,
+    tx_hash     BYTEA NOT NULL,
+    nonce       BIGINT NOT NULL,
+    gas_price   VARCHAR,
+    gas_tip_cap VARCHAR,
+    gas_fee_cap VARCHAR,
+    block_num   BIGINT NOT NULL DEFAULT 0,
+    escalation  BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL
+);
+CREATE INDEX IF NOT EXISTS monitored_txs_attempt_deposit_id_idx ON sync.monitored_txs_attempt (deposit_id);
@@ -0,0 +1,25 @@
+-- +migrate Down
+
+DROP TABLE IF EXISTS sync.monitored_txs_operation;
+
+ALTER TABLE sync.monitored_txs DROP COLUMN IF EXISTS revert_reason;
+
+-- +migrate Up
+
+ALTER TABLE sync.monitored_txs ADD COLUMN IF NOT EXISTS revert_reason VARCHAR;
+
+CREATE TABLE IF NOT EXISTS sync.monitored_txs_operation
+(
+    id          SERIAL PRIMARY KEY,
+    deposit_id  BIGINT NOT NULL,
+    action      VARCHAR NOT NULL,
+    operator    VARCHAR NOT NULL DEFAULT '',
+    reason      VARCHAR NOT NULL DEFAULT '',
+    status      VARCHAR NOT NULL,
+    result      VARCHAR NOT NULL DEFAULT '',
+    tx_hash     BYTEA,
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at  TIMESTAMP WITH TIME ZONE NOT NULL
+);
+CREATE INDEX IF NOT EXISTS monitored_txs_operation_deposit_id_idx ON sync.monitored_txs_operation (deposit_id);
+CREATE INDEX IF NOT EXISTS monitored_txs_operation_status_idx ON sync.monitored_txs_operation (status);
@@ -0,0 +1,36 @@
+-- +migrate Down
+
+DROP INDEX IF EXISTS sync.monitored_txs_attempt_deposit_network_idx;
+DROP INDEX IF EXISTS sync.monitored_txs_operation_deposit_network_idx;
+CREATE INDEX IF NOT EXISTS monitored_txs_attempt_deposit_id_idx ON sync.monitored_txs_attempt (deposit_id);
+CREATE INDEX IF NOT EXISTS monitored_txs_operation_deposit_id_idx ON sync.monitored_txs_operation (deposit_id);
+ALTER TABLE sync.monitored_txs_attempt DROP COLUMN IF EXISTS network_id;
+ALTER TABLE sync.monitored_txs_operation DROP COLUMN IF EXISTS network_id;
+
+DELETE FROM sync.monitored_txs WHERE network_id = 0;
+ALTER TABLE sync.monitored_txs DROP CONSTRAINT IF EXISTS monitored_txs_pkey;
+ALTER TABLE sync.monitored_txs ADD PRIMARY KEY (deposit_id);
+ALTER TABLE sync.monitored_txs DROP COLUMN IF EXISTS network_id;
+
+-- +migrate Up
+
+-- the monitored txs are keyed by the deposit and the destination network, so the L2->L1
+-- claims can be tracked in the same table as the L1->L2 ones
+ALTER TABLE sync.monitored_txs ADD COLUMN IF NOT EXISTS network_id INTEGER NOT NULL DEFAULT 0;
+UPDATE sync.monitored_txs AS m SET network_id = d.dest_net
+    FROM sync.deposit AS d WHERE d.network_id = 0 AND d.deposit_cnt = m.deposit_id;
+ALTER TABLE sync.monitored_txs ALTER COLUMN network_id DROP DEFAULT;
+ALTER TABLE sync.monitored_txs DROP CONSTRAINT IF EXISTS monitored_txs_pkey;
+ALTER TABLE sync.monitored_txs ADD PRIMARY KEY (deposit_id, network_id);
+
+ALTER TABLE sync.monitored_txs_attempt ADD COLUMN IF NOT EXISTS network_id INTEGER NOT NULL DEFAULT 0;
+UPDATE sync.monitored_txs_attempt AS a SET network_id = m.network_id
+    FROM sync.monitored_txs AS m WHERE m.deposit_id = a.deposit_id;
+DROP INDEX IF EXISTS sync.monitored_txs_attempt_deposit_id_idx;
+CREATE INDEX IF NOT EXISTS monitored_txs_attempt_deposit_network_idx ON sync.monitored_txs_attempt (deposit_id, network_id);
+
+ALTER TABLE sync.monitored_txs_operation ADD COLUMN IF NOT EXISTS network_id INTEGER NOT NULL DEFAULT 0;
+UPDATE sync.monitored_txs_operation AS o SET network_id = m.network_id
+    FROM sync.monitored_txs AS m WHERE m.deposit_id = o.deposit_id;
+DROP INDEX IF EXISTS sync.monitored_txs_operation_deposit_id_idx;
+CREATE INDEX IF NOT EXISTS monitored_txs_operation_deposit_network_idx ON sync.monitored_txs_operation (deposit_id, network_id);
@@ -0,0 +1,21 @@
+-- +migrate Down
+
+DROP TABLE IF EXISTS sync.reorg_journal;
+
+-- +migrate Up
+
+-- the reorg journal keeps what was rolled back by every reorg, the sync tables lose it when the
+-- blocks are deleted
+CREATE TABLE IF NOT EXISTS sync.reorg_journal
+(
+    id                SERIAL PRIMARY KEY,
+    network_id        INTEGER NOT NULL,
+    from_block        BIGINT NOT NULL,
+    to_block          BIGINT NOT NULL,
+    depth             BIGINT NOT NULL,
+    deposits          JSONB NOT NULL DEFAULT '[]',
+    claims            JSONB NOT NULL DEFAULT '[]',
+    global_exit_roots JSONB NOT NULL DEFAULT '[]',
+    created_at        TIMESTAMP WITH TIME ZONE NOT NULL
+);
+CREATE INDEX IF NOT EXISTS reorg_journal_network_id_idx ON sync.reorg_journal (network_id, id);
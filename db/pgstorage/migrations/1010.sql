@@ -0,0 +1,11 @@
+-- +migrate Down
+
+DROP INDEX IF EXISTS sync.deposit_trusted_idx;
+ALTER TABLE sync.deposit DROP COLUMN IF EXISTS trust_level;
+
+-- +migrate Up
+
+-- the trust level of the deposits of the L2 blocks that are not virtualized yet is 1, it's set to 0 when
+-- the block is virtualized
+ALTER TABLE sync.deposit ADD COLUMN IF NOT EXISTS trust_level SMALLINT NOT NULL DEFAULT 0;
+CREATE INDEX IF NOT EXISTS deposit_trusted_idx ON sync.deposit (network_id, block_id) WHERE trust_level > 0;
@@ -0,0 +1,18 @@
+-- +migrate Down
+
+DROP TABLE IF EXISTS sync.sovereign_exit_root;
+
+-- +migrate Up
+
+-- the global exit roots inserted and removed on the global exit root manager of the sovereign chains, they
+-- replace the trusted global exit roots of the sequencer for the deposits bridged to these chains
+CREATE TABLE IF NOT EXISTS sync.sovereign_exit_root
+(
+    id               SERIAL PRIMARY KEY,
+    block_id         BIGINT NOT NULL REFERENCES sync.block (id) ON DELETE CASCADE,
+    network_id       INTEGER NOT NULL,
+    global_exit_root BYTEA NOT NULL,
+    removed          BOOLEAN NOT NULL DEFAULT FALSE,
+    tx_hash          BYTEA NOT NULL
+);
+CREATE INDEX IF NOT EXISTS sovereign_exit_root_network_id_idx ON sync.sovereign_exit_root (network_id, global_exit_root);
@@ -0,0 +1,9 @@
+-- +migrate Down
+
+ALTER TABLE sync.deposit DROP COLUMN IF EXISTS log_index;
+
+-- +migrate Up
+
+-- the index of the deposit event in its block, to find the deposit of a tx with several deposits. It's null for
+-- the deposits synced before
+ALTER TABLE sync.deposit ADD COLUMN IF NOT EXISTS log_index INTEGER;
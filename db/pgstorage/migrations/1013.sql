@@ -0,0 +1,36 @@
+-- +migrate Down
+
+DROP TABLE IF EXISTS sync.webhook_delivery;
+DROP TABLE IF EXISTS sync.webhook_subscription;
+
+-- +migrate Up
+
+-- the subscriptions are disabled instead of deleted, so their delivery log is kept
+CREATE TABLE IF NOT EXISTS sync.webhook_subscription
+(
+    id          SERIAL PRIMARY KEY,
+    url         VARCHAR NOT NULL,
+    secret      VARCHAR NOT NULL,
+    dest_addr   VARCHAR NOT NULL DEFAULT '',
+    token_addr  VARCHAR NOT NULL DEFAULT '',
+    network_ids INTEGER[] NOT NULL DEFAULT '{}',
+    enabled     BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS sync.webhook_delivery
+(
+    id              BIGSERIAL PRIMARY KEY,
+    subscription_id INTEGER NOT NULL REFERENCES sync.webhook_subscription (id) ON DELETE CASCADE,
+    event           VARCHAR NOT NULL,
+    payload         BYTEA NOT NULL,
+    status          VARCHAR NOT NULL,
+    attempts        INTEGER NOT NULL DEFAULT 0,
+    response_code   INTEGER NOT NULL DEFAULT 0,
+    last_error      VARCHAR NOT NULL DEFAULT '',
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at      TIMESTAMP WITH TIME ZONE NOT NULL
+);
+CREATE INDEX IF NOT EXISTS webhook_delivery_subscription_idx ON sync.webhook_delivery (subscription_id, id);
+CREATE INDEX IF NOT EXISTS webhook_delivery_pending_idx ON sync.webhook_delivery (next_attempt_at) WHERE status = 'pending';
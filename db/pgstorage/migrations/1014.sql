@@ -0,0 +1,19 @@
+-- +migrate Down
+
+DROP TABLE IF EXISTS sync.push_outbox;
+
+-- +migrate Up
+
+-- the transaction updates are stored in the db tx of the change they notify, and pushed by the relay after commit
+CREATE TABLE IF NOT EXISTS sync.push_outbox
+(
+    id         BIGSERIAL PRIMARY KEY,
+    dedup_key  VARCHAR NOT NULL UNIQUE,
+    payload    BYTEA NOT NULL,
+    attempts   INTEGER NOT NULL DEFAULT 0,
+    last_error VARCHAR NOT NULL DEFAULT '',
+    sent_at    TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+CREATE INDEX IF NOT EXISTS push_outbox_pending_idx ON sync.push_outbox (id) WHERE sent_at IS NULL;
+CREATE INDEX IF NOT EXISTS push_outbox_sent_at_idx ON sync.push_outbox (sent_at) WHERE sent_at IS NOT NULL;
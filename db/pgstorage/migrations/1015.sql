@@ -0,0 +1,8 @@
+-- +migrate Down
+
+ALTER TABLE sync.monitored_txs DROP COLUMN IF EXISTS gas_price;
+
+-- +migrate Up
+
+-- the gas price of the last legacy tx is kept, so it can be raised after the monitored tx is reloaded
+ALTER TABLE sync.monitored_txs ADD COLUMN IF NOT EXISTS gas_price VARCHAR;
@@ -0,0 +1,10 @@
+-- +migrate Down
+
+DROP INDEX IF EXISTS sync.webhook_subscription_owner_idx;
+ALTER TABLE sync.webhook_subscription DROP COLUMN IF EXISTS owner;
+
+-- +migrate Up
+
+-- the subscriptions can only be read and deleted by the integrator that added them
+ALTER TABLE sync.webhook_subscription ADD COLUMN IF NOT EXISTS owner VARCHAR NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS webhook_subscription_owner_idx ON sync.webhook_subscription (owner);
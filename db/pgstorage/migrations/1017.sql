@@ -0,0 +1,12 @@
+-- +migrate Down
+
+DROP TABLE IF EXISTS sync.leader_fencing;
+
+-- +migrate Up
+
+-- the highest fencing token of the leader of each role, the writes of a stale leader with an older token are rejected
+CREATE TABLE IF NOT EXISTS sync.leader_fencing
+(
+    lease_key VARCHAR PRIMARY KEY,
+    token     BIGINT NOT NULL
+);
@@ -0,0 +1,14 @@
+-- +migrate Down
+
+DROP INDEX IF EXISTS sync.push_outbox_failed_at_idx;
+DROP INDEX IF EXISTS sync.push_outbox_pending_idx;
+ALTER TABLE sync.push_outbox DROP COLUMN IF EXISTS failed_at;
+CREATE INDEX IF NOT EXISTS push_outbox_pending_idx ON sync.push_outbox (id) WHERE sent_at IS NULL;
+
+-- +migrate Up
+
+-- the messages that can't be pushed, like the ones that can't be decoded, are failed and leave the pending ones
+ALTER TABLE sync.push_outbox ADD COLUMN IF NOT EXISTS failed_at TIMESTAMP WITH TIME ZONE;
+DROP INDEX IF EXISTS sync.push_outbox_pending_idx;
+CREATE INDEX IF NOT EXISTS push_outbox_pending_idx ON sync.push_outbox (id) WHERE sent_at IS NULL AND failed_at IS NULL;
+CREATE INDEX IF NOT EXISTS push_outbox_failed_at_idx ON sync.push_outbox (failed_at) WHERE failed_at IS NOT NULL;
@@ -521,7 +521,7 @@ func (p *PostgresStorage) GetClaims(ctx context.Context, destAddr string, limit
 // GetDeposits gets the deposit list which be smaller than depositCount.
 func (p *PostgresStorage) GetDeposits(ctx context.Context, destAddr string, limit uint, offset uint, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
 	const getDepositsSQL = `
-		SELECT d.id, leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, b.block_num, d.network_id, tx_hash, metadata, ready_for_claim, b.received_at, dest_contract_addr
+		SELECT d.id, leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, b.block_num, d.network_id, tx_hash, metadata, ready_for_claim, b.received_at, dest_contract_addr, trust_level
 		FROM sync.deposit as d INNER JOIN sync.block as b ON d.network_id = b.network_id AND d.block_id = b.id
 		WHERE dest_addr = $1 AND (d.network_id = $4 OR dest_net = $4)
 		ORDER BY d.block_id DESC, d.deposit_cnt DESC LIMIT $2 OFFSET $3`
@@ -584,9 +584,11 @@ func (p *PostgresStorage) UpdateL2DepositsStatus(ctx context.Context, exitRoot [
 // AddClaimTx adds a claim monitored transaction to the storage.
 func (p *PostgresStorage) AddClaimTx(ctx context.Context, mTx ctmtypes.MonitoredTx, dbTx pgx.Tx) error {
 	const addMonitoredTxSQL = `INSERT INTO sync.monitored_txs 
-		(deposit_id, from_addr, to_addr, nonce, value, data, gas, status, history, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
-	_, err := p.getExecQuerier(dbTx).Exec(ctx, addMonitoredTxSQL, mTx.DepositID, mTx.From, mTx.To, mTx.Nonce, mTx.Value.String(), mTx.Data, mTx.Gas, mTx.Status, pq.Array(mTx.HistoryHashSlice()), time.Now().UTC(), time.Now().UTC())
+		(deposit_id, from_addr, to_addr, nonce, value, data, gas, status, history, created_at, updated_at, gas_tip_cap, gas_fee_cap,
+		escalation_count, last_tx_hash, last_sent_at, last_sent_block, revert_reason, network_id, gas_price)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20)`
+	_, err := p.getExecQuerier(dbTx).Exec(ctx, addMonitoredTxSQL, mTx.DepositID, mTx.From, mTx.To, mTx.Nonce, mTx.Value.String(), mTx.Data, mTx.Gas, mTx.Status, pq.Array(mTx.HistoryHashSlice()), time.Now().UTC(), time.Now().UTC(),
+		bigIntToNullString(mTx.GasTipCap), bigIntToNullString(mTx.GasFeeCap), mTx.EscalationCount, lastTxHashToBytes(mTx.LastTxHash), timeToNullTime(mTx.LastSentAt), mTx.LastSentBlock, mTx.RevertReason, mTx.NetworkID, bigIntToNullString(mTx.GasPrice))
 	return err
 }
 
@@ -602,14 +604,23 @@ func (p *PostgresStorage) UpdateClaimTx(ctx context.Context, mTx ctmtypes.Monito
 		, status = $8
 		, history = $9
 		, updated_at = $10
-		WHERE deposit_id = $1`
-	_, err := p.getExecQuerier(dbTx).Exec(ctx, updateMonitoredTxSQL, mTx.DepositID, mTx.From, mTx.To, mTx.Nonce, mTx.Value.String(), mTx.Data, mTx.Gas, mTx.Status, pq.Array(mTx.HistoryHashSlice()), time.Now().UTC())
+		, gas_tip_cap = $11
+		, gas_fee_cap = $12
+		, escalation_count = $13
+		, last_tx_hash = $14
+		, last_sent_at = $15
+		, last_sent_block = $16
+		, revert_reason = $17
+		, gas_price = $19
+		WHERE deposit_id = $1 AND network_id = $18`
+	_, err := p.getExecQuerier(dbTx).Exec(ctx, updateMonitoredTxSQL, mTx.DepositID, mTx.From, mTx.To, mTx.Nonce, mTx.Value.String(), mTx.Data, mTx.Gas, mTx.Status, pq.Array(mTx.HistoryHashSlice()), time.Now().UTC(),
+		bigIntToNullString(mTx.GasTipCap), bigIntToNullString(mTx.GasFeeCap), mTx.EscalationCount, lastTxHashToBytes(mTx.LastTxHash), timeToNullTime(mTx.LastSentAt), mTx.LastSentBlock, mTx.RevertReason, mTx.NetworkID, bigIntToNullString(mTx.GasPrice))
 	return err
 }
 
 // GetClaimTxsByStatus gets the monitored transactions by status.
 func (p *PostgresStorage) GetClaimTxsByStatus(ctx context.Context, statuses []ctmtypes.MonitoredTxStatus, dbTx pgx.Tx) ([]ctmtypes.MonitoredTx, error) {
-	const getMonitoredTxsSQL = "SELECT deposit_id, from_addr, to_addr, nonce, value, data, gas, status, history, created_at, updated_at FROM sync.monitored_txs WHERE status = ANY($1) ORDER BY created_at ASC"
+	const getMonitoredTxsSQL = "SELECT " + monitoredTxColumns + " FROM sync.monitored_txs WHERE status = ANY($1) ORDER BY created_at ASC"
 	rows, err := p.getExecQuerier(dbTx).Query(ctx, getMonitoredTxsSQL, pq.Array(statuses))
 	if errors.Is(err, pgx.ErrNoRows) {
 		return []ctmtypes.MonitoredTx{}, nil
@@ -619,21 +630,11 @@ func (p *PostgresStorage) GetClaimTxsByStatus(ctx context.Context, statuses []ct
 
 	mTxs := make([]ctmtypes.MonitoredTx, 0, len(rows.RawValues()))
 	for rows.Next() {
-		var (
-			value   string
-			history [][]byte
-		)
-		mTx := ctmtypes.MonitoredTx{}
-		err = rows.Scan(&mTx.DepositID, &mTx.From, &mTx.To, &mTx.Nonce, &value, &mTx.Data, &mTx.Gas, &mTx.Status, pq.Array(&history), &mTx.CreatedAt, &mTx.UpdatedAt)
+		mTx, err := scanMonitoredTx(rows)
 		if err != nil {
 			return mTxs, err
 		}
-		mTx.Value, _ = new(big.Int).SetString(value, 10) //nolint:gomnd
-		mTx.History = make(map[common.Hash]bool)
-		for _, h := range history {
-			mTx.History[common.BytesToHash(h)] = true
-		}
-		mTxs = append(mTxs, mTx)
+		mTxs = append(mTxs, *mTx)
 	}
 
 	return mTxs, nil
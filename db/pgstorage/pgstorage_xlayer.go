@@ -2,6 +2,7 @@ package pgstorage
 
 import (
 	"context"
+	"encoding/json"
 	"math/big"
 	"time"
 
@@ -9,8 +10,10 @@ import (
 	ctmtypes "github.com/0xPolygonHermez/zkevm-bridge-service/claimtxman/types"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/pushoutbox"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/utils"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/webhook"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/jackc/pgx/v4"
 	"github.com/lib/pq"
@@ -19,17 +22,17 @@ import (
 
 // AddDeposit adds new deposit to the storage.
 func (p *PostgresStorage) AddDepositXLayer(ctx context.Context, deposit *etherman.Deposit, dbTx pgx.Tx) (uint64, error) {
-	const addDepositSQL = "INSERT INTO sync.deposit (leaf_type, network_id, orig_net, orig_addr, amount, dest_net, dest_addr, block_id, deposit_cnt, tx_hash, metadata, dest_contract_addr) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) RETURNING id"
+	const addDepositSQL = "INSERT INTO sync.deposit (leaf_type, network_id, orig_net, orig_addr, amount, dest_net, dest_addr, block_id, deposit_cnt, tx_hash, metadata, dest_contract_addr, trust_level, log_index) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14) RETURNING id"
 	e := p.getExecQuerier(dbTx)
 	var depositID uint64
-	err := e.QueryRow(ctx, addDepositSQL, deposit.LeafType, deposit.NetworkID, deposit.OriginalNetwork, deposit.OriginalAddress, deposit.Amount.String(), deposit.DestinationNetwork, deposit.DestinationAddress, deposit.BlockID, deposit.DepositCount, deposit.TxHash, deposit.Metadata, deposit.DestContractAddress).Scan(&depositID)
+	err := e.QueryRow(ctx, addDepositSQL, deposit.LeafType, deposit.NetworkID, deposit.OriginalNetwork, deposit.OriginalAddress, deposit.Amount.String(), deposit.DestinationNetwork, deposit.DestinationAddress, deposit.BlockID, deposit.DepositCount, deposit.TxHash, deposit.Metadata, deposit.DestContractAddress, deposit.TrustLevel, deposit.LogIndex).Scan(&depositID)
 	return depositID, err
 }
 
 // GetDepositsXLayer gets the deposit list which be smaller than depositCount.
 func (p *PostgresStorage) GetDepositsXLayer(ctx context.Context, destAddr string, limit uint, offset uint, messageAllowlist []common.Address, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
 	const getDepositsSQL = `
-		SELECT d.id, leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, b.block_num, d.network_id, tx_hash, metadata, ready_for_claim, b.received_at, dest_contract_addr
+		SELECT d.id, leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, b.block_num, d.network_id, tx_hash, metadata, ready_for_claim, b.received_at, dest_contract_addr, trust_level
 		FROM sync.deposit as d INNER JOIN sync.block as b ON d.network_id = b.network_id AND d.block_id = b.id
 		WHERE dest_addr = $1 AND (leaf_type = 0 OR (leaf_type = 1 AND orig_addr = ANY($4))) AND (d.network_id = $5 OR dest_net = $5)
 		ORDER BY d.block_id DESC, d.deposit_cnt DESC LIMIT $2 OFFSET $3`
@@ -63,7 +66,7 @@ func (p *PostgresStorage) GetDepositByHash(ctx context.Context, destAddr string,
 
 // GetPendingTransactions gets all the deposit transactions of a user that have not been claimed
 func (p *PostgresStorage) GetPendingTransactions(ctx context.Context, destAddr string, limit uint, offset uint, messageAllowlist []common.Address, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
-	const getDepositsSQL = `SELECT d.id, leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, b.block_num, d.network_id, tx_hash, metadata, ready_for_claim, b.received_at, dest_contract_addr
+	const getDepositsSQL = `SELECT d.id, leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, b.block_num, d.network_id, tx_hash, metadata, ready_for_claim, b.received_at, dest_contract_addr, trust_level
 		FROM sync.deposit as d INNER JOIN sync.block as b ON d.network_id = b.network_id AND d.block_id = b.id
 		WHERE dest_addr = $1 AND (leaf_type = 0 OR (leaf_type = 1 AND orig_addr = ANY($4))) AND (d.network_id = $5 OR dest_net = $5)  AND NOT EXISTS
 			(SELECT 1 FROM sync.claim as c WHERE c.index = d.deposit_cnt AND c.network_id = d.dest_net)
@@ -87,7 +90,7 @@ func (p *PostgresStorage) GetNotReadyTransactions(ctx context.Context, limit uin
 }
 
 func (p *PostgresStorage) GetReadyPendingTransactions(ctx context.Context, networkID uint, limit uint, offset uint, minReadyTime time.Time, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
-	const getDepositsSQL = `SELECT d.id, leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, b.block_num, d.network_id, tx_hash, metadata, ready_for_claim, b.received_at, dest_contract_addr
+	const getDepositsSQL = `SELECT d.id, leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, b.block_num, d.network_id, tx_hash, metadata, ready_for_claim, b.received_at, dest_contract_addr, trust_level
 		FROM sync.deposit as d INNER JOIN sync.block as b ON d.network_id = b.network_id AND d.block_id = b.id
 		WHERE d.network_id = $1 AND ready_for_claim = true AND ready_time >= $4 AND (d.network_id = $5 OR dest_net = $5) AND NOT EXISTS
 			(SELECT 1 FROM sync.claim as c WHERE c.index = d.deposit_cnt AND c.network_id = d.dest_net)
@@ -110,7 +113,7 @@ func (p *PostgresStorage) getDepositList(ctx context.Context, sql string, dbTx p
 			amount  string
 		)
 		err = rows.Scan(&deposit.Id, &deposit.LeafType, &deposit.OriginalNetwork, &deposit.OriginalAddress, &amount, &deposit.DestinationNetwork, &deposit.DestinationAddress,
-			&deposit.DepositCount, &deposit.BlockID, &deposit.BlockNumber, &deposit.NetworkID, &deposit.TxHash, &deposit.Metadata, &deposit.ReadyForClaim, &deposit.Time, &deposit.DestContractAddress)
+			&deposit.DepositCount, &deposit.BlockID, &deposit.BlockNumber, &deposit.NetworkID, &deposit.TxHash, &deposit.Metadata, &deposit.ReadyForClaim, &deposit.Time, &deposit.DestContractAddress, &deposit.TrustLevel)
 		if err != nil {
 			return nil, err
 		}
@@ -122,7 +125,7 @@ func (p *PostgresStorage) getDepositList(ctx context.Context, sql string, dbTx p
 }
 
 func (p *PostgresStorage) GetNotReadyTransactionsWithBlockRange(ctx context.Context, networkID uint, minBlockNum, maxBlockNum uint64, limit, offset uint, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
-	const getDepositsSQL = `SELECT d.id, leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, b.block_num, d.network_id, tx_hash, metadata, ready_for_claim, b.received_at, dest_contract_addr
+	const getDepositsSQL = `SELECT d.id, leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, b.block_num, d.network_id, tx_hash, metadata, ready_for_claim, b.received_at, dest_contract_addr, trust_level
 		FROM sync.deposit as d INNER JOIN sync.block as b ON d.network_id = b.network_id AND d.block_id = b.id
 		WHERE b.network_id = $1 AND ready_for_claim = false AND b.block_num >= $2 AND b.block_num <= $3
 		ORDER BY d.block_id DESC, d.deposit_cnt DESC LIMIT $4 OFFSET $5`
@@ -151,13 +154,13 @@ func (p *PostgresStorage) GetNotReadyTransactionsWithBlockRange(ctx context.Cont
 	return deposits, nil
 }
 
-// GetL1Deposits get the L1 deposits remain to be ready_for_claim
-func (p *PostgresStorage) GetL1Deposits(ctx context.Context, exitRoot []byte, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
+// GetL1Deposits get the L1 deposits to the destination network remain to be ready_for_claim
+func (p *PostgresStorage) GetL1Deposits(ctx context.Context, exitRoot []byte, destNetwork uint, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
 	const updateDepositsStatusSQL = `Select d.id, leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, b.block_num, d.network_id, tx_hash, metadata, ready_for_claim, b.received_at, dest_contract_addr
 			FROM sync.deposit as d INNER JOIN sync.block as b ON d.network_id = b.network_id AND d.block_id = b.id
 			WHERE deposit_cnt <= (SELECT d.deposit_cnt FROM mt.root as r INNER JOIN sync.deposit as d ON d.id = r.deposit_id WHERE r.root = $1 AND r.network = 0) 
-			AND d.network_id = 0 AND ready_for_claim = false`
-	rows, err := p.getExecQuerier(dbTx).Query(ctx, updateDepositsStatusSQL, exitRoot)
+			AND d.network_id = 0 AND dest_net = $2 AND ready_for_claim = false`
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, updateDepositsStatusSQL, exitRoot, destNetwork)
 	if err != nil {
 		return nil, err
 	}
@@ -193,7 +196,7 @@ func (p *PostgresStorage) UpdateL2DepositsStatusXLayer(ctx context.Context, exit
 			(SELECT d.deposit_cnt FROM mt.root as r INNER JOIN sync.deposit as d ON d.id = r.deposit_id WHERE r.root = (select leaf from mt.rollup_exit where root = $1 and rollup_id = $2) AND r.network = $3)
 			AND network_id = $3 AND ready_for_claim = false
 			RETURNING *)
-		SELECT d.id, leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, b.block_num, d.network_id, tx_hash, metadata, ready_for_claim, b.received_at
+		SELECT d.id, leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, b.block_num, d.network_id, tx_hash, metadata, ready_for_claim, b.received_at, dest_contract_addr
 		FROM d INNER JOIN sync.block as b ON d.network_id = b.network_id AND d.block_id = b.id`
 	rows, err := p.getExecQuerier(dbTx).Query(ctx, updateDepositsStatusSQL, exitRoot, rollupID, networkID, time.Now())
 	if err != nil {
@@ -206,7 +209,7 @@ func (p *PostgresStorage) UpdateL2DepositsStatusXLayer(ctx context.Context, exit
 			amount  string
 		)
 		err = rows.Scan(&deposit.Id, &deposit.LeafType, &deposit.OriginalNetwork, &deposit.OriginalAddress, &amount, &deposit.DestinationNetwork, &deposit.DestinationAddress,
-			&deposit.DepositCount, &deposit.BlockID, &deposit.BlockNumber, &deposit.NetworkID, &deposit.TxHash, &deposit.Metadata, &deposit.ReadyForClaim, &deposit.Time)
+			&deposit.DepositCount, &deposit.BlockID, &deposit.BlockNumber, &deposit.NetworkID, &deposit.TxHash, &deposit.Metadata, &deposit.ReadyForClaim, &deposit.Time, &deposit.DestContractAddress)
 		if err != nil {
 			return nil, err
 		}
@@ -217,7 +220,7 @@ func (p *PostgresStorage) UpdateL2DepositsStatusXLayer(ctx context.Context, exit
 }
 
 func (p *PostgresStorage) GetClaimTxsByStatusWithLimit(ctx context.Context, statuses []ctmtypes.MonitoredTxStatus, limit uint, offset uint, dbTx pgx.Tx) ([]ctmtypes.MonitoredTx, error) {
-	const getMonitoredTxsSQL = "SELECT deposit_id, from_addr, to_addr, nonce, value, data, gas, status, history, created_at, updated_at FROM sync.monitored_txs WHERE status = ANY($1) ORDER BY created_at DESC LIMIT $2 OFFSET $3"
+	const getMonitoredTxsSQL = "SELECT " + monitoredTxColumns + " FROM sync.monitored_txs WHERE status = ANY($1) ORDER BY created_at DESC LIMIT $2 OFFSET $3"
 	rows, err := p.getExecQuerier(dbTx).Query(ctx, getMonitoredTxsSQL, pq.Array(statuses), limit, offset)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return []ctmtypes.MonitoredTx{}, nil
@@ -227,48 +230,59 @@ func (p *PostgresStorage) GetClaimTxsByStatusWithLimit(ctx context.Context, stat
 
 	mTxs := make([]ctmtypes.MonitoredTx, 0, len(rows.RawValues()))
 	for rows.Next() {
-		var (
-			value   string
-			history [][]byte
-		)
-		mTx := ctmtypes.MonitoredTx{}
-		err = rows.Scan(&mTx.DepositID, &mTx.From, &mTx.To, &mTx.Nonce, &value, &mTx.Data, &mTx.Gas, &mTx.Status, pq.Array(&history), &mTx.CreatedAt, &mTx.UpdatedAt)
+		mTx, err := scanMonitoredTx(rows)
 		if err != nil {
 			return mTxs, err
 		}
-		mTx.Value, _ = new(big.Int).SetString(value, 10) //nolint:gomnd
-		mTx.History = make(map[common.Hash]bool)
-		for _, h := range history {
-			mTx.History[common.BytesToHash(h)] = true
+		mTxs = append(mTxs, *mTx)
+	}
+
+	return mTxs, nil
+}
+
+// GetClaimTxsByStatusAndNetwork gets the monitored transactions by status sent to the network
+func (p *PostgresStorage) GetClaimTxsByStatusAndNetwork(ctx context.Context, statuses []ctmtypes.MonitoredTxStatus, networkID uint, limit uint, offset uint, dbTx pgx.Tx) ([]ctmtypes.MonitoredTx, error) {
+	const getMonitoredTxsSQL = "SELECT " + monitoredTxColumns + " FROM sync.monitored_txs WHERE status = ANY($1) AND network_id = $2 ORDER BY created_at DESC LIMIT $3 OFFSET $4"
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, getMonitoredTxsSQL, pq.Array(statuses), networkID, limit, offset)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return []ctmtypes.MonitoredTx{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	mTxs := make([]ctmtypes.MonitoredTx, 0, len(rows.RawValues()))
+	for rows.Next() {
+		mTx, err := scanMonitoredTx(rows)
+		if err != nil {
+			return mTxs, err
 		}
-		mTxs = append(mTxs, mTx)
+		mTxs = append(mTxs, *mTx)
 	}
 
 	return mTxs, nil
 }
 
-// GetClaimTxById gets the monitored transactions by id (depositCount)
-func (p *PostgresStorage) GetClaimTxById(ctx context.Context, id uint, dbTx pgx.Tx) (*ctmtypes.MonitoredTx, error) {
-	getClaimSql := "SELECT deposit_id, from_addr, to_addr, nonce, value, data, gas, status, history, created_at, updated_at FROM sync.monitored_txs WHERE deposit_id = $1"
-	var (
-		value   string
-		history [][]byte
-		mTx     = &ctmtypes.MonitoredTx{}
-	)
-	err := p.getExecQuerier(dbTx).QueryRow(ctx, getClaimSql, id).
-		Scan(&mTx.DepositID, &mTx.From, &mTx.To, &mTx.Nonce, &value, &mTx.Data, &mTx.Gas, &mTx.Status, pq.Array(&history), &mTx.CreatedAt, &mTx.UpdatedAt)
+// CountClaimTxsByDestAddress returns the number of monitored txs created since the time for the deposits
+// made on the network to the destination address
+func (p *PostgresStorage) CountClaimTxsByDestAddress(ctx context.Context, destAddr common.Address, networkID uint, since time.Time, dbTx pgx.Tx) (uint64, error) {
+	const countClaimTxsSQL = `SELECT COUNT(*) FROM sync.monitored_txs AS m
+		INNER JOIN sync.deposit AS d ON d.deposit_cnt = m.deposit_id AND d.dest_net = m.network_id AND d.network_id = $2
+		WHERE d.dest_addr = $1 AND m.created_at >= $3`
+	var count uint64
+	err := p.getExecQuerier(dbTx).QueryRow(ctx, countClaimTxsSQL, destAddr, networkID, since).Scan(&count)
+	return count, err
+}
+
+// GetClaimTxById gets the monitored transactions by id (depositCount) and destination network
+func (p *PostgresStorage) GetClaimTxById(ctx context.Context, id uint, networkID uint, dbTx pgx.Tx) (*ctmtypes.MonitoredTx, error) {
+	getClaimSql := "SELECT " + monitoredTxColumns + " FROM sync.monitored_txs WHERE deposit_id = $1 AND network_id = $2"
+	mTx, err := scanMonitoredTx(p.getExecQuerier(dbTx).QueryRow(ctx, getClaimSql, id, networkID))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, gerror.ErrStorageNotFound
 		}
 		return nil, err
 	}
-	mTx.Value, _ = new(big.Int).SetString(value, 10) //nolint:gomnd
-	mTx.History = make(map[common.Hash]bool)
-	for _, h := range history {
-		mTx.History[common.BytesToHash(h)] = true
-	}
-
 	return mTx, nil
 }
 
@@ -329,16 +343,17 @@ func (p *PostgresStorage) GetLatestReadyDeposits(ctx context.Context, networkID
 	return deposits, nil
 }
 
-// UpdateL1DepositsStatusXLayer updates the ready_for_claim status of L1 deposits.
-func (p *PostgresStorage) UpdateL1DepositsStatusXLayer(ctx context.Context, exitRoot []byte, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
+// UpdateL1DepositsStatusXLayer updates the ready_for_claim status of the L1 deposits to the destination network,
+// every network gets the global exit root at its own time
+func (p *PostgresStorage) UpdateL1DepositsStatusXLayer(ctx context.Context, exitRoot []byte, destNetwork uint, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
 	const updateDepositsStatusSQL = `WITH d AS (UPDATE sync.deposit SET ready_for_claim = true, ready_time = $1 
 		WHERE deposit_cnt <=
 			(SELECT d.deposit_cnt FROM mt.root as r INNER JOIN sync.deposit as d ON d.id = r.deposit_id WHERE r.root = $2 AND r.network = 0) 
-			AND network_id = 0 AND ready_for_claim = false
+			AND network_id = 0 AND dest_net = $3 AND ready_for_claim = false
 			RETURNING *)
 		SELECT d.id, leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, b.block_num, d.network_id, tx_hash, metadata, ready_for_claim, b.received_at, dest_contract_addr
 		FROM d INNER JOIN sync.block as b ON d.network_id = b.network_id AND d.block_id = b.id`
-	rows, err := p.getExecQuerier(dbTx).Query(ctx, updateDepositsStatusSQL, time.Now(), exitRoot)
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, updateDepositsStatusSQL, time.Now(), exitRoot, destNetwork)
 	if err != nil {
 		return nil, err
 	}
@@ -363,7 +378,7 @@ func (p *PostgresStorage) UpdateL1DepositsStatusXLayer(ctx context.Context, exit
 // GetDepositsForUnitTest gets the deposit list which be smaller than depositCount.
 func (p *PostgresStorage) GetDepositsForUnitTest(ctx context.Context, destAddr string, limit uint, offset uint, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
 	const getDepositsSQL = `
-		SELECT d.id, leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, b.block_num, d.network_id, tx_hash, metadata, ready_for_claim, b.received_at, dest_contract_addr
+		SELECT d.id, leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, b.block_num, d.network_id, tx_hash, metadata, ready_for_claim, b.received_at, dest_contract_addr, trust_level
 		FROM sync.deposit as d INNER JOIN sync.block as b ON d.network_id = b.network_id AND d.block_id = b.id
 		WHERE dest_addr = $1
 		ORDER BY d.block_id DESC, d.deposit_cnt DESC LIMIT $2 OFFSET $3`
@@ -398,3 +413,757 @@ func (p *PostgresStorage) SetBridgeBalance(ctx context.Context, originalTokenAdd
 	_, err := p.getExecQuerier(dbTx).Exec(ctx, setBridgeBalanceSQL, originalTokenAddr, networkID, balance.String(), time.Now())
 	return err
 }
+
+// monitoredTxColumns are the columns read by scanMonitoredTx, in order
+const monitoredTxColumns = "deposit_id, from_addr, to_addr, nonce, value, data, gas, status, history, created_at, updated_at, " +
+	"gas_tip_cap, gas_fee_cap, escalation_count, last_tx_hash, last_sent_at, last_sent_block, revert_reason, network_id, gas_price"
+
+// scanMonitoredTx reads a monitored tx selected with monitoredTxColumns
+func scanMonitoredTx(row pgx.Row) (*ctmtypes.MonitoredTx, error) {
+	var (
+		value                string
+		history              [][]byte
+		gasTipCap, gasFeeCap *string
+		gasPrice             *string
+		lastTxHash           []byte
+		lastSentAt           *time.Time
+		revertReason         *string
+		mTx                  = &ctmtypes.MonitoredTx{}
+	)
+	err := row.Scan(&mTx.DepositID, &mTx.From, &mTx.To, &mTx.Nonce, &value, &mTx.Data, &mTx.Gas, &mTx.Status, pq.Array(&history), &mTx.CreatedAt, &mTx.UpdatedAt,
+		&gasTipCap, &gasFeeCap, &mTx.EscalationCount, &lastTxHash, &lastSentAt, &mTx.LastSentBlock, &revertReason, &mTx.NetworkID, &gasPrice)
+	if err != nil {
+		return nil, err
+	}
+	mTx.Value, _ = new(big.Int).SetString(value, 10) //nolint:gomnd
+	mTx.GasPrice = nullStringToBigInt(gasPrice)
+	mTx.GasTipCap = nullStringToBigInt(gasTipCap)
+	mTx.GasFeeCap = nullStringToBigInt(gasFeeCap)
+	mTx.LastTxHash = common.BytesToHash(lastTxHash)
+	if lastSentAt != nil {
+		mTx.LastSentAt = *lastSentAt
+	}
+	if revertReason != nil {
+		mTx.RevertReason = *revertReason
+	}
+	mTx.History = make(map[common.Hash]bool)
+	for _, h := range history {
+		mTx.History[common.BytesToHash(h)] = true
+	}
+	return mTx, nil
+}
+
+// bigIntToNullString converts an optional big int into a nullable column value
+func bigIntToNullString(v *big.Int) *string {
+	if v == nil {
+		return nil
+	}
+	s := v.String()
+	return &s
+}
+
+// nullStringToBigInt converts a nullable column value into an optional big int
+func nullStringToBigInt(s *string) *big.Int {
+	if s == nil {
+		return nil
+	}
+	v, _ := new(big.Int).SetString(*s, 10) //nolint:gomnd
+	return v
+}
+
+// lastTxHashToBytes converts the last sent tx hash into a nullable column value
+func lastTxHashToBytes(h common.Hash) []byte {
+	if h == (common.Hash{}) {
+		return nil
+	}
+	return h.Bytes()
+}
+
+// timeToNullTime converts a time into a nullable column value
+func timeToNullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// AddClaimTxAttempt stores a tx sent to the network for a monitored tx
+func (p *PostgresStorage) AddClaimTxAttempt(ctx context.Context, attempt ctmtypes.MonitoredTxAttempt, dbTx pgx.Tx) error {
+	const addAttemptSQL = `INSERT INTO sync.monitored_txs_attempt
+		(deposit_id, tx_hash, nonce, gas_price, gas_tip_cap, gas_fee_cap, block_num, escalation, created_at, network_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+	_, err := p.getExecQuerier(dbTx).Exec(ctx, addAttemptSQL, attempt.DepositID, attempt.TxHash, attempt.Nonce, bigIntToNullString(attempt.GasPrice),
+		bigIntToNullString(attempt.GasTipCap), bigIntToNullString(attempt.GasFeeCap), attempt.BlockNumber, attempt.Escalation, time.Now().UTC(), attempt.NetworkID)
+	return err
+}
+
+// GetClaimTxAttempts returns all the txs sent to the network for a monitored tx, oldest first
+func (p *PostgresStorage) GetClaimTxAttempts(ctx context.Context, depositID uint, networkID uint, dbTx pgx.Tx) ([]ctmtypes.MonitoredTxAttempt, error) {
+	const getAttemptsSQL = `SELECT deposit_id, tx_hash, nonce, gas_price, gas_tip_cap, gas_fee_cap, block_num, escalation, created_at, network_id
+		FROM sync.monitored_txs_attempt WHERE deposit_id = $1 AND network_id = $2 ORDER BY id ASC`
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, getAttemptsSQL, depositID, networkID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var attempts []ctmtypes.MonitoredTxAttempt
+	for rows.Next() {
+		var (
+			attempt                        ctmtypes.MonitoredTxAttempt
+			gasPrice, gasTipCap, gasFeeCap *string
+		)
+		err = rows.Scan(&attempt.DepositID, &attempt.TxHash, &attempt.Nonce, &gasPrice, &gasTipCap, &gasFeeCap, &attempt.BlockNumber, &attempt.Escalation, &attempt.CreatedAt, &attempt.NetworkID)
+		if err != nil {
+			return nil, err
+		}
+		attempt.GasPrice = nullStringToBigInt(gasPrice)
+		attempt.GasTipCap = nullStringToBigInt(gasTipCap)
+		attempt.GasFeeCap = nullStringToBigInt(gasFeeCap)
+		attempts = append(attempts, attempt)
+	}
+	return attempts, nil
+}
+
+// operationColumns are the columns read by scanOperation, in order
+const operationColumns = "id, deposit_id, action, operator, reason, status, result, tx_hash, created_at, updated_at, network_id"
+
+// scanOperation reads a monitored tx operation selected with operationColumns
+func scanOperation(row pgx.Row) (*ctmtypes.MonitoredTxOperation, error) {
+	var (
+		txHash []byte
+		op     = &ctmtypes.MonitoredTxOperation{}
+	)
+	err := row.Scan(&op.ID, &op.DepositID, &op.Action, &op.Operator, &op.Reason, &op.Status, &op.Result, &txHash, &op.CreatedAt, &op.UpdatedAt, &op.NetworkID)
+	if err != nil {
+		return nil, err
+	}
+	op.TxHash = common.BytesToHash(txHash)
+	return op, nil
+}
+
+// AddClaimTxOperation stores an operation requested on a monitored tx and returns its id
+func (p *PostgresStorage) AddClaimTxOperation(ctx context.Context, op ctmtypes.MonitoredTxOperation, dbTx pgx.Tx) (uint64, error) {
+	const addOperationSQL = `INSERT INTO sync.monitored_txs_operation
+		(deposit_id, action, operator, reason, status, result, tx_hash, created_at, updated_at, network_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING id`
+	var id uint64
+	err := p.getExecQuerier(dbTx).QueryRow(ctx, addOperationSQL, op.DepositID, op.Action, op.Operator, op.Reason, op.Status, op.Result,
+		lastTxHashToBytes(op.TxHash), time.Now().UTC(), time.Now().UTC(), op.NetworkID).Scan(&id)
+	return id, err
+}
+
+// UpdateClaimTxOperation updates the status and the result of an operation
+func (p *PostgresStorage) UpdateClaimTxOperation(ctx context.Context, op ctmtypes.MonitoredTxOperation, dbTx pgx.Tx) error {
+	const updateOperationSQL = `UPDATE sync.monitored_txs_operation SET status = $2, result = $3, tx_hash = $4, updated_at = $5 WHERE id = $1`
+	_, err := p.getExecQuerier(dbTx).Exec(ctx, updateOperationSQL, op.ID, op.Status, op.Result, lastTxHashToBytes(op.TxHash), time.Now().UTC())
+	return err
+}
+
+// GetClaimTxOperationsByStatus returns the operations with the status, oldest first
+func (p *PostgresStorage) GetClaimTxOperationsByStatus(ctx context.Context, status ctmtypes.MonitoredTxOperationStatus, dbTx pgx.Tx) ([]ctmtypes.MonitoredTxOperation, error) {
+	getOperationsSQL := "SELECT " + operationColumns + " FROM sync.monitored_txs_operation WHERE status = $1 ORDER BY id ASC"
+	return p.getClaimTxOperations(ctx, getOperationsSQL, dbTx, status)
+}
+
+// GetClaimTxOperations returns the audit trail of the operations requested on a monitored tx, oldest first
+func (p *PostgresStorage) GetClaimTxOperations(ctx context.Context, depositID uint, networkID uint, dbTx pgx.Tx) ([]ctmtypes.MonitoredTxOperation, error) {
+	getOperationsSQL := "SELECT " + operationColumns + " FROM sync.monitored_txs_operation WHERE deposit_id = $1 AND network_id = $2 ORDER BY id ASC"
+	return p.getClaimTxOperations(ctx, getOperationsSQL, dbTx, depositID, networkID)
+}
+
+func (p *PostgresStorage) getClaimTxOperations(ctx context.Context, sql string, dbTx pgx.Tx, args ...interface{}) ([]ctmtypes.MonitoredTxOperation, error) {
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ops []ctmtypes.MonitoredTxOperation
+	for rows.Next() {
+		op, err := scanOperation(rows)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, *op)
+	}
+	return ops, nil
+}
+
+// GetDepositsAfterBlock gets the deposits of the network stored in the blocks after the block number
+func (p *PostgresStorage) GetDepositsAfterBlock(ctx context.Context, blockNumber uint64, networkID uint, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
+	const getDepositsSQL = `SELECT d.id, leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, b.block_num, d.network_id, tx_hash, metadata, ready_for_claim, b.received_at, dest_contract_addr, trust_level
+		FROM sync.deposit as d INNER JOIN sync.block as b ON d.network_id = b.network_id AND d.block_id = b.id
+		WHERE b.network_id = $1 AND b.block_num > $2
+		ORDER BY d.deposit_cnt ASC`
+	return p.getDepositList(ctx, getDepositsSQL, dbTx, networkID, blockNumber)
+}
+
+// GetClaimsAfterBlock gets the claims of the network stored in the blocks after the block number
+func (p *PostgresStorage) GetClaimsAfterBlock(ctx context.Context, blockNumber uint64, networkID uint, dbTx pgx.Tx) ([]*etherman.Claim, error) {
+	const getClaimsSQL = `SELECT index, orig_net, orig_addr, amount, dest_addr, block_id, b.block_num, c.network_id, tx_hash, b.received_at, rollup_index, mainnet_flag
+		FROM sync.claim as c INNER JOIN sync.block as b ON c.network_id = b.network_id AND c.block_id = b.id
+		WHERE b.network_id = $1 AND b.block_num > $2
+		ORDER BY b.block_num ASC`
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, getClaimsSQL, networkID, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var claims []*etherman.Claim
+	for rows.Next() {
+		var (
+			claim  etherman.Claim
+			amount string
+		)
+		err = rows.Scan(&claim.Index, &claim.OriginalNetwork, &claim.OriginalAddress, &amount, &claim.DestinationAddress, &claim.BlockID, &claim.BlockNumber, &claim.NetworkID, &claim.TxHash, &claim.Time, &claim.RollupIndex, &claim.MainnetFlag)
+		if err != nil {
+			return nil, err
+		}
+		claim.Amount, _ = new(big.Int).SetString(amount, 10) //nolint:gomnd
+		claims = append(claims, &claim)
+	}
+	return claims, nil
+}
+
+// GetGlobalExitRootsAfterBlock gets the global exit roots of the network stored in the blocks after the block number
+func (p *PostgresStorage) GetGlobalExitRootsAfterBlock(ctx context.Context, blockNumber uint64, networkID uint, dbTx pgx.Tx) ([]*etherman.GlobalExitRoot, error) {
+	const getExitRootsSQL = `SELECT block_id, b.block_num, global_exit_root, exit_roots, b.received_at
+		FROM sync.exit_root as e INNER JOIN sync.block as b ON e.block_id = b.id
+		WHERE b.network_id = $1 AND b.block_num > $2
+		ORDER BY e.id ASC`
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, getExitRootsSQL, networkID, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var gers []*etherman.GlobalExitRoot
+	for rows.Next() {
+		var (
+			ger       etherman.GlobalExitRoot
+			exitRoots [][]byte
+		)
+		err = rows.Scan(&ger.BlockID, &ger.BlockNumber, &ger.GlobalExitRoot, pq.Array(&exitRoots), &ger.Time)
+		if err != nil {
+			return nil, err
+		}
+		for _, exitRoot := range exitRoots {
+			ger.ExitRoots = append(ger.ExitRoots, common.BytesToHash(exitRoot))
+		}
+		gers = append(gers, &ger)
+	}
+	return gers, nil
+}
+
+// AddReorgJournal stores what was rolled back by a reorg
+func (p *PostgresStorage) AddReorgJournal(ctx context.Context, journal *etherman.ReorgJournal, dbTx pgx.Tx) (uint64, error) {
+	const addReorgJournalSQL = `INSERT INTO sync.reorg_journal (network_id, from_block, to_block, depth, deposits, claims, global_exit_roots, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id`
+	deposits, err := marshalJournalItems(journal.Deposits)
+	if err != nil {
+		return 0, err
+	}
+	claims, err := marshalJournalItems(journal.Claims)
+	if err != nil {
+		return 0, err
+	}
+	gers, err := marshalJournalItems(journal.GlobalExitRoots)
+	if err != nil {
+		return 0, err
+	}
+	var id uint64
+	err = p.getExecQuerier(dbTx).QueryRow(ctx, addReorgJournalSQL, journal.NetworkID, journal.FromBlock, journal.ToBlock, journal.Depth,
+		deposits, claims, gers, journal.CreatedAt).Scan(&id)
+	return id, err
+}
+
+// GetReorgJournals gets the reorg journal of the network, newest first
+func (p *PostgresStorage) GetReorgJournals(ctx context.Context, networkID uint, limit, offset uint, dbTx pgx.Tx) ([]*etherman.ReorgJournal, error) {
+	const getReorgJournalsSQL = `SELECT id, network_id, from_block, to_block, depth, deposits, claims, global_exit_roots, created_at
+		FROM sync.reorg_journal WHERE network_id = $1 ORDER BY id DESC LIMIT $2 OFFSET $3`
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, getReorgJournalsSQL, networkID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var journals []*etherman.ReorgJournal
+	for rows.Next() {
+		var (
+			journal                etherman.ReorgJournal
+			deposits, claims, gers []byte
+		)
+		err = rows.Scan(&journal.ID, &journal.NetworkID, &journal.FromBlock, &journal.ToBlock, &journal.Depth, &deposits, &claims, &gers, &journal.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal(deposits, &journal.Deposits); err != nil {
+			return nil, errors.Wrap(err, "failed to decode the reorged deposits")
+		}
+		if err = json.Unmarshal(claims, &journal.Claims); err != nil {
+			return nil, errors.Wrap(err, "failed to decode the reorged claims")
+		}
+		if err = json.Unmarshal(gers, &journal.GlobalExitRoots); err != nil {
+			return nil, errors.Wrap(err, "failed to decode the reorged global exit roots")
+		}
+		journals = append(journals, &journal)
+	}
+	return journals, nil
+}
+
+func marshalJournalItems[T any](items []T) ([]byte, error) {
+	if items == nil {
+		items = []T{}
+	}
+	b, err := json.Marshal(items)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode the reorg journal")
+	}
+	return b, nil
+}
+
+// GetDepositsByDepositCount gets a page of the deposits of the network in deposit count order, starting at the deposit count
+func (p *PostgresStorage) GetDepositsByDepositCount(ctx context.Context, networkID uint, fromDepositCount uint, limit uint, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
+	const getDepositsSQL = `SELECT d.id, leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, b.block_num, d.network_id, tx_hash, metadata, ready_for_claim, b.received_at, dest_contract_addr, trust_level
+		FROM sync.deposit as d INNER JOIN sync.block as b ON d.network_id = b.network_id AND d.block_id = b.id
+		WHERE d.network_id = $1 AND d.deposit_cnt >= $2
+		ORDER BY d.deposit_cnt ASC LIMIT $3`
+	return p.getDepositList(ctx, getDepositsSQL, dbTx, networkID, fromDepositCount, limit)
+}
+
+// GetRandomClaims gets a random sample of the claims of the network
+func (p *PostgresStorage) GetRandomClaims(ctx context.Context, networkID uint, limit uint, dbTx pgx.Tx) ([]*etherman.Claim, error) {
+	const getClaimsSQL = `SELECT index, orig_net, orig_addr, amount, dest_addr, block_id, b.block_num, c.network_id, tx_hash, b.received_at, rollup_index, mainnet_flag
+		FROM sync.claim as c INNER JOIN sync.block as b ON c.network_id = b.network_id AND c.block_id = b.id
+		WHERE c.network_id = $1
+		ORDER BY random() LIMIT $2`
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, getClaimsSQL, networkID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var claims []*etherman.Claim
+	for rows.Next() {
+		var (
+			claim  etherman.Claim
+			amount string
+		)
+		err = rows.Scan(&claim.Index, &claim.OriginalNetwork, &claim.OriginalAddress, &amount, &claim.DestinationAddress, &claim.BlockID, &claim.BlockNumber, &claim.NetworkID, &claim.TxHash, &claim.Time, &claim.RollupIndex, &claim.MainnetFlag)
+		if err != nil {
+			return nil, err
+		}
+		claim.Amount, _ = new(big.Int).SetString(amount, 10) //nolint:gomnd
+		claims = append(claims, &claim)
+	}
+	return claims, rows.Err()
+}
+
+// GetRandomL1GlobalExitRoots gets a random sample of the global exit roots synced from L1
+func (p *PostgresStorage) GetRandomL1GlobalExitRoots(ctx context.Context, limit uint, dbTx pgx.Tx) ([]*etherman.GlobalExitRoot, error) {
+	const getExitRootsSQL = `SELECT block_id, b.block_num, global_exit_root, exit_roots, b.received_at
+		FROM sync.exit_root as e INNER JOIN sync.block as b ON e.block_id = b.id
+		WHERE e.block_id > 0
+		ORDER BY random() LIMIT $1`
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, getExitRootsSQL, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var gers []*etherman.GlobalExitRoot
+	for rows.Next() {
+		var (
+			ger       etherman.GlobalExitRoot
+			exitRoots [][]byte
+		)
+		err = rows.Scan(&ger.BlockID, &ger.BlockNumber, &ger.GlobalExitRoot, pq.Array(&exitRoots), &ger.Time)
+		if err != nil {
+			return nil, err
+		}
+		for _, exitRoot := range exitRoots {
+			ger.ExitRoots = append(ger.ExitRoots, common.BytesToHash(exitRoot))
+		}
+		gers = append(gers, &ger)
+	}
+	return gers, rows.Err()
+}
+
+// GetTrustedDepositBlocks gets the numbers of the blocks of the network with trusted deposits, in ascending order
+func (p *PostgresStorage) GetTrustedDepositBlocks(ctx context.Context, networkID uint, dbTx pgx.Tx) ([]uint64, error) {
+	const getTrustedDepositBlocksSQL = `SELECT DISTINCT b.block_num
+		FROM sync.deposit as d INNER JOIN sync.block as b ON d.network_id = b.network_id AND d.block_id = b.id
+		WHERE d.network_id = $1 AND d.trust_level = $2
+		ORDER BY b.block_num ASC`
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, getTrustedDepositBlocksSQL, networkID, etherman.TrustLevelTrusted)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var blocks []uint64
+	for rows.Next() {
+		var blockNumber uint64
+		if err = rows.Scan(&blockNumber); err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, blockNumber)
+	}
+	return blocks, rows.Err()
+}
+
+// PromoteTrustedDeposits marks the trusted deposits of the network up to the block number as virtual
+func (p *PostgresStorage) PromoteTrustedDeposits(ctx context.Context, networkID uint, blockNumber uint64, dbTx pgx.Tx) (int64, error) {
+	const promoteTrustedDepositsSQL = `UPDATE sync.deposit SET trust_level = $1
+		WHERE network_id = $2 AND trust_level = $3
+		AND block_id IN (SELECT id FROM sync.block WHERE network_id = $2 AND block_num <= $4)`
+	res, err := p.getExecQuerier(dbTx).Exec(ctx, promoteTrustedDepositsSQL, etherman.TrustLevelVirtual, networkID, etherman.TrustLevelTrusted, blockNumber)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected(), nil
+}
+
+// AddSovereignGlobalExitRoot adds a global exit root inserted or removed on a sovereign chain
+func (p *PostgresStorage) AddSovereignGlobalExitRoot(ctx context.Context, ger *etherman.SovereignGlobalExitRoot, dbTx pgx.Tx) error {
+	const addSovereignGlobalExitRootSQL = `INSERT INTO sync.sovereign_exit_root (block_id, network_id, global_exit_root, removed, tx_hash)
+		VALUES ($1, $2, $3, $4, $5)`
+	_, err := p.getExecQuerier(dbTx).Exec(ctx, addSovereignGlobalExitRootSQL, ger.BlockID, ger.NetworkID, ger.GlobalExitRoot, ger.Removed, ger.TxHash)
+	return err
+}
+
+// GetLatestSovereignExitRoot gets the latest global exit root inserted on the sovereign chain and not removed
+// after, with the exit roots synced from L1
+func (p *PostgresStorage) GetLatestSovereignExitRoot(ctx context.Context, networkID uint, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error) {
+	var (
+		ger       etherman.GlobalExitRoot
+		exitRoots [][]byte
+	)
+	const getLatestSovereignExitRootSQL = `SELECT s.global_exit_root, e.exit_roots
+		FROM sync.sovereign_exit_root as s INNER JOIN sync.exit_root as e ON e.global_exit_root = s.global_exit_root AND e.block_id > 0
+		WHERE s.network_id = $1 AND NOT s.removed AND NOT EXISTS
+			(SELECT 1 FROM sync.sovereign_exit_root as r WHERE r.network_id = s.network_id AND r.global_exit_root = s.global_exit_root AND r.removed AND r.id > s.id)
+		ORDER BY s.id DESC LIMIT 1`
+	err := p.getExecQuerier(dbTx).QueryRow(ctx, getLatestSovereignExitRootSQL, networkID).Scan(&ger.GlobalExitRoot, pq.Array(&exitRoots))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, gerror.ErrStorageNotFound
+		}
+		return nil, err
+	}
+	ger.ExitRoots = []common.Hash{common.BytesToHash(exitRoots[0]), common.BytesToHash(exitRoots[1])}
+	return &ger, nil
+}
+
+// DeleteClaim deletes the claim of the network unset on a sovereign chain
+func (p *PostgresStorage) DeleteClaim(ctx context.Context, claim *etherman.Claim, dbTx pgx.Tx) (int64, error) {
+	const deleteClaimSQL = "DELETE FROM sync.claim WHERE network_id = $1 AND index = $2 AND mainnet_flag = $3 AND rollup_index = $4"
+	res, err := p.getExecQuerier(dbTx).Exec(ctx, deleteClaimSQL, claim.NetworkID, claim.Index, claim.MainnetFlag, claim.RollupIndex)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected(), nil
+}
+
+// GetClaimBySource gets the claim on the network of the deposit with the deposit count made on the source network, the
+// source is identified by the mainnet flag and the rollup index of the global index, since the deposit counts of
+// different source networks overlap
+func (p *PostgresStorage) GetClaimBySource(ctx context.Context, depositCount, networkID uint, mainnetFlag bool, rollupIndex uint, dbTx pgx.Tx) (*etherman.Claim, error) {
+	var (
+		claim  etherman.Claim
+		amount string
+	)
+	const getClaimSQL = `
+		SELECT index, orig_net, orig_addr, amount, dest_addr, block_id, c.network_id, tx_hash, b.received_at, rollup_index, mainnet_flag
+		FROM sync.claim as c INNER JOIN sync.block as b ON c.network_id = b.network_id AND c.block_id = b.id
+		WHERE index = $1 AND c.network_id = $2 AND mainnet_flag = $3 AND rollup_index = $4`
+	err := p.getExecQuerier(dbTx).QueryRow(ctx, getClaimSQL, depositCount, networkID, mainnetFlag, rollupIndex).Scan(&claim.Index, &claim.OriginalNetwork, &claim.OriginalAddress, &amount, &claim.DestinationAddress, &claim.BlockID, &claim.NetworkID, &claim.TxHash, &claim.Time, &claim.RollupIndex, &claim.MainnetFlag)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, gerror.ErrStorageNotFound
+	}
+	claim.Amount, _ = new(big.Int).SetString(amount, 10) //nolint:gomnd
+	return &claim, err
+}
+
+// GetClaimDeposit gets the deposit of the network with the fields needed to build its claim
+func (p *PostgresStorage) GetClaimDeposit(ctx context.Context, depositCnt, networkID uint, dbTx pgx.Tx) (*etherman.Deposit, error) {
+	const getClaimDepositSQL = `SELECT leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, b.block_num, d.network_id, tx_hash, metadata, ready_for_claim, dest_contract_addr, log_index
+		FROM sync.deposit as d INNER JOIN sync.block as b ON d.network_id = b.network_id AND d.block_id = b.id
+		WHERE d.network_id = $1 AND deposit_cnt = $2`
+	deposits, err := p.getClaimDeposits(ctx, getClaimDepositSQL, dbTx, networkID, depositCnt)
+	if err != nil {
+		return nil, err
+	}
+	if len(deposits) == 0 {
+		return nil, gerror.ErrStorageNotFound
+	}
+	return deposits[0], nil
+}
+
+// GetClaimDepositsByTxHash gets the deposits of the network sent in the tx, ordered by deposit count
+func (p *PostgresStorage) GetClaimDepositsByTxHash(ctx context.Context, networkID uint, txHash common.Hash, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
+	const getClaimDepositsByTxHashSQL = `SELECT leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, b.block_num, d.network_id, tx_hash, metadata, ready_for_claim, dest_contract_addr, log_index
+		FROM sync.deposit as d INNER JOIN sync.block as b ON d.network_id = b.network_id AND d.block_id = b.id
+		WHERE d.network_id = $1 AND d.tx_hash = $2
+		ORDER BY deposit_cnt ASC`
+	return p.getClaimDeposits(ctx, getClaimDepositsByTxHashSQL, dbTx, networkID, txHash)
+}
+
+func (p *PostgresStorage) getClaimDeposits(ctx context.Context, sql string, dbTx pgx.Tx, args ...interface{}) ([]*etherman.Deposit, error) {
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deposits []*etherman.Deposit
+	for rows.Next() {
+		var (
+			deposit  etherman.Deposit
+			amount   string
+			logIndex *uint
+		)
+		err = rows.Scan(&deposit.LeafType, &deposit.OriginalNetwork, &deposit.OriginalAddress, &amount, &deposit.DestinationNetwork, &deposit.DestinationAddress,
+			&deposit.DepositCount, &deposit.BlockID, &deposit.BlockNumber, &deposit.NetworkID, &deposit.TxHash, &deposit.Metadata, &deposit.ReadyForClaim, &deposit.DestContractAddress, &logIndex)
+		if err != nil {
+			return nil, err
+		}
+		deposit.Amount, _ = new(big.Int).SetString(amount, 10) //nolint:gomnd
+		if logIndex != nil {
+			deposit.LogIndex = *logIndex
+		}
+		deposits = append(deposits, &deposit)
+	}
+	return deposits, rows.Err()
+}
+
+const (
+	webhookSubscriptionColumns = "id, url, secret, owner, dest_addr, token_addr, network_ids, enabled, created_at"
+	webhookDeliveryColumns     = "id, subscription_id, event, payload, status, attempts, response_code, last_error, next_attempt_at, created_at, updated_at"
+)
+
+// AddWebhookSubscription stores a webhook subscription and returns its id
+func (p *PostgresStorage) AddWebhookSubscription(ctx context.Context, sub *webhook.Subscription, dbTx pgx.Tx) (uint64, error) {
+	const addSubscriptionSQL = `INSERT INTO sync.webhook_subscription (url, secret, owner, dest_addr, token_addr, network_ids, enabled, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id`
+	networkIDs := make([]int64, 0, len(sub.NetworkIDs))
+	for _, networkID := range sub.NetworkIDs {
+		networkIDs = append(networkIDs, int64(networkID))
+	}
+	var id uint64
+	err := p.getExecQuerier(dbTx).QueryRow(ctx, addSubscriptionSQL, sub.URL, sub.Secret, sub.Owner, sub.DestAddr, sub.TokenAddr, pq.Array(networkIDs), sub.Enabled, sub.CreatedAt).Scan(&id)
+	return id, err
+}
+
+// DisableWebhookSubscription disables a webhook subscription of the owner, false if it doesn't exist, it's
+// owned by another integrator or it's already disabled
+func (p *PostgresStorage) DisableWebhookSubscription(ctx context.Context, id uint64, owner string, dbTx pgx.Tx) (bool, error) {
+	const disableSubscriptionSQL = "UPDATE sync.webhook_subscription SET enabled = FALSE WHERE id = $1 AND owner = $2 AND enabled"
+	res, err := p.getExecQuerier(dbTx).Exec(ctx, disableSubscriptionSQL, id, owner)
+	if err != nil {
+		return false, err
+	}
+	return res.RowsAffected() > 0, nil
+}
+
+// GetWebhookSubscriptions returns the enabled webhook subscriptions, oldest first
+func (p *PostgresStorage) GetWebhookSubscriptions(ctx context.Context, dbTx pgx.Tx) ([]*webhook.Subscription, error) {
+	getSubscriptionsSQL := "SELECT " + webhookSubscriptionColumns + " FROM sync.webhook_subscription WHERE enabled ORDER BY id ASC"
+	return p.getWebhookSubscriptions(ctx, getSubscriptionsSQL, dbTx)
+}
+
+// GetWebhookSubscriptionsByOwner returns the enabled webhook subscriptions of the owner, oldest first
+func (p *PostgresStorage) GetWebhookSubscriptionsByOwner(ctx context.Context, owner string, dbTx pgx.Tx) ([]*webhook.Subscription, error) {
+	getSubscriptionsSQL := "SELECT " + webhookSubscriptionColumns + " FROM sync.webhook_subscription WHERE enabled AND owner = $1 ORDER BY id ASC"
+	return p.getWebhookSubscriptions(ctx, getSubscriptionsSQL, dbTx, owner)
+}
+
+func (p *PostgresStorage) getWebhookSubscriptions(ctx context.Context, sql string, dbTx pgx.Tx, args ...interface{}) ([]*webhook.Subscription, error) {
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var subscriptions []*webhook.Subscription
+	for rows.Next() {
+		var (
+			sub        webhook.Subscription
+			networkIDs []int64
+		)
+		err = rows.Scan(&sub.ID, &sub.URL, &sub.Secret, &sub.Owner, &sub.DestAddr, &sub.TokenAddr, pq.Array(&networkIDs), &sub.Enabled, &sub.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		for _, networkID := range networkIDs {
+			sub.NetworkIDs = append(sub.NetworkIDs, uint(networkID))
+		}
+		subscriptions = append(subscriptions, &sub)
+	}
+	return subscriptions, rows.Err()
+}
+
+// AddWebhookDeliveries stores the deliveries of a transaction update
+func (p *PostgresStorage) AddWebhookDeliveries(ctx context.Context, deliveries []*webhook.Delivery, dbTx pgx.Tx) error {
+	now := time.Now().UTC()
+	rows := make([][]interface{}, 0, len(deliveries))
+	for _, d := range deliveries {
+		rows = append(rows, []interface{}{d.SubscriptionID, d.Event, d.Payload, d.Status, d.Attempts, d.NextAttemptAt, now, now})
+	}
+	_, err := p.getExecQuerier(dbTx).CopyFrom(ctx, pgx.Identifier{"sync", "webhook_delivery"},
+		[]string{"subscription_id", "event", "payload", "status", "attempts", "next_attempt_at", "created_at", "updated_at"}, pgx.CopyFromRows(rows))
+	return err
+}
+
+// ClaimDueWebhookDeliveries returns the pending deliveries whose next attempt is due, oldest first, and moves
+// their next attempt to claimedUntil, so the other dispatchers skip them while they're sent. The deliveries
+// locked by other dispatchers claiming them at the same time are skipped
+func (p *PostgresStorage) ClaimDueWebhookDeliveries(ctx context.Context, limit uint, claimedUntil time.Time, dbTx pgx.Tx) ([]*webhook.Delivery, error) {
+	claimDueDeliveriesSQL := `UPDATE sync.webhook_delivery SET next_attempt_at = $3 WHERE id IN (
+		SELECT id FROM sync.webhook_delivery WHERE status = $1 AND next_attempt_at <= $2 ORDER BY next_attempt_at ASC LIMIT $4 FOR UPDATE SKIP LOCKED
+		) RETURNING ` + webhookDeliveryColumns
+	return p.getWebhookDeliveries(ctx, claimDueDeliveriesSQL, dbTx, webhook.DeliveryStatusPending, time.Now().UTC(), claimedUntil.UTC(), limit)
+}
+
+// UpdateWebhookDelivery updates the status and the last attempt of a delivery
+func (p *PostgresStorage) UpdateWebhookDelivery(ctx context.Context, d *webhook.Delivery, dbTx pgx.Tx) error {
+	const updateDeliverySQL = `UPDATE sync.webhook_delivery SET status = $2, attempts = $3, response_code = $4, last_error = $5, next_attempt_at = $6, updated_at = $7
+		WHERE id = $1`
+	_, err := p.getExecQuerier(dbTx).Exec(ctx, updateDeliverySQL, d.ID, d.Status, d.Attempts, d.ResponseCode, d.LastError, d.NextAttemptAt, time.Now().UTC())
+	return err
+}
+
+// GetWebhookDeliveries returns the delivery log of a subscription of the owner filtered by status, if any, newest first
+func (p *PostgresStorage) GetWebhookDeliveries(ctx context.Context, subscriptionID uint64, owner string, status webhook.DeliveryStatus, limit, offset uint, dbTx pgx.Tx) ([]*webhook.Delivery, error) {
+	getDeliveriesSQL := "SELECT " + webhookDeliveryColumns + ` FROM sync.webhook_delivery
+		WHERE subscription_id = (SELECT id FROM sync.webhook_subscription WHERE id = $1 AND owner = $2)
+		AND ($3 = '' OR status = $3) ORDER BY id DESC LIMIT $4 OFFSET $5`
+	return p.getWebhookDeliveries(ctx, getDeliveriesSQL, dbTx, subscriptionID, owner, string(status), limit, offset)
+}
+
+// ReplayWebhookDelivery sets a delivery of a subscription of the owner as pending again with its attempts
+// reset, so it's sent on the next poll
+func (p *PostgresStorage) ReplayWebhookDelivery(ctx context.Context, id uint64, owner string, dbTx pgx.Tx) (*webhook.Delivery, error) {
+	replayDeliverySQL := `UPDATE sync.webhook_delivery SET status = $2, attempts = 0, last_error = '', next_attempt_at = $3, updated_at = $3
+		WHERE id = $1 AND subscription_id IN (SELECT id FROM sync.webhook_subscription WHERE owner = $4) RETURNING ` + webhookDeliveryColumns
+	deliveries, err := p.getWebhookDeliveries(ctx, replayDeliverySQL, dbTx, id, webhook.DeliveryStatusPending, time.Now().UTC(), owner)
+	if err != nil {
+		return nil, err
+	}
+	if len(deliveries) == 0 {
+		return nil, gerror.ErrStorageNotFound
+	}
+	return deliveries[0], nil
+}
+
+func (p *PostgresStorage) getWebhookDeliveries(ctx context.Context, sql string, dbTx pgx.Tx, args ...interface{}) ([]*webhook.Delivery, error) {
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []*webhook.Delivery
+	for rows.Next() {
+		var d webhook.Delivery
+		err = rows.Scan(&d.ID, &d.SubscriptionID, &d.Event, &d.Payload, &d.Status, &d.Attempts, &d.ResponseCode, &d.LastError, &d.NextAttemptAt, &d.CreatedAt, &d.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, &d)
+	}
+	return deliveries, rows.Err()
+}
+
+// AddPushOutboxMessage stores a transaction update to be pushed by the relay, it's ignored if the same update
+// is already stored
+func (p *PostgresStorage) AddPushOutboxMessage(ctx context.Context, msg *pushoutbox.Message, dbTx pgx.Tx) error {
+	const addMessageSQL = `INSERT INTO sync.push_outbox (dedup_key, payload, created_at) VALUES ($1, $2, $3)
+		ON CONFLICT (dedup_key) DO NOTHING`
+	_, err := p.getExecQuerier(dbTx).Exec(ctx, addMessageSQL, msg.DedupKey, msg.Payload, msg.CreatedAt)
+	return err
+}
+
+// pushOutboxRelayLockID is the key of the advisory lock held by the push outbox relay pushing the messages
+const pushOutboxRelayLockID = 1014
+
+// GetPendingPushOutboxMessages returns the messages not pushed nor failed yet, oldest first. The relay lock is
+// held until the end of the db tx, and no message is returned while another db tx holds it, so only one relay
+// pushes at a time and the updates of a transaction are pushed in order
+func (p *PostgresStorage) GetPendingPushOutboxMessages(ctx context.Context, limit uint, dbTx pgx.Tx) ([]*pushoutbox.Message, error) {
+	const lockRelaySQL = "SELECT pg_try_advisory_xact_lock($1)"
+	var locked bool
+	if err := p.getExecQuerier(dbTx).QueryRow(ctx, lockRelaySQL, pushOutboxRelayLockID).Scan(&locked); err != nil {
+		return nil, err
+	}
+	if !locked {
+		return nil, nil
+	}
+
+	const getPendingMessagesSQL = `SELECT id, dedup_key, payload, attempts, last_error, sent_at, failed_at, created_at
+		FROM sync.push_outbox WHERE sent_at IS NULL AND failed_at IS NULL ORDER BY id ASC LIMIT $1`
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, getPendingMessagesSQL, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var messages []*pushoutbox.Message
+	for rows.Next() {
+		var msg pushoutbox.Message
+		err = rows.Scan(&msg.ID, &msg.DedupKey, &msg.Payload, &msg.Attempts, &msg.LastError, &msg.SentAt, &msg.FailedAt, &msg.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, &msg)
+	}
+	return messages, rows.Err()
+}
+
+// UpdatePushOutboxMessage updates the attempts, the sent time and the failed time of an outbox message
+func (p *PostgresStorage) UpdatePushOutboxMessage(ctx context.Context, msg *pushoutbox.Message, dbTx pgx.Tx) error {
+	const updateMessageSQL = "UPDATE sync.push_outbox SET attempts = $2, last_error = $3, sent_at = $4, failed_at = $5 WHERE id = $1"
+	_, err := p.getExecQuerier(dbTx).Exec(ctx, updateMessageSQL, msg.ID, msg.Attempts, msg.LastError, msg.SentAt, msg.FailedAt)
+	return err
+}
+
+// DeleteSentPushOutboxMessages deletes the outbox messages pushed or failed before the time and returns how many
+// were deleted
+func (p *PostgresStorage) DeleteSentPushOutboxMessages(ctx context.Context, sentBefore time.Time, dbTx pgx.Tx) (int64, error) {
+	const deleteMessagesSQL = "DELETE FROM sync.push_outbox WHERE sent_at < $1 OR failed_at < $1"
+	res, err := p.getExecQuerier(dbTx).Exec(ctx, deleteMessagesSQL, sentBefore)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected(), nil
+}
+
+// CheckFencingToken returns whether the token is the highest fencing token of the lease. The token is raised
+// outside the db tx and the row is locked for share in it, so a newer leader can't raise it until the writes
+// of the db tx are committed, and the writes of an older leader are rejected afterwards
+func (p *PostgresStorage) CheckFencingToken(ctx context.Context, leaseKey string, token int64, dbTx pgx.Tx) (bool, error) {
+	const (
+		getTokenSQL   = "SELECT token FROM sync.leader_fencing WHERE lease_key = $1"
+		raiseTokenSQL = `INSERT INTO sync.leader_fencing (lease_key, token) VALUES ($1, $2)
+			ON CONFLICT (lease_key) DO UPDATE SET token = EXCLUDED.token WHERE sync.leader_fencing.token < EXCLUDED.token`
+		lockTokenSQL = "SELECT token FROM sync.leader_fencing WHERE lease_key = $1 FOR SHARE"
+	)
+	var current int64
+	err := p.getExecQuerier(nil).QueryRow(ctx, getTokenSQL, leaseKey).Scan(&current)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return false, err
+	}
+	// the token is only raised once by every new leader, the next checks just lock the row
+	if err != nil || current < token {
+		if _, err = p.getExecQuerier(nil).Exec(ctx, raiseTokenSQL, leaseKey, token); err != nil {
+			return false, err
+		}
+	}
+	err = p.getExecQuerier(dbTx).QueryRow(ctx, lockTokenSQL, leaseKey).Scan(&current)
+	if err != nil {
+		return false, err
+	}
+	return current == token, nil
+}
@@ -4,8 +4,12 @@ import (
 	"context"
 	"math/big"
 	"testing"
+	"time"
 
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/pushoutbox"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/utils"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 )
@@ -86,3 +90,99 @@ func TestBridgeBalance(t *testing.T) {
 	err = dbTx.Rollback(ctx)
 	require.NoError(t, err)
 }
+
+func TestCheckFencingToken(t *testing.T) {
+	dbCfg := NewConfigFromEnv()
+	ctx := context.Background()
+	err := InitOrReset(dbCfg)
+	require.NoError(t, err)
+
+	store, err := NewPostgresStorage(dbCfg)
+	require.NoError(t, err)
+
+	latest, err := store.CheckFencingToken(ctx, "bridge_tasks", 3, nil)
+	require.NoError(t, err)
+	require.True(t, latest)
+
+	// a newer leader raises the token, the writes of the older one are rejected from then on
+	latest, err = store.CheckFencingToken(ctx, "bridge_tasks", 4, nil)
+	require.NoError(t, err)
+	require.True(t, latest)
+	latest, err = store.CheckFencingToken(ctx, "bridge_tasks", 3, nil)
+	require.NoError(t, err)
+	require.False(t, latest)
+
+	// the token of every lease is fenced on its own
+	latest, err = store.CheckFencingToken(ctx, "bridge_push_tasks", 1, nil)
+	require.NoError(t, err)
+	require.True(t, latest)
+}
+
+func TestGetClaimBySource(t *testing.T) {
+	dbCfg := NewConfigFromEnv()
+	ctx := context.Background()
+	err := InitOrReset(dbCfg)
+	require.NoError(t, err)
+
+	store, err := NewPostgresStorage(dbCfg)
+	require.NoError(t, err)
+
+	blockID, err := store.AddBlock(ctx, &etherman.Block{BlockNumber: 1, BlockHash: common.HexToHash("0x1"), NetworkID: 1}, nil)
+	require.NoError(t, err)
+	// the deposit 3 of L1 is claimed on the network 1, the deposit 3 of the rollup 2 is not
+	err = store.AddClaim(ctx, &etherman.Claim{NetworkID: 1, Index: 3, MainnetFlag: true, Amount: big.NewInt(1), BlockID: blockID}, nil)
+	require.NoError(t, err)
+
+	claim, err := store.GetClaimBySource(ctx, 3, 1, true, 0, nil)
+	require.NoError(t, err)
+	require.True(t, claim.MainnetFlag)
+	_, err = store.GetClaimBySource(ctx, 3, 1, false, 1, nil)
+	require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+}
+
+func TestGetPendingPushOutboxMessages(t *testing.T) {
+	dbCfg := NewConfigFromEnv()
+	ctx := context.Background()
+	err := InitOrReset(dbCfg)
+	require.NoError(t, err)
+
+	store, err := NewPostgresStorage(dbCfg)
+	require.NoError(t, err)
+
+	for _, key := range []string{"1-7-TX_CREATED-0x01-1", "1-7-TX_CLAIMED-0x01-2", "1-8-TX_CREATED-0x02-3"} {
+		err = store.AddPushOutboxMessage(ctx, &pushoutbox.Message{DedupKey: key, Payload: []byte("{}"), CreatedAt: time.Now().UTC()}, nil)
+		require.NoError(t, err)
+	}
+	dbTx, err := store.BeginDBTransaction(ctx)
+	require.NoError(t, err)
+	messages, err := store.GetPendingPushOutboxMessages(ctx, 10, dbTx)
+	require.NoError(t, err)
+	require.Len(t, messages, 3)
+
+	// another relay gets no message while the first one holds the relay lock, not even the updates after the locked ones
+	otherTx, err := store.BeginDBTransaction(ctx)
+	require.NoError(t, err)
+	pending, err := store.GetPendingPushOutboxMessages(ctx, 10, otherTx)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+	require.NoError(t, otherTx.Rollback(ctx))
+
+	// the failed messages leave the pending ones
+	failedAt := time.Now().UTC()
+	messages[0].FailedAt = &failedAt
+	messages[0].LastError = "unmarshal transaction error"
+	require.NoError(t, store.UpdatePushOutboxMessage(ctx, messages[0], dbTx))
+	require.NoError(t, dbTx.Commit(ctx))
+
+	dbTx, err = store.BeginDBTransaction(ctx)
+	require.NoError(t, err)
+	pending, err = store.GetPendingPushOutboxMessages(ctx, 10, dbTx)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	require.Equal(t, "1-7-TX_CLAIMED-0x01-2", pending[0].DedupKey)
+	require.NoError(t, dbTx.Rollback(ctx))
+
+	deleted, err := store.DeleteSentPushOutboxMessages(ctx, time.Now().Add(time.Minute), nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), deleted)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/0xPolygonHermez/zkevm-bridge-service/config/apolloconfig"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/networkregistry"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/utils"
 	"github.com/pkg/errors"
 )
@@ -136,6 +137,12 @@ func (c *calculatorImpl) Get(networkID uint) uint32 {
 	if networkID == utils.GetMainNetworkId() {
 		index = networkID
 	} else {
+		// The networks other than the rollup have the estimated time of the network registry
+		if networkID != utils.GetRollupNetworkId() {
+			if n, ok := networkregistry.Default().Get(networkID); ok && n.EstimateTime > 0 {
+				return n.EstimateTime
+			}
+		}
 		index = 1
 	}
 	return c.estimateTime[index]
@@ -0,0 +1,61 @@
+package etherman
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// chainlinkAggregatorABI is the part of the AggregatorV3Interface used to read the prices
+const chainlinkAggregatorABI = `[
+	{"inputs":[],"name":"decimals","outputs":[{"name":"","type":"uint8"}],"stateMutability":"view","type":"function"},
+	{"inputs":[],"name":"latestRoundData","outputs":[{"name":"roundId","type":"uint80"},{"name":"answer","type":"int256"},{"name":"startedAt","type":"uint256"},{"name":"updatedAt","type":"uint256"},{"name":"answeredInRound","type":"uint80"}],"stateMutability":"view","type":"function"}
+]`
+
+var parsedChainlinkAggregatorABI = func() abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(chainlinkAggregatorABI))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}()
+
+// ErrInvalidChainlinkAnswer is returned when the latest round of an aggregator has no positive answer
+var ErrInvalidChainlinkAnswer = errors.New("the chainlink aggregator answer is not positive")
+
+// GetChainlinkPrice reads the latest answer of a Chainlink price feed aggregator, scaled by its decimals, and the
+// time it was updated
+func (etherMan *Client) GetChainlinkPrice(ctx context.Context, aggregator common.Address) (float64, time.Time, error) {
+	caller, ok := etherMan.EtherClient.(bind.ContractCaller)
+	if !ok {
+		return 0, time.Time{}, errors.New("the client can't call contracts")
+	}
+	contract := bind.NewBoundContract(aggregator, parsedChainlinkAggregatorABI, caller, nil, nil)
+	opts := &bind.CallOpts{Context: ctx}
+
+	var out []interface{}
+	if err := contract.Call(opts, &out, "decimals"); err != nil {
+		return 0, time.Time{}, err
+	}
+	decimals := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	out = nil
+	if err := contract.Call(opts, &out, "latestRoundData"); err != nil {
+		return 0, time.Time{}, err
+	}
+	answer := abi.ConvertType(out[1], new(big.Int)).(*big.Int)
+	updatedAt := abi.ConvertType(out[3], new(big.Int)).(*big.Int)
+	if answer.Sign() <= 0 {
+		return 0, time.Time{}, ErrInvalidChainlinkAnswer
+	}
+
+	scale := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)) //nolint:gomnd
+	price, _ := new(big.Float).Quo(new(big.Float).SetInt(answer), scale).Float64()
+	return price, time.Unix(updatedAt.Int64(), 0), nil
+}
@@ -8,4 +8,10 @@ type Config struct {
 	// XLayer
 	L1ChainId  uint   `mapstructure:"L1ChainId"`
 	L2ChainIds []uint `mapstructure:"L2ChainIds"`
+
+	// L1WSURL is the websocket url of the L1 node. When it's set the new blocks and the logs of the
+	// bridge contracts are received through a subscription, polling is used as fallback
+	L1WSURL string `mapstructure:"L1WSURL"`
+	// L2WSURLs are the websocket urls of the L2 nodes, in the same order as L2URLs. Empty urls disable the subscription
+	L2WSURLs []string `mapstructure:"L2WSURLs"`
 }
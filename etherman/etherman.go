@@ -18,6 +18,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 	"golang.org/x/crypto/sha3"
 )
 
@@ -105,6 +106,10 @@ const (
 	VerifyBatchOrder EventOrder = "VerifyBatch"
 	// ActivateEtrogOrder identifies the event to activate etrog
 	ActivateEtrogOrder EventOrder = "etrog"
+	// SovereignGlobalExitRootsOrder identifies a global exit root inserted or removed on a sovereign chain
+	SovereignGlobalExitRootsOrder EventOrder = "SovereignGlobalExitRoot"
+	// UnsetClaimsOrder identifies an UnsetClaim event of a sovereign chain
+	UnsetClaimsOrder EventOrder = "UnsetClaim"
 )
 
 type ethClienter interface {
@@ -122,6 +127,10 @@ type Client struct {
 	PolygonRollupManager       *polygonrollupmanager.Polygonrollupmanager
 	RollupID                   uint32
 	SCAddresses                []common.Address
+
+	// XLayer
+	subscription *logSubscription
+	rpcClient    *rpc.Client
 }
 
 // NewClient creates a new etherman.
@@ -187,7 +196,7 @@ func NewL2Client(url string, polygonBridgeAddr common.Address, rollupID uint) (*
 	}
 	scAddresses := []common.Address{polygonBridgeAddr}
 
-	return &Client{EtherClient: ethClient, PolygonBridge: bridge, OldPolygonBridge: oldpolygonBridge, SCAddresses: scAddresses, RollupID: uint32(rollupID)}, nil
+	return &Client{EtherClient: ethClient, PolygonBridge: bridge, OldPolygonBridge: oldpolygonBridge, SCAddresses: scAddresses, RollupID: uint32(rollupID), rpcClient: ethClient.Client()}, nil
 }
 
 // GetRollupInfoByBlockRange function retrieves the Rollup information that are included in all this ethereum blocks
@@ -215,7 +224,7 @@ type Order struct {
 }
 
 func (etherMan *Client) readEvents(ctx context.Context, query ethereum.FilterQuery) ([]Block, map[common.Hash][]Order, error) {
-	logs, err := etherMan.EtherClient.FilterLogs(ctx, query)
+	logs, err := etherMan.filterLogs(ctx, query)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -368,6 +377,9 @@ func (etherMan *Client) processEvent(ctx context.Context, vLog types.Log, blocks
 		log.Debug("TransferAdminRole event detected. Ignoring...")
 		return nil
 	}
+	if ok, err := etherMan.processSovereignEvent(ctx, vLog, blocks, blocksOrder); ok {
+		return err
+	}
 	log.Warnf("Event not registered: %+v", vLog)
 	return nil
 }
@@ -437,6 +449,7 @@ func (etherMan *Client) depositEvent(ctx context.Context, vLog types.Log, blocks
 	deposit.TxHash = vLog.TxHash
 	deposit.Metadata = d.Metadata
 	deposit.LeafType = d.LeafType
+	deposit.LogIndex = vLog.Index
 
 	log.Debugf("Deposit event[%+v] blockNumber[%v]", deposit, vLog.BlockNumber)
 
@@ -0,0 +1,63 @@
+package etherman
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ErrNoGlobalExitRootManager is returned by the queries of the global exit root manager on the L2 clients
+var ErrNoGlobalExitRootManager = errors.New("the client has no global exit root manager")
+
+// ErrNotL2Client is returned by the queries of the zkEVM node on the L1 clients
+var ErrNotL2Client = errors.New("the client is not connected to a zkEVM node")
+
+// GetDepositCount gets the deposit count of the bridge at the block number
+func (etherMan *Client) GetDepositCount(ctx context.Context, blockNumber uint64) (uint64, error) {
+	depositCount, err := etherMan.PolygonBridge.DepositCount(&bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(blockNumber)})
+	if err != nil {
+		return 0, err
+	}
+	return depositCount.Uint64(), nil
+}
+
+// GetDepositRoot gets the root of the bridge deposit tree at the block number
+func (etherMan *Client) GetDepositRoot(ctx context.Context, blockNumber uint64) (common.Hash, error) {
+	root, err := etherMan.PolygonBridge.GetRoot(&bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(blockNumber)})
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return root, nil
+}
+
+// IsClaimed checks if the deposit of the source network is claimed in the bridge
+func (etherMan *Client) IsClaimed(ctx context.Context, depositCount uint32, sourceBridgeNetwork uint32) (bool, error) {
+	return etherMan.PolygonBridge.IsClaimed(&bind.CallOpts{Context: ctx}, depositCount, sourceBridgeNetwork)
+}
+
+// GetGlobalExitRootTimestamp gets the timestamp of the global exit root in the global exit root manager,
+// zero if the global exit root is unknown
+func (etherMan *Client) GetGlobalExitRootTimestamp(ctx context.Context, globalExitRoot common.Hash) (uint64, error) {
+	if etherMan.PolygonZkEVMGlobalExitRoot == nil {
+		return 0, ErrNoGlobalExitRootManager
+	}
+	timestamp, err := etherMan.PolygonZkEVMGlobalExitRoot.GlobalExitRootMap(&bind.CallOpts{Context: ctx}, globalExitRoot)
+	if err != nil {
+		return 0, err
+	}
+	return timestamp.Uint64(), nil
+}
+
+// IsBlockVirtualized checks if the L2 block is in a batch that is already virtualized
+func (etherMan *Client) IsBlockVirtualized(ctx context.Context, blockNumber uint64) (bool, error) {
+	if etherMan.rpcClient == nil {
+		return false, ErrNotL2Client
+	}
+	var virtualized bool
+	err := etherMan.rpcClient.CallContext(ctx, &virtualized, "zkevm_isBlockVirtualized", hexutil.Uint64(blockNumber))
+	return virtualized, err
+}
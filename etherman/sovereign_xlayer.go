@@ -0,0 +1,188 @@
+package etherman
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	// Sovereign chain L2 global exit root manager events
+	insertGlobalExitRootSignatureHash        = crypto.Keccak256Hash([]byte("InsertGlobalExitRoot(bytes32)"))
+	removeLastGlobalExitRootSignatureHash    = crypto.Keccak256Hash([]byte("RemoveLastGlobalExitRoot(bytes32)"))
+	updateHashChainValueSignatureHash        = crypto.Keccak256Hash([]byte("UpdateHashChainValue(bytes32,bytes32)"))
+	updateRemovalHashChainValueSignatureHash = crypto.Keccak256Hash([]byte("UpdateRemovalHashChainValue(bytes32,bytes32)"))
+	setGlobalExitRootUpdaterSignatureHash    = crypto.Keccak256Hash([]byte("SetGlobalExitRootUpdater(address)"))
+	setGlobalExitRootRemoverSignatureHash    = crypto.Keccak256Hash([]byte("SetGlobalExitRootRemover(address)"))
+
+	// Sovereign chain bridge events
+	setClaimSignatureHash                          = crypto.Keccak256Hash([]byte("SetClaim(uint32,uint32)"))
+	unsetClaimSignatureHash                        = crypto.Keccak256Hash([]byte("UnsetClaim(uint32,uint32)"))
+	setSovereignTokenAddressSignatureHash          = crypto.Keccak256Hash([]byte("SetSovereignTokenAddress(uint32,address,address,bool)"))
+	migrateLegacyTokenSignatureHash                = crypto.Keccak256Hash([]byte("MigrateLegacyToken(address,address,address,uint256)"))
+	removeLegacySovereignTokenAddressSignatureHash = crypto.Keccak256Hash([]byte("RemoveLegacySovereignTokenAddress(address)"))
+	setSovereignWETHAddressSignatureHash           = crypto.Keccak256Hash([]byte("SetSovereignWETHAddress(address,bool)"))
+
+	// RollupManager settlement of the pessimistic proof chains
+	verifyPessimisticStateTransitionSignatureHash = crypto.Keccak256Hash([]byte("VerifyPessimisticStateTransition(uint32,bytes32,bytes32,bytes32,bytes32,bytes32,address)"))
+)
+
+// AddSovereignGlobalExitRootManager syncs the events of the global exit root manager of a sovereign chain, the
+// global exit roots injected on the chain replace the trusted global exit roots of the sequencer. It must be
+// called before the client is used by the synchronizer
+func (etherMan *Client) AddSovereignGlobalExitRootManager(address common.Address) {
+	etherMan.SCAddresses = append(etherMan.SCAddresses, address)
+}
+
+// processSovereignEvent decodes the events of the sovereign chains and the pessimistic proof settlement,
+// false if the event is not one of them
+func (etherMan *Client) processSovereignEvent(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order) (bool, error) {
+	switch vLog.Topics[0] {
+	case insertGlobalExitRootSignatureHash:
+		return true, etherMan.sovereignGlobalExitRootEvent(ctx, vLog, blocks, blocksOrder, false)
+	case removeLastGlobalExitRootSignatureHash:
+		return true, etherMan.sovereignGlobalExitRootEvent(ctx, vLog, blocks, blocksOrder, true)
+	case updateHashChainValueSignatureHash, updateRemovalHashChainValueSignatureHash:
+		// The managers with the hash chain emit them after InsertGlobalExitRoot and RemoveLastGlobalExitRoot, which
+		// are emitted by all the versions, so the global exit root is only synced from those
+		log.Debug("UpdateHashChainValue event detected. Ignoring...")
+		return true, nil
+	case setClaimSignatureHash:
+		return true, etherMan.setClaimEvent(ctx, vLog, blocks, blocksOrder)
+	case unsetClaimSignatureHash:
+		return true, etherMan.unsetClaimEvent(ctx, vLog, blocks, blocksOrder)
+	case verifyPessimisticStateTransitionSignatureHash:
+		return true, etherMan.verifyPessimisticStateTransitionEvent(ctx, vLog, blocks, blocksOrder)
+	case setGlobalExitRootUpdaterSignatureHash:
+		log.Debug("SetGlobalExitRootUpdater event detected. Ignoring...")
+		return true, nil
+	case setGlobalExitRootRemoverSignatureHash:
+		log.Debug("SetGlobalExitRootRemover event detected. Ignoring...")
+		return true, nil
+	case setSovereignTokenAddressSignatureHash:
+		log.Debug("SetSovereignTokenAddress event detected. Ignoring...")
+		return true, nil
+	case migrateLegacyTokenSignatureHash:
+		log.Debug("MigrateLegacyToken event detected. Ignoring...")
+		return true, nil
+	case removeLegacySovereignTokenAddressSignatureHash:
+		log.Debug("RemoveLegacySovereignTokenAddress event detected. Ignoring...")
+		return true, nil
+	case setSovereignWETHAddressSignatureHash:
+		log.Debug("SetSovereignWETHAddress event detected. Ignoring...")
+		return true, nil
+	}
+	return false, nil
+}
+
+func (etherMan *Client) sovereignGlobalExitRootEvent(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order, removed bool) error {
+	log.Debugf("Sovereign GlobalExitRoot event detected, removed: %v. Processing...", removed)
+	if len(vLog.Topics) < 2 { //nolint:gomnd
+		return fmt.Errorf("invalid sovereign global exit root event, topics: %d", len(vLog.Topics))
+	}
+	ger := SovereignGlobalExitRoot{
+		BlockNumber:    vLog.BlockNumber,
+		GlobalExitRoot: vLog.Topics[1],
+		Removed:        removed,
+		TxHash:         vLog.TxHash,
+	}
+	log.Debugf("Sovereign GlobalExitRoot event[%+v] blockNumber[%v]", ger, vLog.BlockNumber)
+
+	block, err := etherMan.eventBlock(ctx, vLog, blocks)
+	if err != nil {
+		return err
+	}
+	block.SovereignGlobalExitRoots = append(block.SovereignGlobalExitRoots, ger)
+	appendOrder(block, blocksOrder, SovereignGlobalExitRootsOrder, len(block.SovereignGlobalExitRoots)-1)
+	return nil
+}
+
+func (etherMan *Client) setClaimEvent(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order) error {
+	log.Debug("SetClaim event detected. Processing...")
+	index, sourceNetwork, err := decodeClaimIndex(vLog)
+	if err != nil {
+		return err
+	}
+	// The deposit is marked as claimed without a claim transaction, so its token and amount are unknown
+	return etherMan.claimEvent(ctx, vLog, blocks, blocksOrder, big.NewInt(0), common.Address{}, common.Address{}, index, 0, sourceRollupIndex(sourceNetwork), sourceNetwork == 0)
+}
+
+func (etherMan *Client) unsetClaimEvent(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order) error {
+	log.Debug("UnsetClaim event detected. Processing...")
+	index, sourceNetwork, err := decodeClaimIndex(vLog)
+	if err != nil {
+		return err
+	}
+	claim := Claim{
+		MainnetFlag: sourceNetwork == 0,
+		RollupIndex: sourceRollupIndex(sourceNetwork),
+		Index:       index,
+		BlockNumber: vLog.BlockNumber,
+		TxHash:      vLog.TxHash,
+	}
+	log.Debugf("UnsetClaim event[%+v] blockNumber[%v]", claim, vLog.BlockNumber)
+
+	block, err := etherMan.eventBlock(ctx, vLog, blocks)
+	if err != nil {
+		return err
+	}
+	claim.Time = block.ReceivedAt
+	block.UnsetClaims = append(block.UnsetClaims, claim)
+	appendOrder(block, blocksOrder, UnsetClaimsOrder, len(block.UnsetClaims)-1)
+	return nil
+}
+
+func (etherMan *Client) verifyPessimisticStateTransitionEvent(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order) error {
+	log.Debug("VerifyPessimisticStateTransition event detected. Processing...")
+	// rollupID and trustedAggregator are indexed, the data has the pessimistic roots, the local exit roots and the l1 info root
+	const dataLength = 5 * common.HashLength
+	if len(vLog.Topics) < 3 || len(vLog.Data) < dataLength { //nolint:gomnd
+		return fmt.Errorf("invalid VerifyPessimisticStateTransition event, topics: %d, data length: %d", len(vLog.Topics), len(vLog.Data))
+	}
+	rollupID := uint(new(big.Int).SetBytes(vLog.Topics[1].Bytes()).Uint64())
+	newLocalExitRoot := common.BytesToHash(vLog.Data[3*common.HashLength : 4*common.HashLength])
+	aggregator := common.BytesToAddress(vLog.Topics[2].Bytes())
+	// The pessimistic proof chains have no batches, the new local exit root is settled like a verified batch
+	return etherMan.verifyBatches(ctx, vLog, blocks, blocksOrder, rollupID, 0, common.Hash{}, newLocalExitRoot, aggregator)
+}
+
+// eventBlock returns the block of the log, it's appended to the blocks if it's not the last one
+func (etherMan *Client) eventBlock(ctx context.Context, vLog types.Log, blocks *[]Block) (*Block, error) {
+	if len(*blocks) > 0 && (*blocks)[len(*blocks)-1].BlockHash == vLog.BlockHash && (*blocks)[len(*blocks)-1].BlockNumber == vLog.BlockNumber {
+		return &(*blocks)[len(*blocks)-1], nil
+	}
+	fullBlock, err := etherMan.EtherClient.BlockByHash(ctx, vLog.BlockHash)
+	if err != nil {
+		return nil, fmt.Errorf("error getting hashParent. BlockNumber: %d. Error: %v", vLog.BlockNumber, err)
+	}
+	*blocks = append(*blocks, prepareBlock(vLog, time.Unix(int64(fullBlock.Time()), 0), fullBlock))
+	return &(*blocks)[len(*blocks)-1], nil
+}
+
+func appendOrder(block *Block, blocksOrder *map[common.Hash][]Order, name EventOrder, pos int) {
+	(*blocksOrder)[block.BlockHash] = append((*blocksOrder)[block.BlockHash], Order{Name: name, Pos: pos})
+}
+
+// decodeClaimIndex decodes the leaf index and the source network of the SetClaim and UnsetClaim events
+func decodeClaimIndex(vLog types.Log) (uint, uint, error) {
+	if len(vLog.Data) < 2*common.HashLength { //nolint:gomnd
+		return 0, 0, fmt.Errorf("invalid claim index event, data length: %d", len(vLog.Data))
+	}
+	index := new(big.Int).SetBytes(vLog.Data[:common.HashLength]).Uint64()
+	sourceNetwork := new(big.Int).SetBytes(vLog.Data[common.HashLength : 2*common.HashLength]).Uint64()
+	return uint(index), uint(sourceNetwork), nil
+}
+
+// sourceRollupIndex returns the rollup index of the global index of the deposits of the network
+func sourceRollupIndex(sourceNetwork uint) uint64 {
+	if sourceNetwork == 0 {
+		return 0
+	}
+	return uint64(sourceNetwork - 1)
+}
@@ -0,0 +1,95 @@
+package etherman
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type blockByHashClient struct {
+	ethClienter
+}
+
+func (c *blockByHashClient) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
+	return types.NewBlockWithHeader(&types.Header{Number: big.NewInt(10), Time: 100}), nil
+}
+
+func words(values ...uint64) []byte {
+	var data []byte
+	for _, v := range values {
+		data = append(data, common.BigToHash(new(big.Int).SetUint64(v)).Bytes()...)
+	}
+	return data
+}
+
+func TestProcessSovereignEvents(t *testing.T) {
+	ctx := context.Background()
+	etherMan := &Client{EtherClient: &blockByHashClient{}}
+	blockHash := common.HexToHash("0x10")
+	ger := common.HexToHash("0x1")
+	newLocalExitRoot := common.HexToHash("0x5")
+	aggregator := common.HexToAddress("0xa")
+	pessimisticData := append(words(1, 2, 3), newLocalExitRoot.Bytes()...)
+	pessimisticData = append(pessimisticData, words(4)...)
+	logs := []types.Log{
+		// the hash chain events are emitted with the insert and remove events, the global exit root is synced once
+		{Topics: []common.Hash{insertGlobalExitRootSignatureHash, ger}},
+		{Topics: []common.Hash{updateHashChainValueSignatureHash, ger, common.HexToHash("0xc")}},
+		{Topics: []common.Hash{removeLastGlobalExitRootSignatureHash, ger}},
+		{Topics: []common.Hash{updateRemovalHashChainValueSignatureHash, ger, common.HexToHash("0xd")}},
+		{Topics: []common.Hash{unsetClaimSignatureHash}, Data: words(7, 0)},
+		{Topics: []common.Hash{setClaimSignatureHash}, Data: words(8, 3)},
+		{Topics: []common.Hash{verifyPessimisticStateTransitionSignatureHash, common.BigToHash(big.NewInt(3)), common.BytesToHash(aggregator.Bytes())}, Data: pessimisticData},
+		{Topics: []common.Hash{setSovereignTokenAddressSignatureHash}},
+	}
+
+	var blocks []Block
+	blocksOrder := make(map[common.Hash][]Order)
+	for _, l := range logs {
+		l.BlockNumber, l.BlockHash = 10, blockHash
+		require.NoError(t, etherMan.processEvent(ctx, l, &blocks, &blocksOrder))
+	}
+
+	require.Len(t, blocks, 1)
+	assert.Equal(t, []Order{
+		{Name: SovereignGlobalExitRootsOrder, Pos: 0},
+		{Name: SovereignGlobalExitRootsOrder, Pos: 1},
+		{Name: UnsetClaimsOrder, Pos: 0},
+		{Name: ClaimsOrder, Pos: 0},
+		{Name: VerifyBatchOrder, Pos: 0},
+	}, blocksOrder[blockHash])
+	block := blocks[0]
+	assert.Equal(t, []SovereignGlobalExitRoot{
+		{BlockNumber: 10, GlobalExitRoot: ger},
+		{BlockNumber: 10, GlobalExitRoot: ger, Removed: true},
+	}, block.SovereignGlobalExitRoots)
+	require.Len(t, block.UnsetClaims, 1)
+	assert.Equal(t, uint(7), block.UnsetClaims[0].Index)
+	assert.True(t, block.UnsetClaims[0].MainnetFlag)
+	// the deposits of the network 3 are in the rollup index 2
+	require.Len(t, block.Claims, 1)
+	assert.Equal(t, uint(8), block.Claims[0].Index)
+	assert.False(t, block.Claims[0].MainnetFlag)
+	assert.Equal(t, uint64(2), block.Claims[0].RollupIndex)
+	require.Len(t, block.VerifiedBatches, 1)
+	assert.Equal(t, uint(3), block.VerifiedBatches[0].RollupID)
+	assert.Equal(t, newLocalExitRoot, block.VerifiedBatches[0].LocalExitRoot)
+	assert.Equal(t, aggregator, block.VerifiedBatches[0].Aggregator)
+}
+
+func TestProcessSovereignEventsInvalid(t *testing.T) {
+	ctx := context.Background()
+	etherMan := &Client{EtherClient: &blockByHashClient{}}
+	var blocks []Block
+	blocksOrder := make(map[common.Hash][]Order)
+	err := etherMan.processEvent(ctx, types.Log{Topics: []common.Hash{unsetClaimSignatureHash}, Data: words(7)}, &blocks, &blocksOrder)
+	assert.Error(t, err)
+	err = etherMan.processEvent(ctx, types.Log{Topics: []common.Hash{insertGlobalExitRootSignatureHash}}, &blocks, &blocksOrder)
+	assert.Error(t, err)
+	assert.Empty(t, blocks)
+}
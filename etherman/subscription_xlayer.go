@@ -0,0 +1,313 @@
+package etherman
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+const (
+	// subscriptionCacheBlocks is the number of blocks whose logs are kept in the cache
+	subscriptionCacheBlocks = 1024
+	// subscriptionRetryInterval is the delay between the attempts to restore a lost subscription
+	subscriptionRetryInterval = 5 * time.Second
+	// headerGracePeriod is the time a new header waits for the logs of its block, the node sends them before
+	// the header but the logs and the headers are delivered through different channels
+	headerGracePeriod  = 100 * time.Millisecond
+	subscriptionBuffer = 256
+)
+
+// Subscribe receives the new blocks and the logs of the bridge contracts through a websocket subscription
+// to the node. GetRollupInfoByBlockRange reads the ranges covered by the subscription from its cache and
+// keeps polling the node for the rest, so a lost subscription or a gap is backfilled with FilterLogs.
+// It must be called before the client is used by the synchronizer
+func (etherMan *Client) Subscribe(ctx context.Context, wsURL string) {
+	etherMan.subscription = newLogSubscription(wsURL, etherMan.SCAddresses)
+	go etherMan.subscription.run(ctx)
+}
+
+// NewHeads returns the channel that receives the number of the new blocks of the subscription, the
+// notifications are dropped while the previous one is not received. It's nil without subscription
+func (etherMan *Client) NewHeads() <-chan uint64 {
+	if etherMan.subscription == nil {
+		return nil
+	}
+	return etherMan.subscription.chHeads
+}
+
+// filterLogs gets the logs of the query from the subscription cache when it covers the range, and from
+// the node otherwise
+func (etherMan *Client) filterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	if etherMan.subscription == nil || query.FromBlock == nil || query.ToBlock == nil {
+		return etherMan.EtherClient.FilterLogs(ctx, query)
+	}
+	fromBlock, toBlock := query.FromBlock.Uint64(), query.ToBlock.Uint64()
+	cacheFrom := etherMan.subscription.cache.firstBlock()
+	if cacheFrom == 0 || cacheFrom > toBlock {
+		return etherMan.EtherClient.FilterLogs(ctx, query)
+	}
+	var logs []types.Log
+	if fromBlock < cacheFrom {
+		// Backfill the blocks before the subscription
+		backfill := query
+		backfill.ToBlock = new(big.Int).SetUint64(cacheFrom - 1)
+		var err error
+		logs, err = etherMan.EtherClient.FilterLogs(ctx, backfill)
+		if err != nil {
+			return nil, err
+		}
+		fromBlock = cacheFrom
+	}
+	cached, ok := etherMan.subscription.cache.getLogs(fromBlock, toBlock)
+	if !ok {
+		rest := query
+		rest.FromBlock = new(big.Int).SetUint64(fromBlock)
+		var err error
+		cached, err = etherMan.EtherClient.FilterLogs(ctx, rest)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return append(logs, cached...), nil
+}
+
+type logSubscription struct {
+	url       string
+	addresses []common.Address
+	cache     *logCache
+	chHeads   chan uint64
+}
+
+func newLogSubscription(url string, addresses []common.Address) *logSubscription {
+	return &logSubscription{
+		url:       url,
+		addresses: addresses,
+		cache:     newLogCache(addresses),
+		chHeads:   make(chan uint64, 1),
+	}
+}
+
+func (s *logSubscription) run(ctx context.Context) {
+	for {
+		err := s.subscribe(ctx)
+		s.cache.reset()
+		if ctx.Err() != nil {
+			return
+		}
+		log.Warnf("subscription to %s lost, polling the node until it's restored. Error: %v", s.url, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(subscriptionRetryInterval):
+		}
+	}
+}
+
+type pendingHeader struct {
+	header     *types.Header
+	receivedAt time.Time
+}
+
+func (s *logSubscription) subscribe(ctx context.Context) error {
+	client, err := ethclient.DialContext(ctx, s.url)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	// The logs are subscribed first, so the logs of every block after the first header are received
+	chLogs := make(chan types.Log, subscriptionBuffer)
+	logsSub, err := client.SubscribeFilterLogs(ctx, ethereum.FilterQuery{Addresses: s.addresses}, chLogs)
+	if err != nil {
+		return err
+	}
+	defer logsSub.Unsubscribe()
+	chHeaders := make(chan *types.Header, subscriptionBuffer)
+	headersSub, err := client.SubscribeNewHead(ctx, chHeaders)
+	if err != nil {
+		return err
+	}
+	defer headersSub.Unsubscribe()
+	log.Infof("subscribed to the new blocks and the bridge logs of %s", s.url)
+
+	ticker := time.NewTicker(headerGracePeriod)
+	defer ticker.Stop()
+	var pending []pendingHeader
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-logsSub.Err():
+			return err
+		case err := <-headersSub.Err():
+			return err
+		case l := <-chLogs:
+			s.cache.addLog(l)
+		case h := <-chHeaders:
+			pending = append(pending, pendingHeader{header: h, receivedAt: time.Now()})
+		case now := <-ticker.C:
+			if len(pending) == 0 || now.Sub(pending[0].receivedAt) < headerGracePeriod {
+				continue
+			}
+			// Read the logs waiting in the channel before the headers cover their blocks
+			for drained := false; !drained; {
+				select {
+				case l := <-chLogs:
+					s.cache.addLog(l)
+				default:
+					drained = true
+				}
+			}
+			for len(pending) > 0 && now.Sub(pending[0].receivedAt) >= headerGracePeriod {
+				s.cache.addHeader(pending[0].header)
+				s.notify(pending[0].header.Number.Uint64())
+				pending = pending[1:]
+			}
+		}
+	}
+}
+
+func (s *logSubscription) notify(blockNumber uint64) {
+	select {
+	case s.chHeads <- blockNumber:
+	default:
+	}
+}
+
+type cachedHeader struct {
+	hash  common.Hash
+	bloom types.Bloom
+}
+
+// logCache keeps the headers and the logs received through the subscription. A block is covered by the cache
+// once its header is received, the first header only starts the coverage because some of the logs of its
+// block can be sent before the logs subscription
+type logCache struct {
+	mu        sync.RWMutex
+	addresses []common.Address
+	// from is the first block covered by the cache, 0 when no block is covered
+	from    uint64
+	head    uint64
+	headers map[uint64]cachedHeader
+	logs    map[uint64][]types.Log
+}
+
+func newLogCache(addresses []common.Address) *logCache {
+	c := &logCache{addresses: addresses}
+	c.reset()
+	return c
+}
+
+func (c *logCache) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.from = 0
+	c.head = 0
+	c.headers = make(map[uint64]cachedHeader)
+	c.logs = make(map[uint64][]types.Log)
+}
+
+func (c *logCache) firstBlock() uint64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.from
+}
+
+func (c *logCache) addLog(l types.Log) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !l.Removed {
+		c.logs[l.BlockNumber] = append(c.logs[l.BlockNumber], l)
+		return
+	}
+	logs := c.logs[l.BlockNumber]
+	for i := range logs {
+		if logs[i].BlockHash == l.BlockHash && logs[i].Index == l.Index {
+			c.logs[l.BlockNumber] = append(logs[:i], logs[i+1:]...)
+			return
+		}
+	}
+}
+
+func (c *logCache) addHeader(h *types.Header) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	blockNumber := h.Number.Uint64()
+	switch {
+	case c.head == 0:
+		c.head = blockNumber
+		c.from = blockNumber + 1
+		return
+	case blockNumber <= c.head:
+		// Reorg, the blocks after the new one are replaced by the next headers
+		for n := blockNumber; n <= c.head; n++ {
+			delete(c.headers, n)
+		}
+		if blockNumber < c.from {
+			c.from = blockNumber
+		}
+	case blockNumber > c.head+1:
+		// Missed headers, the logs of their blocks can be missed too
+		c.from = blockNumber
+	}
+	c.headers[blockNumber] = cachedHeader{hash: h.Hash(), bloom: h.Bloom}
+	c.head = blockNumber
+	if blockNumber >= subscriptionCacheBlocks && c.from <= blockNumber-subscriptionCacheBlocks {
+		c.from = blockNumber - subscriptionCacheBlocks + 1
+	}
+	for n := range c.headers {
+		if n < c.from {
+			delete(c.headers, n)
+		}
+	}
+	for n := range c.logs {
+		if n < c.from {
+			delete(c.logs, n)
+		}
+	}
+}
+
+// getLogs returns the logs of the range ordered like FilterLogs, false if the cache does not cover the whole range
+func (c *logCache) getLogs(fromBlock, toBlock uint64) ([]types.Log, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.from == 0 || fromBlock < c.from || toBlock > c.head {
+		return nil, false
+	}
+	var logs []types.Log
+	for n := fromBlock; n <= toBlock; n++ {
+		header, ok := c.headers[n]
+		if !ok {
+			return nil, false
+		}
+		var blockLogs []types.Log
+		for _, l := range c.logs[n] {
+			if l.BlockHash == header.hash {
+				blockLogs = append(blockLogs, l)
+			}
+		}
+		if len(blockLogs) == 0 && c.mayContainLogs(header.bloom) {
+			// The bloom can be a false positive, but the logs can also be delayed
+			return nil, false
+		}
+		sort.Slice(blockLogs, func(i, j int) bool { return blockLogs[i].Index < blockLogs[j].Index })
+		logs = append(logs, blockLogs...)
+	}
+	return logs, true
+}
+
+func (c *logCache) mayContainLogs(bloom types.Bloom) bool {
+	for _, addr := range c.addresses {
+		if types.BloomLookup(bloom, addr) {
+			return true
+		}
+	}
+	return false
+}
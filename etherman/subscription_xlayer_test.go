@@ -0,0 +1,172 @@
+package etherman
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var bridgeAddr = common.HexToAddress("0xb7")
+
+func newHeader(blockNumber uint64, extra byte, withLogs bool) *types.Header {
+	h := &types.Header{Number: new(big.Int).SetUint64(blockNumber), Extra: []byte{extra}}
+	if withLogs {
+		h.Bloom.Add(bridgeAddr.Bytes())
+	}
+	return h
+}
+
+func newLog(h *types.Header, index uint) types.Log {
+	return types.Log{Address: bridgeAddr, BlockNumber: h.Number.Uint64(), BlockHash: h.Hash(), Index: index}
+}
+
+func TestLogCacheCoverage(t *testing.T) {
+	c := newLogCache([]common.Address{bridgeAddr})
+	_, ok := c.getLogs(10, 10)
+	assert.False(t, ok)
+
+	// the first header only starts the coverage
+	c.addHeader(newHeader(10, 0, true))
+	assert.Equal(t, uint64(11), c.firstBlock())
+	_, ok = c.getLogs(10, 10)
+	assert.False(t, ok)
+
+	h11, h12, h13 := newHeader(11, 0, true), newHeader(12, 0, false), newHeader(13, 0, true)
+	c.addLog(newLog(h11, 3))
+	c.addLog(newLog(h11, 1))
+	c.addHeader(h11)
+	c.addHeader(h12)
+	logs, ok := c.getLogs(11, 12)
+	require.True(t, ok)
+	require.Len(t, logs, 2)
+	assert.Equal(t, uint(1), logs[0].Index)
+	assert.Equal(t, uint(3), logs[1].Index)
+
+	// the bloom of the block says there are logs that were not received
+	c.addHeader(h13)
+	_, ok = c.getLogs(11, 13)
+	assert.False(t, ok)
+	// blocks after the head are not covered
+	_, ok = c.getLogs(12, 14)
+	assert.False(t, ok)
+
+	// missed headers restart the coverage
+	c.addHeader(newHeader(20, 0, false))
+	assert.Equal(t, uint64(20), c.firstBlock())
+	_, ok = c.getLogs(12, 20)
+	assert.False(t, ok)
+	logs, ok = c.getLogs(20, 20)
+	assert.True(t, ok)
+	assert.Empty(t, logs)
+
+	c.reset()
+	assert.Equal(t, uint64(0), c.firstBlock())
+}
+
+func TestLogCacheReorg(t *testing.T) {
+	c := newLogCache([]common.Address{bridgeAddr})
+	c.addHeader(newHeader(10, 0, false))
+	old11, old12 := newHeader(11, 0, true), newHeader(12, 0, true)
+	c.addLog(newLog(old11, 0))
+	c.addLog(newLog(old12, 0))
+	c.addHeader(old11)
+	c.addHeader(old12)
+	logs, ok := c.getLogs(11, 12)
+	require.True(t, ok)
+	assert.Len(t, logs, 2)
+
+	// the block 12 is replaced and its logs are removed
+	removed := newLog(old12, 0)
+	removed.Removed = true
+	c.addLog(removed)
+	new12 := newHeader(12, 1, true)
+	c.addLog(newLog(new12, 5))
+	c.addHeader(new12)
+	logs, ok = c.getLogs(11, 12)
+	require.True(t, ok)
+	require.Len(t, logs, 2)
+	assert.Equal(t, new12.Hash(), logs[1].BlockHash)
+	assert.Equal(t, uint(5), logs[1].Index)
+
+	// the logs of the replaced block 11 are ignored, the bloom of the new one has no logs
+	c.addHeader(newHeader(11, 1, false))
+	_, ok = c.getLogs(11, 12)
+	assert.False(t, ok)
+	logs, ok = c.getLogs(11, 11)
+	require.True(t, ok)
+	assert.Empty(t, logs)
+}
+
+func TestLogCachePrune(t *testing.T) {
+	c := newLogCache([]common.Address{bridgeAddr})
+	c.addHeader(newHeader(1, 0, false))
+	for n := uint64(2); n <= subscriptionCacheBlocks+10; n++ {
+		c.addHeader(newHeader(n, 0, false))
+	}
+	assert.Equal(t, uint64(11), c.firstBlock())
+	assert.Len(t, c.headers, subscriptionCacheBlocks)
+}
+
+type filterLogsClient struct {
+	ethClienter
+	queries []ethereum.FilterQuery
+}
+
+func (c *filterLogsClient) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	c.queries = append(c.queries, query)
+	return []types.Log{{BlockNumber: query.FromBlock.Uint64()}}, nil
+}
+
+func TestFilterLogsWithSubscription(t *testing.T) {
+	ctx := context.Background()
+	client := &filterLogsClient{}
+	etherMan := &Client{EtherClient: client, SCAddresses: []common.Address{bridgeAddr}}
+	query := func(from, to uint64) ethereum.FilterQuery {
+		return ethereum.FilterQuery{FromBlock: new(big.Int).SetUint64(from), ToBlock: new(big.Int).SetUint64(to), Addresses: etherMan.SCAddresses}
+	}
+
+	// without subscription the node is polled
+	_, err := etherMan.filterLogs(ctx, query(1, 5))
+	require.NoError(t, err)
+	require.Len(t, client.queries, 1)
+
+	etherMan.subscription = newLogSubscription("", etherMan.SCAddresses)
+	cache := etherMan.subscription.cache
+	cache.addHeader(newHeader(10, 0, false))
+	h11 := newHeader(11, 0, true)
+	cache.addLog(newLog(h11, 0))
+	cache.addHeader(h11)
+	cache.addHeader(newHeader(12, 0, false))
+
+	// the range is covered by the subscription
+	client.queries = nil
+	logs, err := etherMan.filterLogs(ctx, query(11, 12))
+	require.NoError(t, err)
+	assert.Empty(t, client.queries)
+	require.Len(t, logs, 1)
+	assert.Equal(t, h11.Hash(), logs[0].BlockHash)
+
+	// the blocks before the subscription are backfilled
+	logs, err = etherMan.filterLogs(ctx, query(5, 12))
+	require.NoError(t, err)
+	require.Len(t, client.queries, 1)
+	assert.Equal(t, uint64(5), client.queries[0].FromBlock.Uint64())
+	assert.Equal(t, uint64(10), client.queries[0].ToBlock.Uint64())
+	require.Len(t, logs, 2)
+	assert.Equal(t, uint64(5), logs[0].BlockNumber)
+	assert.Equal(t, uint64(11), logs[1].BlockNumber)
+
+	// the blocks after the head are polled
+	client.queries = nil
+	_, err = etherMan.filterLogs(ctx, query(11, 13))
+	require.NoError(t, err)
+	require.Len(t, client.queries, 1)
+	assert.Equal(t, uint64(11), client.queries[0].FromBlock.Uint64())
+	assert.Equal(t, uint64(13), client.queries[0].ToBlock.Uint64())
+}
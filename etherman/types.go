@@ -21,6 +21,10 @@ type Block struct {
 	VerifiedBatches []VerifiedBatch
 	ActivateEtrog   []bool
 	ReceivedAt      time.Time
+
+	// XLayer
+	SovereignGlobalExitRoots []SovereignGlobalExitRoot
+	UnsetClaims              []Claim
 }
 
 // GlobalExitRoot struct
@@ -32,6 +36,20 @@ type GlobalExitRoot struct {
 
 	// XLayer
 	Time time.Time
+	// NetworkID is the sovereign network the global exit root was injected on, 0 for the global exit roots of L1 and
+	// of the trusted state of the rollup
+	NetworkID uint
+}
+
+// SovereignGlobalExitRoot is a global exit root inserted or removed on the global exit root manager of a
+// sovereign chain
+type SovereignGlobalExitRoot struct {
+	BlockID        uint64
+	BlockNumber    uint64
+	NetworkID      uint
+	GlobalExitRoot common.Hash
+	Removed        bool
+	TxHash         common.Hash
 }
 
 // Deposit struct
@@ -56,8 +74,22 @@ type Deposit struct {
 	Id                  uint64
 	ReadyTime           time.Time
 	DestContractAddress common.Address
+	TrustLevel          TrustLevel
+	// LogIndex is the index of the deposit event in its block, 0 for the deposits synced before it was stored
+	LogIndex uint
 }
 
+// TrustLevel is how final the block of a deposit is
+type TrustLevel uint8
+
+const (
+	// TrustLevelVirtual is a deposit of L1 or of a virtualized L2 block
+	TrustLevelVirtual TrustLevel = 0
+	// TrustLevelTrusted is a deposit of an L2 block that is only known by the trusted sequencer, it's removed
+	// by the reorg detection if the block is not virtualized as it is
+	TrustLevelTrusted TrustLevel = 1
+)
+
 // Claim struct
 type Claim struct {
 	MainnetFlag        bool
@@ -112,3 +144,16 @@ type RollupExitLeaf struct {
 	RollupId uint
 	Root     common.Hash
 }
+
+// ReorgJournal struct, what was rolled back by a reorg
+type ReorgJournal struct {
+	ID              uint64
+	NetworkID       uint
+	FromBlock       uint64 // Last synced block before the reorg
+	ToBlock         uint64 // Block the state was reverted to
+	Depth           uint64
+	Deposits        []*Deposit
+	Claims          []*Claim
+	GlobalExitRoots []*GlobalExitRoot
+	CreatedAt       time.Time
+}
@@ -0,0 +1,283 @@
+package integrity
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+const depositsPageSize = 1000
+
+// Config is the configuration of the checks
+type Config struct {
+	// Height is the depth of the exit trees
+	Height uint8
+	// Samples is the number of deposits of each network whose roots are checked against the storage
+	// and whose blocks are checked against the bridge
+	Samples uint
+	// ClaimSamples is the number of random claims of each network checked against the bridge
+	ClaimSamples uint
+	// GlobalExitRootSamples is the number of random L1 global exit roots checked against the global exit root manager
+	GlobalExitRootSamples uint
+}
+
+// Network is a network to verify
+type Network struct {
+	// ID is the network ID, the exit tree of the network is stored with it in the merkle tree storage
+	ID     uint
+	Bridge bridgeInterface
+}
+
+// Checker recomputes the exit trees from the synced deposits and checks the synced state against the chains.
+// The first network is L1, its global exit root manager is used to check the synced global exit roots
+type Checker struct {
+	storage    storageInterface
+	gerManager globalExitRootManagerInterface
+	networks   []Network
+	cfg        Config
+}
+
+// NewChecker creates a new Checker
+func NewChecker(cfg Config, storage storageInterface, gerManager globalExitRootManagerInterface, networks []Network) *Checker {
+	return &Checker{
+		storage:    storage,
+		gerManager: gerManager,
+		networks:   networks,
+		cfg:        cfg,
+	}
+}
+
+// Run does all the checks and returns the report with the discrepancies found. The errors of the chains are
+// reported as failed checks, only the storage errors stop the verification
+func (c *Checker) Run(ctx context.Context) (*Report, error) {
+	report := &Report{StartedAt: time.Now().UTC(), Discrepancies: []Discrepancy{}}
+	for _, network := range c.networks {
+		networkReport, err := c.checkExitTree(ctx, network, report)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to verify the exit tree of network %d", network.ID)
+		}
+		err = c.checkClaims(ctx, network, networkReport, report)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to verify the claims of network %d", network.ID)
+		}
+		report.Networks = append(report.Networks, networkReport)
+		log.Infof("network %d verified: %d deposits, root %s", network.ID, networkReport.Deposits, networkReport.Root)
+	}
+	if err := c.checkGlobalExitRoots(ctx, report); err != nil {
+		return nil, errors.Wrap(err, "failed to verify the global exit roots")
+	}
+	report.FinishedAt = time.Now().UTC()
+	return report, nil
+}
+
+// checkExitTree adds all the deposits of the network to an empty tree in deposit count order. The roots of
+// the sampled deposits are compared with the stored ones and, once every deposit of the sampled block is
+// added, with the bridge deposit count and root at that block
+func (c *Checker) checkExitTree(ctx context.Context, network Network, report *Report) (*NetworkReport, error) {
+	total, err := c.storage.GetNumberDeposits(ctx, network.ID, math.MaxInt64, nil)
+	if err != nil {
+		return nil, err
+	}
+	samples := sampleDepositCounts(uint(total), c.cfg.Samples)
+	tree := bridgectrl.NewExitTree(c.cfg.Height)
+	networkReport := &NetworkReport{NetworkID: network.ID, SampledBlocks: []uint64{}}
+	var sampled *etherman.Deposit
+	checkSampledBlock := func() {
+		c.checkBridge(ctx, network, sampled.BlockNumber, tree, report)
+		networkReport.SampledBlocks = append(networkReport.SampledBlocks, sampled.BlockNumber)
+		sampled = nil
+	}
+
+loop:
+	for tree.Count() < uint(total) {
+		deposits, err := c.storage.GetDepositsByDepositCount(ctx, network.ID, tree.Count(), depositsPageSize, nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(deposits) == 0 {
+			break
+		}
+		for _, deposit := range deposits {
+			if deposit.DepositCount != tree.Count() {
+				// The roots after a missing deposit can't be recomputed
+				report.add(Discrepancy{
+					Type:         DepositCountGap,
+					NetworkID:    network.ID,
+					BlockNumber:  deposit.BlockNumber,
+					DepositCount: tree.Count(),
+					Detail:       fmt.Sprintf("the next synced deposit is %d", deposit.DepositCount),
+				})
+				sampled = nil
+				break loop
+			}
+			if sampled != nil && deposit.BlockNumber > sampled.BlockNumber {
+				checkSampledBlock()
+			}
+			tree.AddDeposit(deposit)
+			if _, ok := samples[deposit.DepositCount]; ok {
+				if err = c.checkStoredRoot(ctx, network, deposit, tree.Root(), report); err != nil {
+					return nil, err
+				}
+				if sampled == nil {
+					sampled = deposit
+				}
+			}
+		}
+	}
+	if sampled != nil {
+		checkSampledBlock()
+	}
+	networkReport.Deposits = tree.Count()
+	networkReport.Root = tree.Root().String()
+	return networkReport, nil
+}
+
+func (c *Checker) checkStoredRoot(ctx context.Context, network Network, deposit *etherman.Deposit, root common.Hash, report *Report) error {
+	stored, err := c.storage.GetRoot(ctx, deposit.DepositCount, network.ID, nil)
+	if errors.Is(err, gerror.ErrStorageNotFound) {
+		report.add(Discrepancy{
+			Type:         StoredRootMismatch,
+			NetworkID:    network.ID,
+			BlockNumber:  deposit.BlockNumber,
+			DepositCount: deposit.DepositCount,
+			Expected:     root.String(),
+			Detail:       "no root stored for the deposit",
+		})
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if storedRoot := common.BytesToHash(stored); storedRoot != root {
+		report.add(Discrepancy{
+			Type:         StoredRootMismatch,
+			NetworkID:    network.ID,
+			BlockNumber:  deposit.BlockNumber,
+			DepositCount: deposit.DepositCount,
+			Expected:     root.String(),
+			Actual:       storedRoot.String(),
+		})
+	}
+	return nil
+}
+
+// checkBridge compares the tree with the bridge of the network at the block, the historical state of the
+// block must be available in the node
+func (c *Checker) checkBridge(ctx context.Context, network Network, blockNumber uint64, tree *bridgectrl.ExitTree, report *Report) {
+	depositCount, err := network.Bridge.GetDepositCount(ctx, blockNumber)
+	if err != nil {
+		report.add(Discrepancy{Type: CheckFailed, NetworkID: network.ID, BlockNumber: blockNumber, Detail: "depositCount: " + err.Error()})
+		return
+	}
+	if depositCount != uint64(tree.Count()) {
+		report.add(Discrepancy{
+			Type:        OnChainDepositCountMismatch,
+			NetworkID:   network.ID,
+			BlockNumber: blockNumber,
+			Expected:    fmt.Sprint(tree.Count()),
+			Actual:      fmt.Sprint(depositCount),
+		})
+		return
+	}
+	root, err := network.Bridge.GetDepositRoot(ctx, blockNumber)
+	if err != nil {
+		report.add(Discrepancy{Type: CheckFailed, NetworkID: network.ID, BlockNumber: blockNumber, Detail: "getRoot: " + err.Error()})
+		return
+	}
+	if root != tree.Root() {
+		report.add(Discrepancy{
+			Type:         OnChainRootMismatch,
+			NetworkID:    network.ID,
+			BlockNumber:  blockNumber,
+			DepositCount: tree.Count() - 1,
+			Expected:     tree.Root().String(),
+			Actual:       root.String(),
+		})
+	}
+}
+
+func (c *Checker) checkClaims(ctx context.Context, network Network, networkReport *NetworkReport, report *Report) error {
+	if c.cfg.ClaimSamples == 0 {
+		return nil
+	}
+	claims, err := c.storage.GetRandomClaims(ctx, network.ID, c.cfg.ClaimSamples, nil)
+	if err != nil {
+		return err
+	}
+	for _, claim := range claims {
+		// The source bridge network is 0 for mainnet and the rollup index plus one for the rollups
+		var sourceBridgeNetwork uint32
+		if !claim.MainnetFlag {
+			sourceBridgeNetwork = uint32(claim.RollupIndex + 1)
+		}
+		claimed, err := network.Bridge.IsClaimed(ctx, uint32(claim.Index), sourceBridgeNetwork)
+		if err != nil {
+			report.add(Discrepancy{Type: CheckFailed, NetworkID: network.ID, BlockNumber: claim.BlockNumber, DepositCount: claim.Index, Detail: "isClaimed: " + err.Error()})
+			continue
+		}
+		networkReport.CheckedClaims++
+		if !claimed {
+			report.add(Discrepancy{
+				Type:         ClaimNotOnChain,
+				NetworkID:    network.ID,
+				BlockNumber:  claim.BlockNumber,
+				DepositCount: claim.Index,
+				Detail:       fmt.Sprintf("source bridge network %d, tx %s", sourceBridgeNetwork, claim.TxHash),
+			})
+		}
+	}
+	return nil
+}
+
+func (c *Checker) checkGlobalExitRoots(ctx context.Context, report *Report) error {
+	if c.cfg.GlobalExitRootSamples == 0 || len(c.networks) == 0 {
+		return nil
+	}
+	l1NetworkID := c.networks[0].ID
+	gers, err := c.storage.GetRandomL1GlobalExitRoots(ctx, c.cfg.GlobalExitRootSamples, nil)
+	if err != nil {
+		return err
+	}
+	for _, ger := range gers {
+		report.CheckedGlobalExitRoots++
+		if len(ger.ExitRoots) != 2 { //nolint:gomnd
+			report.add(Discrepancy{Type: GlobalExitRootMismatch, NetworkID: l1NetworkID, BlockNumber: ger.BlockNumber, Actual: ger.GlobalExitRoot.String(),
+				Detail: fmt.Sprintf("%d exit roots stored", len(ger.ExitRoots))})
+			continue
+		}
+		if expected := common.Hash(bridgectrl.Hash(ger.ExitRoots[0], ger.ExitRoots[1])); expected != ger.GlobalExitRoot {
+			report.add(Discrepancy{Type: GlobalExitRootMismatch, NetworkID: l1NetworkID, BlockNumber: ger.BlockNumber, Expected: expected.String(), Actual: ger.GlobalExitRoot.String()})
+			continue
+		}
+		if c.gerManager == nil {
+			continue
+		}
+		timestamp, err := c.gerManager.GetGlobalExitRootTimestamp(ctx, ger.GlobalExitRoot)
+		if err != nil {
+			report.add(Discrepancy{Type: CheckFailed, NetworkID: l1NetworkID, BlockNumber: ger.BlockNumber, Detail: "globalExitRootMap: " + err.Error()})
+			continue
+		}
+		if timestamp == 0 {
+			report.add(Discrepancy{Type: GlobalExitRootNotOnChain, NetworkID: l1NetworkID, BlockNumber: ger.BlockNumber, Actual: ger.GlobalExitRoot.String()})
+		}
+	}
+	return nil
+}
+
+// sampleDepositCounts spreads the samples evenly over the deposits, the last deposit is always sampled
+func sampleDepositCounts(total, samples uint) map[uint]struct{} {
+	counts := make(map[uint]struct{})
+	for i := uint(1); i <= samples; i++ {
+		if n := i * total / samples; n > 0 {
+			counts[n-1] = struct{}{}
+		}
+	}
+	return counts
+}
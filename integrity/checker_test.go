@@ -0,0 +1,208 @@
+package integrity
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeStorage struct {
+	deposits []*etherman.Deposit
+	roots    map[uint][]byte
+	// rootsNetwork is the network ID the roots are stored with
+	rootsNetwork uint
+	claims       []*etherman.Claim
+	gers         []*etherman.GlobalExitRoot
+}
+
+func (s *fakeStorage) GetNumberDeposits(ctx context.Context, networkID uint, blockNumber uint64, dbTx pgx.Tx) (uint64, error) {
+	if len(s.deposits) == 0 {
+		return 0, nil
+	}
+	return uint64(s.deposits[len(s.deposits)-1].DepositCount + 1), nil
+}
+
+func (s *fakeStorage) GetDepositsByDepositCount(ctx context.Context, networkID uint, fromDepositCount uint, limit uint, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
+	var deposits []*etherman.Deposit
+	for _, d := range s.deposits {
+		if d.DepositCount >= fromDepositCount && uint(len(deposits)) < limit {
+			deposits = append(deposits, d)
+		}
+	}
+	return deposits, nil
+}
+
+func (s *fakeStorage) GetRoot(ctx context.Context, depositCnt uint, network uint, dbTx pgx.Tx) ([]byte, error) {
+	root, ok := s.roots[depositCnt]
+	if !ok || network != s.rootsNetwork {
+		return nil, gerror.ErrStorageNotFound
+	}
+	return root, nil
+}
+
+func (s *fakeStorage) GetRandomClaims(ctx context.Context, networkID uint, limit uint, dbTx pgx.Tx) ([]*etherman.Claim, error) {
+	return s.claims, nil
+}
+
+func (s *fakeStorage) GetRandomL1GlobalExitRoots(ctx context.Context, limit uint, dbTx pgx.Tx) ([]*etherman.GlobalExitRoot, error) {
+	return s.gers, nil
+}
+
+// fakeBridge answers with the state of the deposits up to the block
+type fakeBridge struct {
+	deposits []*etherman.Deposit
+	claimed  map[uint32]bool
+}
+
+func (b *fakeBridge) tree(blockNumber uint64) *bridgectrl.ExitTree {
+	tree := bridgectrl.NewExitTree(32)
+	for _, d := range b.deposits {
+		if d.BlockNumber <= blockNumber {
+			tree.AddDeposit(d)
+		}
+	}
+	return tree
+}
+
+func (b *fakeBridge) GetDepositCount(ctx context.Context, blockNumber uint64) (uint64, error) {
+	return uint64(b.tree(blockNumber).Count()), nil
+}
+
+func (b *fakeBridge) GetDepositRoot(ctx context.Context, blockNumber uint64) (common.Hash, error) {
+	return b.tree(blockNumber).Root(), nil
+}
+
+func (b *fakeBridge) IsClaimed(ctx context.Context, depositCount uint32, sourceBridgeNetwork uint32) (bool, error) {
+	if sourceBridgeNetwork != 0 {
+		return false, errors.New("unexpected source network")
+	}
+	return b.claimed[depositCount], nil
+}
+
+type fakeGERManager map[common.Hash]uint64
+
+func (m fakeGERManager) GetGlobalExitRootTimestamp(ctx context.Context, ger common.Hash) (uint64, error) {
+	return m[ger], nil
+}
+
+func newDeposits(n int) []*etherman.Deposit {
+	var deposits []*etherman.Deposit
+	for i := 0; i < n; i++ {
+		deposits = append(deposits, &etherman.Deposit{
+			OriginalAddress:    common.HexToAddress("0x1"),
+			Amount:             big.NewInt(int64(i + 1)),
+			DestinationNetwork: 1,
+			DestinationAddress: common.HexToAddress("0x2"),
+			DepositCount:       uint(i),
+			// two deposits per block
+			BlockNumber: uint64(100 + i/2),
+		})
+	}
+	return deposits
+}
+
+func storedRoots(deposits []*etherman.Deposit) map[uint][]byte {
+	roots := make(map[uint][]byte)
+	tree := bridgectrl.NewExitTree(32)
+	for _, d := range deposits {
+		tree.AddDeposit(d)
+		roots[d.DepositCount] = tree.Root().Bytes()
+	}
+	return roots
+}
+
+func TestCheckerConsistentState(t *testing.T) {
+	deposits := newDeposits(2500)
+	mainnetExitRoot, rollupExitRoot := common.HexToHash("0x1"), common.HexToHash("0x2")
+	ger := common.Hash(bridgectrl.Hash(mainnetExitRoot, rollupExitRoot))
+	// the roots are looked up with the network ID, not with the position of the network
+	storage := &fakeStorage{
+		deposits:     deposits,
+		roots:        storedRoots(deposits),
+		rootsNetwork: 1,
+		claims:       []*etherman.Claim{{Index: 7, MainnetFlag: true, NetworkID: 0}},
+		gers:         []*etherman.GlobalExitRoot{{GlobalExitRoot: ger, ExitRoots: []common.Hash{mainnetExitRoot, rollupExitRoot}}},
+	}
+	bridge := &fakeBridge{deposits: deposits, claimed: map[uint32]bool{7: true}}
+	checker := NewChecker(Config{Height: 32, Samples: 5, ClaimSamples: 1, GlobalExitRootSamples: 1}, storage, fakeGERManager{ger: 1}, []Network{{ID: 1, Bridge: bridge}})
+
+	report, err := checker.Run(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, report.Discrepancies)
+	require.Len(t, report.Networks, 1)
+	assert.Equal(t, uint(2500), report.Networks[0].Deposits)
+	assert.Equal(t, common.BytesToHash(storage.roots[2499]).String(), report.Networks[0].Root)
+	assert.Equal(t, []uint64{349, 599, 849, 1099, 1349}, report.Networks[0].SampledBlocks)
+	assert.Equal(t, uint(1), report.Networks[0].CheckedClaims)
+	assert.Equal(t, uint(1), report.CheckedGlobalExitRoots)
+}
+
+func TestCheckerDiscrepancies(t *testing.T) {
+	deposits := newDeposits(10)
+	roots := storedRoots(deposits)
+	roots[4] = common.HexToHash("0xbad").Bytes()
+	delete(roots, 9)
+	// the deposit 5 of the bridge is not the synced one and the deposits of the last block are missing
+	onChain := append(newDeposits(5), &etherman.Deposit{Amount: big.NewInt(0), DepositCount: 5, BlockNumber: 102})
+	ger := common.HexToHash("0x3")
+	storage := &fakeStorage{
+		deposits: deposits,
+		roots:    roots,
+		claims:   []*etherman.Claim{{Index: 3, MainnetFlag: true}, {Index: 4, RollupIndex: 1}},
+		gers:     []*etherman.GlobalExitRoot{{GlobalExitRoot: ger, ExitRoots: []common.Hash{{}, {}}}},
+	}
+	bridge := &fakeBridge{deposits: onChain, claimed: map[uint32]bool{}}
+	checker := NewChecker(Config{Height: 32, Samples: 2, ClaimSamples: 2, GlobalExitRootSamples: 1}, storage, fakeGERManager{}, []Network{{ID: 0, Bridge: bridge}})
+
+	report, err := checker.Run(context.Background())
+	require.NoError(t, err)
+	var types []DiscrepancyType
+	for _, d := range report.Discrepancies {
+		types = append(types, d.Type)
+	}
+	assert.Equal(t, []DiscrepancyType{
+		StoredRootMismatch, OnChainRootMismatch,
+		StoredRootMismatch, OnChainDepositCountMismatch,
+		ClaimNotOnChain, CheckFailed,
+		GlobalExitRootMismatch,
+	}, types)
+	assert.Equal(t, uint(4), report.Discrepancies[0].DepositCount)
+	assert.Equal(t, "0x0000000000000000000000000000000000000000000000000000000000000bad", report.Discrepancies[0].Actual)
+	assert.Equal(t, Discrepancy{
+		Type:         OnChainRootMismatch,
+		BlockNumber:  102,
+		DepositCount: 5,
+		Expected:     common.BytesToHash(storedRoots(deposits)[5]).String(),
+		Actual:       bridge.tree(102).Root().String(),
+	}, report.Discrepancies[1])
+	assert.Equal(t, "no root stored for the deposit", report.Discrepancies[2].Detail)
+}
+
+func TestCheckerDepositCountGap(t *testing.T) {
+	deposits := newDeposits(6)
+	deposits = append(deposits[:3], deposits[4:]...)
+	storage := &fakeStorage{deposits: deposits, roots: storedRoots(deposits)}
+	checker := NewChecker(Config{Height: 32, Samples: 1}, storage, nil, []Network{{ID: 1, Bridge: &fakeBridge{}}})
+
+	report, err := checker.Run(context.Background())
+	require.NoError(t, err)
+	require.Len(t, report.Discrepancies, 1)
+	assert.Equal(t, Discrepancy{Type: DepositCountGap, NetworkID: 1, BlockNumber: 102, DepositCount: 3, Detail: "the next synced deposit is 4"}, report.Discrepancies[0])
+	assert.Equal(t, uint(3), report.Networks[0].Deposits)
+}
+
+func TestSampleDepositCounts(t *testing.T) {
+	assert.Equal(t, map[uint]struct{}{4: {}, 9: {}}, sampleDepositCounts(10, 2))
+	assert.Equal(t, map[uint]struct{}{0: {}, 1: {}}, sampleDepositCounts(2, 5))
+	assert.Empty(t, sampleDepositCounts(0, 5))
+	assert.Empty(t, sampleDepositCounts(10, 0))
+}
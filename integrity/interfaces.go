@@ -0,0 +1,27 @@
+package integrity
+
+import (
+	"context"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+)
+
+type storageInterface interface {
+	GetNumberDeposits(ctx context.Context, networkID uint, blockNumber uint64, dbTx pgx.Tx) (uint64, error)
+	GetDepositsByDepositCount(ctx context.Context, networkID uint, fromDepositCount uint, limit uint, dbTx pgx.Tx) ([]*etherman.Deposit, error)
+	GetRoot(ctx context.Context, depositCnt uint, network uint, dbTx pgx.Tx) ([]byte, error)
+	GetRandomClaims(ctx context.Context, networkID uint, limit uint, dbTx pgx.Tx) ([]*etherman.Claim, error)
+	GetRandomL1GlobalExitRoots(ctx context.Context, limit uint, dbTx pgx.Tx) ([]*etherman.GlobalExitRoot, error)
+}
+
+type bridgeInterface interface {
+	GetDepositCount(ctx context.Context, blockNumber uint64) (uint64, error)
+	GetDepositRoot(ctx context.Context, blockNumber uint64) (common.Hash, error)
+	IsClaimed(ctx context.Context, depositCount uint32, sourceBridgeNetwork uint32) (bool, error)
+}
+
+type globalExitRootManagerInterface interface {
+	GetGlobalExitRootTimestamp(ctx context.Context, globalExitRoot common.Hash) (uint64, error)
+}
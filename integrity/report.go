@@ -0,0 +1,61 @@
+package integrity
+
+import (
+	"time"
+)
+
+// DiscrepancyType identifies the check that found a discrepancy
+type DiscrepancyType string
+
+const (
+	// DepositCountGap is a deposit count missing in the synced deposits of a network
+	DepositCountGap DiscrepancyType = "deposit_count_gap"
+	// StoredRootMismatch is a root stored in mt.root that differs from the root recomputed from the deposits
+	StoredRootMismatch DiscrepancyType = "stored_root_mismatch"
+	// OnChainDepositCountMismatch is a bridge deposit count that differs from the synced deposits at the same block
+	OnChainDepositCountMismatch DiscrepancyType = "onchain_deposit_count_mismatch"
+	// OnChainRootMismatch is a bridge deposit root that differs from the recomputed root at the same block
+	OnChainRootMismatch DiscrepancyType = "onchain_root_mismatch"
+	// ClaimNotOnChain is a synced claim that the bridge does not have as claimed
+	ClaimNotOnChain DiscrepancyType = "claim_not_onchain"
+	// GlobalExitRootMismatch is a synced global exit root that is not the hash of its exit roots
+	GlobalExitRootMismatch DiscrepancyType = "global_exit_root_mismatch"
+	// GlobalExitRootNotOnChain is a synced global exit root unknown by the global exit root manager
+	GlobalExitRootNotOnChain DiscrepancyType = "global_exit_root_not_onchain"
+	// CheckFailed is a check that could not be done because the node failed to answer
+	CheckFailed DiscrepancyType = "check_failed"
+)
+
+// Discrepancy is a difference found between the synced state and the recomputed or on-chain state
+type Discrepancy struct {
+	Type         DiscrepancyType `json:"type"`
+	NetworkID    uint            `json:"networkId"`
+	BlockNumber  uint64          `json:"blockNumber,omitempty"`
+	DepositCount uint            `json:"depositCount,omitempty"`
+	Expected     string          `json:"expected,omitempty"`
+	Actual       string          `json:"actual,omitempty"`
+	Detail       string          `json:"detail,omitempty"`
+}
+
+// NetworkReport sums up the checks done on a network
+type NetworkReport struct {
+	NetworkID uint `json:"networkId"`
+	Deposits  uint `json:"deposits"`
+	// Root is the root recomputed from all the synced deposits
+	Root          string   `json:"root"`
+	SampledBlocks []uint64 `json:"sampledBlocks"`
+	CheckedClaims uint     `json:"checkedClaims"`
+}
+
+// Report is the machine readable result of a verification
+type Report struct {
+	StartedAt              time.Time        `json:"startedAt"`
+	FinishedAt             time.Time        `json:"finishedAt"`
+	Networks               []*NetworkReport `json:"networks"`
+	CheckedGlobalExitRoots uint             `json:"checkedGlobalExitRoots"`
+	Discrepancies          []Discrepancy    `json:"discrepancies"`
+}
+
+func (r *Report) add(d Discrepancy) {
+	r.Discrepancies = append(r.Discrepancies, d)
+}
@@ -0,0 +1,18 @@
+package leaderelection
+
+import "github.com/0xPolygonHermez/zkevm-node/config/types"
+
+// Config is the configuration of the leader election among the replicas
+type Config struct {
+	// Enabled whether only the leader replica runs the synchronizer, claimtxman and push tasks
+	Enabled bool `mapstructure:"Enabled"`
+	// Key is the redis key of the leader lease, the role of the tasks is appended to it
+	Key string `mapstructure:"Key"`
+	// LeaseDuration is the time the lease is valid without being renewed. A standby replica takes
+	// over after it when the leader dies
+	LeaseDuration types.Duration `mapstructure:"LeaseDuration"`
+	// RenewInterval is the interval to renew the lease, it must be shorter than LeaseDuration
+	RenewInterval types.Duration `mapstructure:"RenewInterval"`
+	// RetryInterval is the interval of the standby replicas to try to acquire the lease
+	RetryInterval types.Duration `mapstructure:"RetryInterval"`
+}
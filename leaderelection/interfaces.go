@@ -0,0 +1,13 @@
+package leaderelection
+
+import (
+	"context"
+	"time"
+)
+
+type leaseStorage interface {
+	AcquireLease(ctx context.Context, key string, holder string, ttl time.Duration) (bool, error)
+	RenewLease(ctx context.Context, key string, holder string, ttl time.Duration) (bool, error)
+	ReleaseLease(ctx context.Context, key string, holder string) error
+	IncrFencingToken(ctx context.Context, key string) (int64, error)
+}
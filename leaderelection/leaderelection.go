@@ -0,0 +1,160 @@
+package leaderelection
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/metrics"
+	"github.com/google/uuid"
+)
+
+// Elector elects the leader of a role among the replicas, using a lease with a fencing token. The
+// token increases on every acquisition and it's part of the lease holder, so a stale leader can't
+// renew or release the lease of a newer one
+type Elector struct {
+	cfg     Config
+	role    string
+	key     string
+	id      string
+	storage leaseStorage
+
+	mu        sync.RWMutex
+	holder    string
+	token     int64
+	leading   bool
+	lastRenew time.Time
+	lost      chan struct{}
+}
+
+// NewElector creates the leader elector of the role
+func NewElector(cfg Config, role string, storage interface{}) *Elector {
+	hostname, _ := os.Hostname()
+	return &Elector{
+		cfg:     cfg,
+		role:    role,
+		key:     cfg.Key + "_" + role,
+		id:      fmt.Sprintf("%s_%d_%s", hostname, os.Getpid(), uuid.NewString()),
+		storage: storage.(leaseStorage),
+		lost:    make(chan struct{}),
+	}
+}
+
+// Campaign blocks until this replica is the leader or the context is done. Once elected, the lease
+// is renewed in the background until the context is done or the leadership is lost
+func (e *Elector) Campaign(ctx context.Context) error {
+	metrics.RecordLeader(e.role, false)
+	for {
+		acquired, err := e.tryAcquire(ctx)
+		if err != nil {
+			log.Warnf("failed to acquire the %s leader lease: %v", e.role, err)
+		}
+		if acquired {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(e.cfg.RetryInterval.Duration):
+		}
+	}
+	log.Infof("elected as the %s leader with fencing token %d", e.role, e.FencingToken())
+	metrics.RecordLeader(e.role, true)
+	go e.renew(ctx)
+	return nil
+}
+
+// IsLeader returns whether this replica is the leader. The leadership ends when the lease could
+// expire, even if it was not possible to check it, so two replicas never lead at the same time
+func (e *Elector) IsLeader() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.leading && time.Since(e.lastRenew) < e.cfg.LeaseDuration.Duration
+}
+
+// FencingToken returns the fencing token of the current lease
+func (e *Elector) FencingToken() int64 {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.token
+}
+
+// LeaseKey returns the key of the lease, the writes guarded by the fencing token are checked against it
+func (e *Elector) LeaseKey() string {
+	return e.key
+}
+
+// Lost returns a channel that is closed when the leadership is lost
+func (e *Elector) Lost() <-chan struct{} {
+	return e.lost
+}
+
+func (e *Elector) tryAcquire(ctx context.Context) (bool, error) {
+	token, err := e.storage.IncrFencingToken(ctx, e.key)
+	if err != nil {
+		return false, err
+	}
+	holder := fmt.Sprintf("%s_%d", e.id, token)
+	now := time.Now()
+	acquired, err := e.storage.AcquireLease(ctx, e.key, holder, e.cfg.LeaseDuration.Duration)
+	if err != nil || !acquired {
+		return false, err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.holder, e.token, e.leading, e.lastRenew = holder, token, true, now
+	return true, nil
+}
+
+func (e *Elector) renew(ctx context.Context) {
+	ticker := time.NewTicker(e.cfg.RenewInterval.Duration)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			e.release()
+			return
+		case <-ticker.C:
+			now := time.Now()
+			renewed, err := e.storage.RenewLease(ctx, e.key, e.holder, e.cfg.LeaseDuration.Duration)
+			if err == nil && renewed {
+				e.mu.Lock()
+				e.lastRenew = now
+				e.mu.Unlock()
+				continue
+			}
+			if err == nil {
+				e.stepDown("the lease is held by another replica")
+				return
+			}
+			log.Warnf("failed to renew the %s leader lease: %v", e.role, err)
+			if !e.IsLeader() {
+				e.stepDown("the lease expired without being renewed")
+				return
+			}
+		}
+	}
+}
+
+func (e *Elector) stepDown(reason string) {
+	e.mu.Lock()
+	e.leading = false
+	e.mu.Unlock()
+	log.Warnf("%s leadership lost: %s", e.role, reason)
+	metrics.RecordLeader(e.role, false)
+	close(e.lost)
+}
+
+func (e *Elector) release() {
+	e.mu.Lock()
+	e.leading = false
+	e.mu.Unlock()
+	metrics.RecordLeader(e.role, false)
+	err := e.storage.ReleaseLease(context.Background(), e.key, e.holder)
+	if err != nil {
+		log.Warnf("failed to release the %s leader lease: %v", e.role, err)
+	}
+}
@@ -0,0 +1,154 @@
+package leaderelection
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memLease struct {
+	holder  string
+	expires time.Time
+}
+
+// memLeaseStorage is an in-memory lease storage with the same semantics as the redis one
+type memLeaseStorage struct {
+	mu     sync.Mutex
+	leases map[string]memLease
+	tokens map[string]int64
+	fail   bool
+}
+
+func newMemLeaseStorage() *memLeaseStorage {
+	return &memLeaseStorage{leases: make(map[string]memLease), tokens: make(map[string]int64)}
+}
+
+func (s *memLeaseStorage) AcquireLease(_ context.Context, key string, holder string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.fail {
+		return false, errors.New("storage down")
+	}
+	if l, ok := s.leases[key]; ok && time.Now().Before(l.expires) {
+		return false, nil
+	}
+	s.leases[key] = memLease{holder: holder, expires: time.Now().Add(ttl)}
+	return true, nil
+}
+
+func (s *memLeaseStorage) RenewLease(_ context.Context, key string, holder string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.fail {
+		return false, errors.New("storage down")
+	}
+	l, ok := s.leases[key]
+	if !ok || l.holder != holder || time.Now().After(l.expires) {
+		return false, nil
+	}
+	s.leases[key] = memLease{holder: holder, expires: time.Now().Add(ttl)}
+	return true, nil
+}
+
+func (s *memLeaseStorage) ReleaseLease(_ context.Context, key string, holder string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if l, ok := s.leases[key]; ok && l.holder == holder {
+		delete(s.leases, key)
+	}
+	return nil
+}
+
+func (s *memLeaseStorage) IncrFencingToken(_ context.Context, key string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.fail {
+		return 0, errors.New("storage down")
+	}
+	s.tokens[key]++
+	return s.tokens[key], nil
+}
+
+func (s *memLeaseStorage) setFail(fail bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fail = fail
+}
+
+func testConfig() Config {
+	return Config{
+		Enabled:       true,
+		Key:           "test",
+		LeaseDuration: types.NewDuration(200 * time.Millisecond),
+		RenewInterval: types.NewDuration(50 * time.Millisecond),
+		RetryInterval: types.NewDuration(20 * time.Millisecond),
+	}
+}
+
+func TestElectorTakeover(t *testing.T) {
+	storage := newMemLeaseStorage()
+	leaderCtx, stopLeader := context.WithCancel(context.Background())
+	leader := NewElector(testConfig(), "tasks", storage)
+	require.NoError(t, leader.Campaign(leaderCtx))
+	assert.True(t, leader.IsLeader())
+
+	// the standby replica can't be elected while the leader renews the lease
+	standby := NewElector(testConfig(), "tasks", storage)
+	ctx, cancel := context.WithTimeout(context.Background(), 400*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, standby.Campaign(ctx), context.DeadlineExceeded)
+	assert.False(t, standby.IsLeader())
+
+	// the lease is released when the leader stops, the standby replica takes over
+	stopLeader()
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.NoError(t, standby.Campaign(ctx))
+	assert.True(t, standby.IsLeader())
+	assert.Greater(t, standby.FencingToken(), leader.FencingToken())
+}
+
+func TestElectorStepsDown(t *testing.T) {
+	storage := newMemLeaseStorage()
+	elector := NewElector(testConfig(), "tasks", storage)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, elector.Campaign(ctx))
+
+	// the leadership ends when the lease can't be renewed before it expires
+	storage.setFail(true)
+	select {
+	case <-elector.Lost():
+	case <-time.After(time.Second):
+		t.Fatal("the leadership was not lost")
+	}
+	assert.False(t, elector.IsLeader())
+}
+
+func TestElectorLeaseTakenByOther(t *testing.T) {
+	storage := newMemLeaseStorage()
+	elector := NewElector(testConfig(), "tasks", storage)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, elector.Campaign(ctx))
+
+	// a newer leader holds the lease, the stale one must not renew it
+	storage.mu.Lock()
+	storage.leases[elector.key] = memLease{holder: "other", expires: time.Now().Add(time.Hour)}
+	storage.mu.Unlock()
+	select {
+	case <-elector.Lost():
+	case <-time.After(time.Second):
+		t.Fatal("the leadership was not lost")
+	}
+	assert.False(t, elector.IsLeader())
+	storage.mu.Lock()
+	assert.Equal(t, "other", storage.leases[elector.key].holder)
+	storage.mu.Unlock()
+}
@@ -1,9 +1,15 @@
 package messagepush
 
+import "github.com/0xPolygonHermez/zkevm-bridge-service/messagequeue"
+
 // Config is the config for the Kafka producer
 type Config struct {
 	Enabled bool `mapstructure:"Enabled"`
 
+	// Backend is the message queue the messages are pushed to: kafka, redis (Redis Streams) or nats. The topic
+	// is the name of the stream or the subject, kafka by default
+	Backend string `mapstructure:"Backend"`
+
 	// Due to some restriction in dev environment, bridge service cannot push message to okc-basic kafka
 	// We need to implement a "fake producer" flow to let okc-basic get the events from bridge-service
 	UseFakeProducer bool `mapstructure:"UseFakeProducer"`
@@ -21,4 +27,26 @@ type Config struct {
 
 	// RootCAPath points to the CA cert used for authentication
 	RootCAPath string `mapstructure:"RootCAPath"`
+
+	// RedisStreams and NATS are the connections of the other backends
+	RedisStreams messagequeue.RedisStreamsConfig `mapstructure:"RedisStreams"`
+	NATS         messagequeue.NATSConfig         `mapstructure:"NATS"`
+
+	// StreamEnabled publishes the transaction updates through redis to the API instances, which stream them to
+	// the clients subscribed with SubscribeTransactions. It doesn't need the Kafka producer to be enabled
+	StreamEnabled bool `mapstructure:"StreamEnabled"`
+}
+
+func (cfg Config) messageQueueConfig() messagequeue.Config {
+	return messagequeue.Config{
+		Backend: cfg.Backend,
+		Kafka: messagequeue.KafkaConfig{
+			Brokers:    cfg.Brokers,
+			Username:   cfg.Username,
+			Password:   cfg.Password,
+			RootCAPath: cfg.RootCAPath,
+		},
+		RedisStreams: cfg.RedisStreams,
+		NATS:         cfg.NATS,
+	}
 }
@@ -79,7 +79,7 @@ func (p *fakeProducer) PushTransactionUpdate(tx *pb.Transaction, optFns ...produ
 	msg := &PushMessage{
 		BizCode:       BizCodeBridgeOrder,
 		WalletAddress: tx.GetDestAddr(),
-		RequestID:     utils.GenerateTraceID(),
+		RequestID:     getRequestID(optFns),
 		PushContent:   fmt.Sprintf("[%v]", string(b)),
 		Time:          time.Now().UnixMilli(),
 	}
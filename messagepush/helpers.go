@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils"
 	"github.com/pkg/errors"
 )
 
@@ -24,3 +25,14 @@ func convertMsgToString(msg interface{}) (string, error) {
 	}
 	return msgString, nil
 }
+
+func getRequestID(optFns []produceOptFunc) string {
+	opts := &produceOptions{}
+	for _, f := range optFns {
+		f(opts)
+	}
+	if opts.requestID != "" {
+		return opts.requestID
+	}
+	return utils.GenerateTraceID()
+}
@@ -1,25 +1,24 @@
 package messagepush
 
 import (
-	"crypto/tls"
-	"crypto/x509"
+	"context"
 	"encoding/json"
 	"fmt"
-	"os"
 	"time"
 
 	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/config/apolloconfig"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/messagequeue"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/utils"
-	"github.com/IBM/sarama"
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
 type produceOptions struct {
-	topic   string
-	pushKey string
+	topic     string
+	pushKey   string
+	requestID string
 }
 
 type produceOptFunc func(opts *produceOptions)
@@ -36,6 +35,14 @@ func WithPushKey(key string) produceOptFunc {
 	}
 }
 
+// WithRequestID sets the request id of the transaction update push message, so the consumers can drop the
+// duplicated ones. A random one is generated by default
+func WithRequestID(id string) produceOptFunc {
+	return func(opts *produceOptions) {
+		opts.requestID = id
+	}
+}
+
 type KafkaProducer interface {
 	Produce(msg interface{}, optFns ...produceOptFunc) error
 	PushTransactionUpdate(tx *pb.Transaction, optFns ...produceOptFunc) error
@@ -47,45 +54,22 @@ type KafkaProducer interface {
 }
 
 type kafkaProducerImpl struct {
-	producer       sarama.SyncProducer
+	producer       messagequeue.Producer
 	defaultTopic   apolloconfig.Entry[string]
 	defaultPushKey apolloconfig.Entry[string]
 	bizCode        apolloconfig.Entry[string]
 }
 
+// NewKafkaProducer creates the producer of the push messages on the message queue backend of the config
 func NewKafkaProducer(cfg Config) (KafkaProducer, error) {
 	if cfg.UseFakeProducer {
 		log.Infof("start to init fake kafka producer!")
 		return newFakeProducer(cfg), nil
 	}
-	log.Infof("start to init real kafka producer!")
-	config := sarama.NewConfig()
-	config.Producer.Return.Successes = true
-
-	// Enable SASL authentication
-	if cfg.Username != "" && cfg.Password != "" && cfg.RootCAPath != "" {
-		config.Net.SASL.Enable = true
-		config.Net.SASL.User = cfg.Username
-		config.Net.SASL.Password = cfg.Password
-
-		// Read the CA cert from file
-		rootCA, err := os.ReadFile(cfg.RootCAPath)
-		if err != nil {
-			return nil, errors.Wrap(err, "NewKafkaProducer read root CA cert fail")
-		}
-
-		caCertPool := x509.NewCertPool()
-		if ok := caCertPool.AppendCertsFromPEM([]byte(rootCA)); !ok {
-			return nil, errors.New("NewKafkaProducer caCertPool.AppendCertsFromPEM")
-		}
-
-		config.Net.TLS.Enable = true
-		config.Net.TLS.Config = &tls.Config{RootCAs: caCertPool, InsecureSkipVerify: true} // #nosec
-	}
-
-	producer, err := sarama.NewSyncProducer(cfg.Brokers, config)
+	log.Infof("start to init real %v producer!", cfg.Backend)
+	producer, err := messagequeue.NewProducer(cfg.messageQueueConfig())
 	if err != nil {
-		return nil, errors.Wrap(err, "NewKafkaProducer: NewSyncProducer error")
+		return nil, errors.Wrap(err, "NewKafkaProducer error")
 	}
 	return &kafkaProducerImpl{
 		producer:       producer,
@@ -95,7 +79,7 @@ func NewKafkaProducer(cfg Config) (KafkaProducer, error) {
 	}, nil
 }
 
-// Produce send a message to the Kafka topic
+// Produce send a message to the topic
 // msg should be either a string or an object
 // If msg is an object, it will be encoded to JSON before being sent
 func (p *kafkaProducerImpl) Produce(msg interface{}, optFns ...produceOptFunc) error {
@@ -116,22 +100,17 @@ func (p *kafkaProducerImpl) Produce(msg interface{}, optFns ...produceOptFunc) e
 		return err
 	}
 
-	produceMsg := &sarama.ProducerMessage{
-		Topic: opts.topic,
-		Value: sarama.StringEncoder(msgString),
-	}
-	if opts.pushKey != "" {
-		produceMsg.Key = sarama.StringEncoder(opts.pushKey)
-	}
-
 	// Send message to the topic
-	partition, offset, err := p.producer.SendMessage(produceMsg)
-
+	err = p.producer.Send(context.Background(), &messagequeue.Message{
+		Topic: opts.topic,
+		Key:   opts.pushKey,
+		Value: []byte(msgString),
+	})
 	if err != nil {
-		return errors.Wrap(err, "kafka SendMessage error")
+		return err
 	}
 
-	log.Debugf("Produced to Kafka: topic[%v] msg[%v] partition[%v] offset[%v]", opts.topic, msgString, partition, offset)
+	log.Debugf("Produced: topic[%v] msg[%v]", opts.topic, msgString)
 	return nil
 }
 
@@ -161,7 +140,7 @@ func (p *kafkaProducerImpl) PushTransactionUpdate(tx *pb.Transaction, optFns ...
 	msg := &PushMessage{
 		BizCode:       p.bizCode.Get(),
 		WalletAddress: tx.GetDestAddr(),
-		RequestID:     utils.GenerateTraceID(),
+		RequestID:     getRequestID(optFns),
 		PushContent:   fmt.Sprintf("[%v]", string(b)),
 		Time:          time.Now().UnixMilli(),
 	}
@@ -0,0 +1,170 @@
+package messagepush
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	publishTimeout           = 3 * time.Second
+	subscriptionBufferSize   = 16
+	maxTransactionStreamSize = 10000
+)
+
+// TransactionPublisher receives the transaction updates besides Kafka, like the API streams or the webhooks
+type TransactionPublisher interface {
+	PublishTransactionUpdate(ctx context.Context, tx *pb.Transaction) error
+}
+
+// streamProducer publishes the transaction updates to the stream and the webhooks, besides pushing them to Kafka
+type streamProducer struct {
+	producer   KafkaProducer
+	publishers []TransactionPublisher
+}
+
+// NewStreamProducer returns a producer that publishes the transaction updates to the publishers before pushing
+// them with the producer, which is nil when the Kafka producer is disabled
+func NewStreamProducer(producer KafkaProducer, publishers ...TransactionPublisher) KafkaProducer {
+	return &streamProducer{
+		producer:   producer,
+		publishers: publishers,
+	}
+}
+
+func (p *streamProducer) Produce(msg interface{}, optFns ...produceOptFunc) error {
+	if p.producer == nil {
+		return nil
+	}
+	return p.producer.Produce(msg, optFns...)
+}
+
+func (p *streamProducer) PushTransactionUpdate(tx *pb.Transaction, optFns ...produceOptFunc) error {
+	if tx == nil {
+		return nil
+	}
+	// The Kafka producer converts the chain ids in place, the publishers get the ones returned by the API
+	streamTx := proto.Clone(tx).(*pb.Transaction)
+	for _, publisher := range p.publishers {
+		ctx, cancel := context.WithTimeout(context.Background(), publishTimeout)
+		if err := publisher.PublishTransactionUpdate(ctx, streamTx); err != nil {
+			log.Errorf("PublishTransactionUpdate error: %v, txHash: %v", err, tx.TxHash)
+		}
+		cancel()
+	}
+
+	if p.producer == nil {
+		return nil
+	}
+	return p.producer.PushTransactionUpdate(tx, optFns...)
+}
+
+func (p *streamProducer) Close() error {
+	if p.producer == nil {
+		return nil
+	}
+	return p.producer.Close()
+}
+
+func (p *streamProducer) GetFakeMessages(topic string) []string {
+	if p.producer == nil {
+		return nil
+	}
+	return p.producer.GetFakeMessages(topic)
+}
+
+// TransactionFilter selects the transaction updates of a subscription, by destination address or else by
+// deposit or claim tx hash
+type TransactionFilter struct {
+	DestAddr string
+	TxHash   string
+}
+
+func (f TransactionFilter) match(tx *pb.Transaction) bool {
+	if f.DestAddr != "" {
+		return strings.EqualFold(tx.DestAddr, f.DestAddr)
+	}
+	return f.TxHash != "" && (strings.EqualFold(tx.TxHash, f.TxHash) || strings.EqualFold(tx.ClaimTxHash, f.TxHash))
+}
+
+// TransactionSubscription receives the transaction updates matching its filter
+type TransactionSubscription struct {
+	filter TransactionFilter
+	ch     chan *pb.Transaction
+	hub    *TransactionHub
+}
+
+// Updates returns the channel of the transaction updates, it's closed when the subscription is closed
+func (s *TransactionSubscription) Updates() <-chan *pb.Transaction {
+	return s.ch
+}
+
+// Close removes the subscription from the hub
+func (s *TransactionSubscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	if _, ok := s.hub.subscriptions[s]; ok {
+		delete(s.hub.subscriptions, s)
+		close(s.ch)
+	}
+}
+
+// TransactionHub fans out the transaction updates to the subscriptions of the API instance
+type TransactionHub struct {
+	mu            sync.RWMutex
+	subscriptions map[*TransactionSubscription]struct{}
+}
+
+// NewTransactionHub creates a hub without subscriptions
+func NewTransactionHub() *TransactionHub {
+	return &TransactionHub{
+		subscriptions: make(map[*TransactionSubscription]struct{}),
+	}
+}
+
+// Subscribe returns a subscription to the transaction updates matching the filter, false if the hub has
+// reached the maximum number of subscriptions. The subscription must be closed when it's not used anymore
+func (h *TransactionHub) Subscribe(filter TransactionFilter) (*TransactionSubscription, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.subscriptions) >= maxTransactionStreamSize {
+		return nil, false
+	}
+	sub := &TransactionSubscription{
+		filter: filter,
+		ch:     make(chan *pb.Transaction, subscriptionBufferSize),
+		hub:    h,
+	}
+	h.subscriptions[sub] = struct{}{}
+	return sub, true
+}
+
+// Publish sends the transaction update to the matching subscriptions. It never blocks, the update is dropped
+// for the subscriptions that are not reading theirs
+func (h *TransactionHub) Publish(tx *pb.Transaction) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for sub := range h.subscriptions {
+		if !sub.filter.match(tx) {
+			continue
+		}
+		select {
+		case sub.ch <- tx:
+		default:
+			log.Warnf("transaction subscription is full, dropping the update of txHash: %v, status: %v", tx.TxHash, tx.Status)
+		}
+	}
+}
+
+// Run publishes the updates until the channel is closed
+func (h *TransactionHub) Run(updates <-chan *pb.Transaction) {
+	for tx := range updates {
+		h.Publish(tx)
+	}
+	log.Info("transaction updates channel closed, the transaction hub stopped")
+}
@@ -0,0 +1,71 @@
+package messagepush
+
+import (
+	"context"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memPublisher struct {
+	txs []*pb.Transaction
+}
+
+func (p *memPublisher) PublishTransactionUpdate(ctx context.Context, tx *pb.Transaction) error {
+	p.txs = append(p.txs, tx)
+	return nil
+}
+
+func TestTransactionHub(t *testing.T) {
+	hub := NewTransactionHub()
+	byAddr, ok := hub.Subscribe(TransactionFilter{DestAddr: "0xABC"})
+	require.True(t, ok)
+	byHash, ok := hub.Subscribe(TransactionFilter{TxHash: "0x02"})
+	require.True(t, ok)
+
+	deposit := &pb.Transaction{TxHash: "0x01", DestAddr: "0xabc", Status: uint32(pb.TransactionStatus_TX_CREATED)}
+	claim := &pb.Transaction{TxHash: "0x03", ClaimTxHash: "0x02", DestAddr: "0xdef", Status: uint32(pb.TransactionStatus_TX_CLAIMED)}
+	hub.Publish(deposit)
+	hub.Publish(claim)
+	hub.Publish(&pb.Transaction{TxHash: "0x04"})
+
+	// the addresses and hashes are compared case insensitively, the claim tx hash matches too
+	require.Len(t, byAddr.Updates(), 1)
+	assert.Equal(t, deposit, <-byAddr.Updates())
+	require.Len(t, byHash.Updates(), 1)
+	assert.Equal(t, claim, <-byHash.Updates())
+
+	byAddr.Close()
+	byAddr.Close()
+	_, open := <-byAddr.Updates()
+	assert.False(t, open)
+	hub.Publish(deposit)
+	assert.Len(t, hub.subscriptions, 1)
+
+	// a subscription that doesn't read its updates never blocks the hub
+	for i := 0; i < subscriptionBufferSize+1; i++ {
+		hub.Publish(claim)
+	}
+	assert.Len(t, byHash.Updates(), subscriptionBufferSize)
+}
+
+func TestStreamProducer(t *testing.T) {
+	publisher := &memPublisher{}
+	producer := NewStreamProducer(newFakeProducer(Config{Topic: "topic"}), publisher)
+	tx := &pb.Transaction{TxHash: "0x01", DestAddr: "0xabc", FromChainId: 1, Status: uint32(pb.TransactionStatus_TX_CREATED)}
+	require.NoError(t, producer.PushTransactionUpdate(tx))
+	require.NoError(t, producer.PushTransactionUpdate(nil))
+
+	require.Len(t, publisher.txs, 1)
+	assert.Equal(t, "0x01", publisher.txs[0].TxHash)
+	assert.Equal(t, uint32(1), publisher.txs[0].FromChainId)
+	assert.Len(t, producer.GetFakeMessages("topic"), 1)
+
+	// the updates are published even if the Kafka producer is disabled
+	producer = NewStreamProducer(nil, publisher)
+	require.NoError(t, producer.PushTransactionUpdate(tx))
+	assert.Len(t, publisher.txs, 2)
+	assert.NoError(t, producer.Close())
+}
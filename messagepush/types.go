@@ -1,7 +1,8 @@
 package messagepush
 
 const (
-	BizCodeBridgeOrder = "x1_bridge_order"
+	BizCodeBridgeOrder        = "x1_bridge_order"
+	BizCodeClaimSignerBalance = "x1_bridge_claim_signer_balance"
 )
 
 type PushMessage struct {
@@ -11,3 +12,13 @@ type PushMessage struct {
 	PushContent   string `json:"pushContent"`
 	Time          int64  `json:"time"`
 }
+
+// ClaimSignerBalanceAlert is the content of the alert sent when the balance of a claim signer
+// drops below the configured threshold
+type ClaimSignerBalanceAlert struct {
+	NetworkID  uint   `json:"networkId"`
+	Address    string `json:"address"`
+	Balance    string `json:"balance"`
+	MinBalance string `json:"minBalance"`
+	Time       int64  `json:"time"`
+}
@@ -0,0 +1,66 @@
+package messagequeue
+
+const (
+	// BackendKafka sends the messages to Kafka topics, it's the default backend
+	BackendKafka = "kafka"
+	// BackendRedisStreams sends the messages to Redis streams named as the topics
+	BackendRedisStreams = "redis"
+	// BackendNATS sends the messages to NATS subjects named as the topics
+	BackendNATS = "nats"
+)
+
+// Config selects the message queue backend and its connection
+type Config struct {
+	// Backend is kafka, redis or nats, kafka if it's empty
+	Backend      string
+	Kafka        KafkaConfig
+	RedisStreams RedisStreamsConfig
+	NATS         NATSConfig
+}
+
+// KafkaConfig is the connection to the Kafka brokers
+type KafkaConfig struct {
+	// Brokers is the list of address of the kafka brokers
+	Brokers []string
+
+	// Username and Password are used for SASL_SSL authentication
+	Username string
+	Password string
+
+	// RootCAPath points to the CA cert used for authentication
+	RootCAPath string
+}
+
+// RedisStreamsConfig is the connection to the Redis server of the streams
+type RedisStreamsConfig struct {
+	// If this is true, will use ClusterClient
+	IsClusterMode bool `mapstructure:"IsClusterMode"`
+
+	// Host:Port address
+	Addrs []string `mapstructure:"Addrs"`
+
+	// Username and Password for ACL
+	Username string `mapstructure:"Username"`
+	Password string `mapstructure:"Password"`
+
+	// DB index
+	DB int `mapstructure:"DB"`
+
+	// MaxLen is the approximate max number of messages kept in each stream, 0 keeps all of them
+	MaxLen int64 `mapstructure:"MaxLen"`
+}
+
+// NATSConfig is the connection to the NATS servers
+type NATSConfig struct {
+	// URL is the comma separated list of the NATS server urls
+	URL string `mapstructure:"URL"`
+
+	// Token, or Username and Password, are used for authentication
+	Token    string `mapstructure:"Token"`
+	Username string `mapstructure:"Username"`
+	Password string `mapstructure:"Password"`
+
+	// JetStream publishes the messages with acknowledgement and consumes them from durable consumers. The
+	// streams of the subjects must exist. It's required to produce, the consumers can use NATS core without it
+	JetStream bool `mapstructure:"JetStream"`
+}
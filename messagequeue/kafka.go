@@ -0,0 +1,158 @@
+package messagequeue
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/IBM/sarama"
+	"github.com/pkg/errors"
+)
+
+type kafkaProducer struct {
+	producer sarama.SyncProducer
+}
+
+// NewKafkaProducer creates a producer sending the messages to Kafka
+func NewKafkaProducer(cfg KafkaConfig) (Producer, error) {
+	config, err := newSaramaConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	config.Producer.Return.Successes = true
+
+	producer, err := sarama.NewSyncProducer(cfg.Brokers, config)
+	if err != nil {
+		return nil, errors.Wrap(err, "NewKafkaProducer: NewSyncProducer error")
+	}
+	return &kafkaProducer{producer: producer}, nil
+}
+
+func (p *kafkaProducer) Send(ctx context.Context, msg *Message) error {
+	produceMsg := &sarama.ProducerMessage{
+		Topic: msg.Topic,
+		Value: sarama.ByteEncoder(msg.Value),
+	}
+	if msg.Key != "" {
+		produceMsg.Key = sarama.StringEncoder(msg.Key)
+	}
+
+	partition, offset, err := p.producer.SendMessage(produceMsg)
+	if err != nil {
+		return errors.Wrap(err, "kafka SendMessage error")
+	}
+	log.Debugf("Produced to Kafka: topic[%v] partition[%v] offset[%v]", msg.Topic, partition, offset)
+	return nil
+}
+
+func (p *kafkaProducer) Close() error {
+	return p.producer.Close()
+}
+
+type kafkaConsumer struct {
+	topics []string
+	client sarama.ConsumerGroup
+}
+
+// NewKafkaConsumer creates a consumer of a Kafka consumer group
+func NewKafkaConsumer(cfg KafkaConfig, consumerCfg ConsumerConfig) (Consumer, error) {
+	config, err := newSaramaConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	config.Consumer.Offsets.Initial = sarama.OffsetNewest
+	if consumerCfg.FromOldest {
+		config.Consumer.Offsets.Initial = sarama.OffsetOldest
+	}
+
+	client, err := sarama.NewConsumerGroup(cfg.Brokers, consumerCfg.Group, config)
+	if err != nil {
+		return nil, errors.Wrap(err, "kafka consumer group init error")
+	}
+	return &kafkaConsumer{
+		topics: consumerCfg.Topics,
+		client: client,
+	}, nil
+}
+
+func (c *kafkaConsumer) Consume(ctx context.Context, handler Handler) error {
+	for {
+		// Consume returns when the partitions are rebalanced, it's called again to join the new session
+		err := c.client.Consume(ctx, c.topics, &kafkaGroupHandler{handler: handler})
+		if err != nil {
+			return errors.Wrap(err, "kafka consumer error")
+		}
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *kafkaConsumer) Close() error {
+	return c.client.Close()
+}
+
+// kafkaGroupHandler implements sarama.ConsumerGroupHandler, the messages are marked once they're handled
+type kafkaGroupHandler struct {
+	handler Handler
+}
+
+func (h *kafkaGroupHandler) Setup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h *kafkaGroupHandler) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h *kafkaGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for {
+		select {
+		case message, ok := <-claim.Messages():
+			if !ok {
+				log.Info("message channel was closed")
+				return nil
+			}
+			log.Infof("message received topic[%v] partition[%v] offset[%v]", message.Topic, message.Partition, message.Offset)
+			err := h.handler(session.Context(), &Message{
+				Topic: message.Topic,
+				Key:   string(message.Key),
+				Value: message.Value,
+			})
+			if err != nil {
+				log.Errorf("handle kafka message error: %v, topic[%v] offset[%v]", err, message.Topic, message.Offset)
+			}
+			session.MarkMessage(message, "")
+		case <-session.Context().Done():
+			return nil
+		}
+	}
+}
+
+func newSaramaConfig(cfg KafkaConfig) (*sarama.Config, error) {
+	config := sarama.NewConfig()
+
+	// Enable SASL authentication
+	if cfg.Username != "" && cfg.Password != "" && cfg.RootCAPath != "" {
+		config.Net.SASL.Enable = true
+		config.Net.SASL.User = cfg.Username
+		config.Net.SASL.Password = cfg.Password
+
+		// Read the CA cert from file
+		rootCA, err := os.ReadFile(cfg.RootCAPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "kafka: read root CA cert fail")
+		}
+
+		caCertPool := x509.NewCertPool()
+		if ok := caCertPool.AppendCertsFromPEM(rootCA); !ok {
+			return nil, errors.New("kafka: caCertPool.AppendCertsFromPEM")
+		}
+
+		config.Net.TLS.Enable = true
+		config.Net.TLS.Config = &tls.Config{RootCAs: caCertPool, InsecureSkipVerify: true} // #nosec
+	}
+	return config, nil
+}
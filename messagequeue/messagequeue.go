@@ -0,0 +1,69 @@
+package messagequeue
+
+import (
+	"context"
+	"fmt"
+)
+
+// Message is a message of a topic. Key is the partition key of Kafka, it's sent as a field of the Redis stream
+// entries and as a header of the NATS messages
+type Message struct {
+	Topic string
+	Key   string
+	Value []byte
+}
+
+// Producer sends the messages to the topics of the backend, Send returns once the backend has accepted the
+// message
+type Producer interface {
+	Send(ctx context.Context, msg *Message) error
+	Close() error
+}
+
+// Handler handles a consumed message, the message is acknowledged once it returns, whatever the error
+type Handler func(ctx context.Context, msg *Message) error
+
+// Consumer consumes the topics of the backend as a member of a consumer group, each message is handled by
+// one member of the group
+type Consumer interface {
+	// Consume handles the messages until the context is done or the backend fails
+	Consume(ctx context.Context, handler Handler) error
+	Close() error
+}
+
+// ConsumerConfig is the subscription of a consumer
+type ConsumerConfig struct {
+	Topics []string
+	// Group is the consumer group of Kafka and Redis streams, the queue group and durable name of NATS
+	Group string
+	// FromOldest consumes the messages sent before the group was created, otherwise only the new ones are
+	FromOldest bool
+}
+
+// NewProducer creates the producer of the backend
+func NewProducer(cfg Config) (Producer, error) {
+	switch cfg.Backend {
+	case "", BackendKafka:
+		return NewKafkaProducer(cfg.Kafka)
+	case BackendRedisStreams:
+		return NewRedisStreamsProducer(cfg.RedisStreams)
+	case BackendNATS:
+		return NewNATSProducer(cfg.NATS)
+	default:
+		return nil, fmt.Errorf("unknown message queue backend %q", cfg.Backend)
+	}
+}
+
+// NewConsumer creates the consumer of the backend
+func NewConsumer(cfg Config, consumerCfg ConsumerConfig) (Consumer, error) {
+	switch cfg.Backend {
+	case "", BackendKafka:
+		return NewKafkaConsumer(cfg.Kafka, consumerCfg)
+	case BackendRedisStreams:
+		return NewRedisStreamsConsumer(cfg.RedisStreams, consumerCfg)
+	case BackendNATS:
+		return NewNATSConsumer(cfg.NATS, consumerCfg)
+	default:
+		return nil, fmt.Errorf("unknown message queue backend %q", cfg.Backend)
+	}
+}
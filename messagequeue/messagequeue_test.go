@@ -0,0 +1,27 @@
+package messagequeue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBackend(t *testing.T) {
+	_, err := NewProducer(Config{Backend: "pulsar"})
+	require.EqualError(t, err, `unknown message queue backend "pulsar"`)
+	_, err = NewConsumer(Config{Backend: "pulsar"}, ConsumerConfig{})
+	require.EqualError(t, err, `unknown message queue backend "pulsar"`)
+
+	// the backend is selected by the config, the connections of the others are ignored
+	cfg := Config{Backend: BackendRedisStreams, NATS: NATSConfig{URL: "nats://127.0.0.1:4222"}}
+	_, err = NewProducer(cfg)
+	require.EqualError(t, err, "redis streams address is empty")
+	cfg = Config{Backend: BackendNATS, RedisStreams: RedisStreamsConfig{Addrs: []string{"127.0.0.1:6379"}}}
+	_, err = NewConsumer(cfg, ConsumerConfig{})
+	require.EqualError(t, err, "nats url is empty")
+}
+
+func TestNATSDurableName(t *testing.T) {
+	assert.Equal(t, "bridge_explorer_prices__", natsDurableName("bridge", "explorer.prices.>"))
+}
@@ -0,0 +1,154 @@
+package messagequeue
+
+import (
+	"context"
+	"strings"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/nats-io/nats.go"
+	"github.com/pkg/errors"
+)
+
+const natsKeyHeader = "Key"
+
+type natsProducer struct {
+	conn *nats.Conn
+	js   nats.JetStreamContext
+}
+
+// NewNATSProducer creates a producer publishing the messages to NATS JetStream. JetStream is required, since the
+// outbox marks the messages as sent once they're published, and a NATS core message is lost if there's no subscriber
+func NewNATSProducer(cfg NATSConfig) (Producer, error) {
+	if !cfg.JetStream {
+		return nil, errors.New("the nats producer requires JetStream")
+	}
+	conn, js, err := newNATSConn(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &natsProducer{conn: conn, js: js}, nil
+}
+
+func (p *natsProducer) Send(ctx context.Context, msg *Message) error {
+	natsMsg := nats.NewMsg(msg.Topic)
+	natsMsg.Data = msg.Value
+	if msg.Key != "" {
+		natsMsg.Header.Set(natsKeyHeader, msg.Key)
+	}
+
+	ack, err := p.js.PublishMsg(natsMsg, nats.Context(ctx))
+	if err != nil {
+		return errors.Wrap(err, "nats JetStream publish error")
+	}
+	log.Debugf("Produced to NATS JetStream: topic[%v] stream[%v] seq[%v]", msg.Topic, ack.Stream, ack.Sequence)
+	return nil
+}
+
+func (p *natsProducer) Close() error {
+	return p.conn.Drain()
+}
+
+type natsConsumer struct {
+	conn *nats.Conn
+	js   nats.JetStreamContext
+	cfg  ConsumerConfig
+}
+
+// NewNATSConsumer creates a consumer of a NATS queue group. With JetStream, each topic is consumed by a durable
+// consumer named after the group and the topic
+func NewNATSConsumer(cfg NATSConfig, consumerCfg ConsumerConfig) (Consumer, error) {
+	conn, js, err := newNATSConn(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &natsConsumer{conn: conn, js: js, cfg: consumerCfg}, nil
+}
+
+func (c *natsConsumer) Consume(ctx context.Context, handler Handler) error {
+	var subs []*nats.Subscription
+	defer func() {
+		for _, sub := range subs {
+			if err := sub.Unsubscribe(); err != nil {
+				log.Errorf("nats unsubscribe error: %v, topic: %v", err, sub.Subject)
+			}
+		}
+	}()
+
+	for _, topic := range c.cfg.Topics {
+		var (
+			sub *nats.Subscription
+			err error
+		)
+		if c.js != nil {
+			deliver := nats.DeliverNew()
+			if c.cfg.FromOldest {
+				deliver = nats.DeliverAll()
+			}
+			sub, err = c.js.QueueSubscribe(topic, c.cfg.Group, c.handle(ctx, handler),
+				nats.Durable(natsDurableName(c.cfg.Group, topic)), nats.ManualAck(), deliver)
+		} else {
+			sub, err = c.conn.QueueSubscribe(topic, c.cfg.Group, c.handle(ctx, handler))
+		}
+		if err != nil {
+			return errors.Wrap(err, "nats subscribe error, topic: "+topic)
+		}
+		subs = append(subs, sub)
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+// handle returns the callback of a subscription, the JetStream messages are acknowledged once they're handled
+func (c *natsConsumer) handle(ctx context.Context, handler Handler) nats.MsgHandler {
+	return func(natsMsg *nats.Msg) {
+		log.Infof("message received topic[%v]", natsMsg.Subject)
+		err := handler(ctx, &Message{
+			Topic: natsMsg.Subject,
+			Key:   natsMsg.Header.Get(natsKeyHeader),
+			Value: natsMsg.Data,
+		})
+		if err != nil {
+			log.Errorf("handle nats message error: %v, topic[%v]", err, natsMsg.Subject)
+		}
+		if c.js != nil {
+			if err = natsMsg.Ack(); err != nil {
+				log.Errorf("nats ack error: %v, topic[%v]", err, natsMsg.Subject)
+			}
+		}
+	}
+}
+
+func (c *natsConsumer) Close() error {
+	return c.conn.Drain()
+}
+
+func newNATSConn(cfg NATSConfig) (*nats.Conn, nats.JetStreamContext, error) {
+	if cfg.URL == "" {
+		return nil, nil, errors.New("nats url is empty")
+	}
+	var opts []nats.Option
+	if cfg.Token != "" {
+		opts = append(opts, nats.Token(cfg.Token))
+	} else if cfg.Username != "" {
+		opts = append(opts, nats.UserInfo(cfg.Username, cfg.Password))
+	}
+	conn, err := nats.Connect(cfg.URL, opts...)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot connect to nats server")
+	}
+	if !cfg.JetStream {
+		return conn, nil, nil
+	}
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return nil, nil, errors.Wrap(err, "nats JetStream init error")
+	}
+	return conn, js, nil
+}
+
+// natsDurableName returns the durable name of the consumer of the topic, the durable names can't have the
+// subject tokens separators and wildcards
+func natsDurableName(group string, topic string) string {
+	return strings.NewReplacer(".", "_", "*", "_", ">", "_").Replace(group + "_" + topic)
+}
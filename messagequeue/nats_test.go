@@ -0,0 +1,128 @@
+package messagequeue
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeNATSServer speaks enough of the NATS protocol to connect a client, and records the subjects published to it
+type fakeNATSServer struct {
+	listener  net.Listener
+	published chan string
+}
+
+func newFakeNATSServer(t *testing.T) *fakeNATSServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := &fakeNATSServer{listener: listener, published: make(chan string, 10)}
+	t.Cleanup(func() { _ = listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *fakeNATSServer) URL() string {
+	return "nats://" + s.listener.Addr().String()
+}
+
+func (s *fakeNATSServer) serve(conn net.Conn) {
+	defer conn.Close()
+	_, _ = conn.Write([]byte(`INFO {"server_id":"test","version":"2.10.0","headers":true,"max_payload":1048576}` + "\r\n"))
+	reader := bufio.NewReader(conn)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		switch fields[0] {
+		case "PING":
+			_, _ = conn.Write([]byte("PONG\r\n"))
+		case "PUB":
+			size, _ := strconv.Atoi(fields[len(fields)-1])
+			if _, err = io.CopyN(io.Discard, reader, int64(size)+2); err != nil {
+				return
+			}
+			s.published <- fields[1]
+		}
+	}
+}
+
+func TestNewNATSProducerJetStream(t *testing.T) {
+	_, err := NewNATSProducer(NATSConfig{URL: "nats://127.0.0.1:4222"})
+	require.EqualError(t, err, "the nats producer requires JetStream")
+}
+
+func TestNATSAck(t *testing.T) {
+	server := newFakeNATSServer(t)
+	conn, err := nats.Connect(server.URL())
+	require.NoError(t, err)
+	defer conn.Close()
+	js, err := conn.JetStream()
+	require.NoError(t, err)
+	sub, err := conn.SubscribeSync("bridge.test")
+	require.NoError(t, err)
+
+	var handled []*Message
+	handle := func(c *natsConsumer, handlerErr error) {
+		msg := nats.NewMsg("bridge.test")
+		msg.Header.Set(natsKeyHeader, "1")
+		msg.Data = []byte("value")
+		msg.Reply = "$JS.ACK.bridge.durable.1.1.1.0.0"
+		msg.Sub = sub
+		c.handle(context.Background(), func(ctx context.Context, msg *Message) error {
+			handled = append(handled, msg)
+			require.NoError(t, conn.Flush())
+			select {
+			case subject := <-server.published:
+				t.Fatalf("message acknowledged before it's handled: %v", subject)
+			default:
+			}
+			return handlerErr
+		})(msg)
+	}
+
+	// the JetStream messages are acknowledged once they're handled, even if the handler failed
+	consumer := &natsConsumer{conn: conn, js: js}
+	for _, handlerErr := range []error{nil, errors.New("invalid message")} {
+		handle(consumer, handlerErr)
+		select {
+		case subject := <-server.published:
+			assert.Equal(t, "$JS.ACK.bridge.durable.1.1.1.0.0", subject)
+		case <-time.After(5 * time.Second):
+			t.Fatal("message not acknowledged")
+		}
+	}
+	require.Len(t, handled, 2)
+	assert.Equal(t, &Message{Topic: "bridge.test", Key: "1", Value: []byte("value")}, handled[0])
+
+	// the NATS core messages aren't acknowledged
+	handle(&natsConsumer{conn: conn}, nil)
+	require.NoError(t, conn.Flush())
+	select {
+	case subject := <-server.published:
+		t.Fatalf("NATS core message acknowledged: %v", subject)
+	default:
+	}
+}
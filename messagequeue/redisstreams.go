@@ -0,0 +1,173 @@
+package messagequeue
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	redisStreamKeyField   = "key"
+	redisStreamValueField = "value"
+	redisStreamReadCount  = 100
+	redisStreamReadBlock  = 5 * time.Second
+	redisStreamRetryWait  = 3 * time.Second
+)
+
+type redisStreamsProducer struct {
+	client redis.UniversalClient
+	maxLen int64
+}
+
+// NewRedisStreamsProducer creates a producer adding the messages to Redis streams
+func NewRedisStreamsProducer(cfg RedisStreamsConfig) (Producer, error) {
+	client, err := newRedisStreamsClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &redisStreamsProducer{
+		client: client,
+		maxLen: cfg.MaxLen,
+	}, nil
+}
+
+func (p *redisStreamsProducer) Send(ctx context.Context, msg *Message) error {
+	id, err := p.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: msg.Topic,
+		MaxLen: p.maxLen,
+		Approx: p.maxLen > 0,
+		Values: []interface{}{redisStreamKeyField, msg.Key, redisStreamValueField, msg.Value},
+	}).Result()
+	if err != nil {
+		return errors.Wrap(err, "redis XAdd error")
+	}
+	log.Debugf("Produced to Redis stream: topic[%v] id[%v]", msg.Topic, id)
+	return nil
+}
+
+func (p *redisStreamsProducer) Close() error {
+	return p.client.Close()
+}
+
+type redisStreamsConsumer struct {
+	client   redis.UniversalClient
+	cfg      ConsumerConfig
+	consumer string
+}
+
+// NewRedisStreamsConsumer creates a consumer of a Redis streams consumer group. The consumer is named after the
+// host, so a restarted replica handles again the messages it didn't acknowledge
+func NewRedisStreamsConsumer(cfg RedisStreamsConfig, consumerCfg ConsumerConfig) (Consumer, error) {
+	client, err := newRedisStreamsClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	hostname, _ := os.Hostname()
+	return &redisStreamsConsumer{
+		client:   client,
+		cfg:      consumerCfg,
+		consumer: hostname,
+	}, nil
+}
+
+func (c *redisStreamsConsumer) Consume(ctx context.Context, handler Handler) error {
+	start := "$"
+	if c.cfg.FromOldest {
+		start = "0"
+	}
+	for _, topic := range c.cfg.Topics {
+		err := c.client.XGroupCreateMkStream(ctx, topic, c.cfg.Group, start).Err()
+		if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+			return errors.Wrap(err, fmt.Sprintf("redis XGroupCreate error, stream: %v", topic))
+		}
+	}
+
+	// The streams are read separately, they may be in different slots of a cluster
+	var wg sync.WaitGroup
+	for _, topic := range c.cfg.Topics {
+		wg.Add(1)
+		go func(topic string) {
+			defer wg.Done()
+			c.consumeStream(ctx, topic, handler)
+		}(topic)
+	}
+	wg.Wait()
+	return ctx.Err()
+}
+
+// consumeStream reads the pending messages of the consumer first, then the new ones
+func (c *redisStreamsConsumer) consumeStream(ctx context.Context, topic string, handler Handler) {
+	id := "0"
+	for ctx.Err() == nil {
+		streams, err := c.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    c.cfg.Group,
+			Consumer: c.consumer,
+			Streams:  []string{topic, id},
+			Count:    redisStreamReadCount,
+			Block:    redisStreamReadBlock,
+		}).Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		} else if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Errorf("redis XReadGroup error: %v, stream: %v", err, topic)
+			time.Sleep(redisStreamRetryWait)
+			continue
+		}
+		for _, stream := range streams {
+			if id != ">" && len(stream.Messages) == 0 {
+				id = ">"
+			}
+			for _, message := range stream.Messages {
+				log.Infof("message received topic[%v] id[%v]", topic, message.ID)
+				key, _ := message.Values[redisStreamKeyField].(string)
+				value, _ := message.Values[redisStreamValueField].(string)
+				err = handler(ctx, &Message{Topic: topic, Key: key, Value: []byte(value)})
+				if err != nil {
+					log.Errorf("handle redis stream message error: %v, topic[%v] id[%v]", err, topic, message.ID)
+				}
+				if err = c.client.XAck(ctx, topic, c.cfg.Group, message.ID).Err(); err != nil {
+					log.Errorf("redis XAck error: %v, topic[%v] id[%v]", err, topic, message.ID)
+				}
+			}
+		}
+	}
+}
+
+func (c *redisStreamsConsumer) Close() error {
+	return c.client.Close()
+}
+
+func newRedisStreamsClient(cfg RedisStreamsConfig) (redis.UniversalClient, error) {
+	if len(cfg.Addrs) == 0 {
+		return nil, errors.New("redis streams address is empty")
+	}
+	var client redis.UniversalClient
+	if cfg.IsClusterMode {
+		client = redis.NewClusterClient(&redis.ClusterOptions{
+			Addrs:    cfg.Addrs,
+			Username: cfg.Username,
+			Password: cfg.Password,
+		})
+	} else {
+		client = redis.NewClient(&redis.Options{
+			Addr:     cfg.Addrs[0],
+			Username: cfg.Username,
+			Password: cfg.Password,
+			DB:       cfg.DB,
+		})
+	}
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		return nil, errors.Wrap(err, "cannot connect to redis server")
+	}
+	return client, nil
+}
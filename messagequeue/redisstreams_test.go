@@ -0,0 +1,106 @@
+package messagequeue
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedisStreamsConsume(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	server := miniredis.RunT(t)
+	cfg := RedisStreamsConfig{Addrs: []string{server.Addr()}}
+	consumerCfg := ConsumerConfig{Topics: []string{"bridge.test"}, Group: "bridge", FromOldest: true}
+
+	producer, err := NewRedisStreamsProducer(cfg)
+	require.NoError(t, err)
+	defer producer.Close()
+	require.NoError(t, producer.Send(ctx, &Message{Topic: "bridge.test", Key: "1", Value: []byte("pending")}))
+
+	// a previous run of the consumer read the first message but stopped before acknowledging it
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	defer client.Close()
+	hostname, _ := os.Hostname()
+	require.NoError(t, client.XGroupCreate(ctx, "bridge.test", "bridge", "0").Err())
+	require.NoError(t, client.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group:    "bridge",
+		Consumer: hostname,
+		Streams:  []string{"bridge.test", ">"},
+	}).Err())
+	require.NoError(t, producer.Send(ctx, &Message{Topic: "bridge.test", Key: "2", Value: []byte("new")}))
+
+	consumer, err := NewRedisStreamsConsumer(cfg, consumerCfg)
+	require.NoError(t, err)
+	var (
+		mu       sync.Mutex
+		received []*Message
+	)
+	done := make(chan error)
+	go func() {
+		done <- consumer.Consume(ctx, func(ctx context.Context, msg *Message) error {
+			mu.Lock()
+			defer mu.Unlock()
+			received = append(received, msg)
+			return nil
+		})
+	}()
+
+	// the pending message is handled again before the new one, and both are acknowledged once handled
+	require.Eventually(t, func() bool {
+		pending, err := client.XPending(ctx, "bridge.test", "bridge").Result()
+		require.NoError(t, err)
+		mu.Lock()
+		defer mu.Unlock()
+		return len(received) == 2 && pending.Count == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, &Message{Topic: "bridge.test", Key: "1", Value: []byte("pending")}, received[0])
+	assert.Equal(t, &Message{Topic: "bridge.test", Key: "2", Value: []byte("new")}, received[1])
+
+	cancel()
+	require.NoError(t, consumer.Close())
+	require.ErrorIs(t, <-done, context.Canceled)
+}
+
+func TestRedisStreamsAckFailedMessage(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	server := miniredis.RunT(t)
+	cfg := RedisStreamsConfig{Addrs: []string{server.Addr()}}
+
+	producer, err := NewRedisStreamsProducer(cfg)
+	require.NoError(t, err)
+	defer producer.Close()
+	consumer, err := NewRedisStreamsConsumer(cfg, ConsumerConfig{Topics: []string{"bridge.test"}, Group: "bridge", FromOldest: true})
+	require.NoError(t, err)
+	handled := make(chan struct{}, 1)
+	done := make(chan error)
+	go func() {
+		done <- consumer.Consume(ctx, func(ctx context.Context, msg *Message) error {
+			handled <- struct{}{}
+			return context.DeadlineExceeded
+		})
+	}()
+	require.NoError(t, producer.Send(ctx, &Message{Topic: "bridge.test", Value: []byte("invalid")}))
+	<-handled
+
+	// a message the handler failed is acknowledged too, so it doesn't block the stream
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	defer client.Close()
+	require.Eventually(t, func() bool {
+		pending, err := client.XPending(ctx, "bridge.test", "bridge").Result()
+		require.NoError(t, err)
+		return pending.Count == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+	require.NoError(t, consumer.Close())
+	require.ErrorIs(t, <-done, context.Canceled)
+}
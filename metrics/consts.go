@@ -38,11 +38,32 @@ const (
 	metricMonitoredTxsPendingCount = prefixMonitoredTxs + "pending_count"
 	metricMonitoredTxsResultCount  = prefixMonitoredTxs + "result_count"
 	metricMonitoredTxsDuration     = prefixMonitoredTxs + "duration_sec"
+	metricMonitoredTxsEscalation   = prefixMonitoredTxs + "escalation_count"
+	metricMonitoredTxsSimRevert    = prefixMonitoredTxs + "simulation_revert_count"
+	metricMonitoredTxsPolicyReject = prefixMonitoredTxs + "policy_reject_count"
+	metricMonitoredTxsRateLimited  = prefixMonitoredTxs + "rate_limited_count"
+	metricMonitoredTxsGlobalBudget = prefixMonitoredTxs + "global_budget_used"
 	labelStatus                    = "status"
+	labelReason                    = "reason"
+	labelRule                      = "rule"
+	labelScope                     = "scope"
+
+	prefixClaimSigner        = prefix + "claim_signer_"
+	metricClaimSignerBalance = prefixClaimSigner + "balance"
+	labelAddress             = "address"
+
+	metricLeader = prefix + "leader"
+	labelRole    = "role"
 
 	prefixSynchronizer           = prefix + "synchronizer_"
 	metricSynchronizerEventCount = prefixSynchronizer + "event_count"
 	metricLastSyncedBlockNum     = prefixSynchronizer + "last_synced_block_num"
 	metricLatestBlockNum         = prefixSynchronizer + "latest_block_num"
 	labelEventType               = "type"
+
+	prefixPriceSource          = prefix + "price_source_"
+	metricPriceSourceHealthy   = prefixPriceSource + "healthy"
+	metricPriceSourceErrors    = prefixPriceSource + "error_count"
+	metricPriceSourceStaleness = prefixPriceSource + "stale_price_count"
+	labelSource                = "source"
 )
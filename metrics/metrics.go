@@ -44,9 +44,19 @@ func initMetrics(c Config) {
 		ConstLabels: constLabels,
 		Buckets:     []float64{0.5, 1, 2.5, 5, 10, 20, 30, 60, 100, 500, 1000},
 	})
+	registerCounter(prometheus.CounterOpts{Name: metricMonitoredTxsEscalation, ConstLabels: constLabels})
+	registerCounter(prometheus.CounterOpts{Name: metricMonitoredTxsSimRevert, ConstLabels: constLabels}, labelReason)
+	registerCounter(prometheus.CounterOpts{Name: metricMonitoredTxsPolicyReject, ConstLabels: constLabels}, labelRule)
+	registerCounter(prometheus.CounterOpts{Name: metricMonitoredTxsRateLimited, ConstLabels: constLabels}, labelNetworkID, labelScope)
+	registerGauge(prometheus.GaugeOpts{Name: metricMonitoredTxsGlobalBudget, ConstLabels: constLabels}, labelNetworkID)
+	registerGauge(prometheus.GaugeOpts{Name: metricClaimSignerBalance, ConstLabels: constLabels}, labelNetworkID, labelAddress)
+	registerGauge(prometheus.GaugeOpts{Name: metricLeader, ConstLabels: constLabels}, labelRole)
 	registerCounter(prometheus.CounterOpts{Name: metricSynchronizerEventCount, ConstLabels: constLabels}, labelNetworkID, labelEventType)
 	registerGauge(prometheus.GaugeOpts{Name: metricLastSyncedBlockNum, ConstLabels: constLabels}, labelNetworkID)
 	registerGauge(prometheus.GaugeOpts{Name: metricLatestBlockNum, ConstLabels: constLabels}, labelNetworkID)
+	registerGauge(prometheus.GaugeOpts{Name: metricPriceSourceHealthy, ConstLabels: constLabels}, labelSource)
+	registerCounter(prometheus.CounterOpts{Name: metricPriceSourceErrors, ConstLabels: constLabels}, labelSource)
+	registerCounter(prometheus.CounterOpts{Name: metricPriceSourceStaleness, ConstLabels: constLabels}, labelSource)
 }
 
 // RecordRequest increments the request count for the method
@@ -116,6 +126,46 @@ func RecordAutoClaimDuration(dur time.Duration) {
 	histogramObserve(metricMonitoredTxsDuration, float64(dur)/float64(time.Second), map[string]string{})
 }
 
+// RecordMonitoredTxEscalation records a pending monitored tx being replaced by a higher priced one
+func RecordMonitoredTxEscalation() {
+	counterInc(metricMonitoredTxsEscalation, map[string]string{})
+}
+
+// RecordClaimSimulationRevert records a claim that was not sent because its simulation reverted
+func RecordClaimSimulationRevert(reason string) {
+	counterInc(metricMonitoredTxsSimRevert, map[string]string{labelReason: reason})
+}
+
+// RecordClaimPolicyRejection records a deposit that was not claimed automatically because of a claim policy rule
+func RecordClaimPolicyRejection(rule string) {
+	counterInc(metricMonitoredTxsPolicyReject, map[string]string{labelRule: rule})
+}
+
+// RecordClaimRateLimited records a claim that was deferred because the rate limit of the scope was reached
+func RecordClaimRateLimited(networkID uint32, scope string) {
+	counterInc(metricMonitoredTxsRateLimited, map[string]string{labelNetworkID: strconv.Itoa(int(networkID)), labelScope: scope})
+}
+
+// RecordClaimGlobalBudget records the number of claims sent in the current minute
+func RecordClaimGlobalBudget(networkID uint32, used int64) {
+	gaugeSet(metricMonitoredTxsGlobalBudget, float64(used), map[string]string{labelNetworkID: strconv.Itoa(int(networkID))})
+}
+
+// RecordClaimSignerBalance records the balance (in ether units) of a claim signer
+func RecordClaimSignerBalance(networkID uint32, address common.Address, balance *big.Int) {
+	floatBalance, _ := new(big.Float).Quo(new(big.Float).SetInt(balance), big.NewFloat(math.Pow10(18))).Float64() //nolint:gomnd
+	gaugeSet(metricClaimSignerBalance, floatBalance, map[string]string{labelNetworkID: strconv.Itoa(int(networkID)), labelAddress: address.String()})
+}
+
+// RecordLeader records whether this replica is the leader of the role
+func RecordLeader(role string, leading bool) {
+	value := 0.0
+	if leading {
+		value = 1
+	}
+	gaugeSet(metricLeader, value, map[string]string{labelRole: role})
+}
+
 // RecordSynchronizerEvent records an event log consumed by the synchronizer
 func RecordSynchronizerEvent(networkID uint32, eventType string) {
 	counterInc(metricSynchronizerEventCount, map[string]string{labelNetworkID: strconv.Itoa(int(networkID)), labelEventType: eventType})
@@ -130,3 +180,22 @@ func RecordLastSyncedBlockNum(networkID uint32, blockNum uint64) {
 func RecordLatestBlockNum(networkID uint32, blockNum uint64) {
 	gaugeSet(metricLatestBlockNum, float64(blockNum), map[string]string{labelNetworkID: strconv.Itoa(int(networkID))})
 }
+
+// RecordPriceSourceHealthy records whether the last query of a price source succeeded with fresh prices
+func RecordPriceSourceHealthy(source string, healthy bool) {
+	value := float64(0)
+	if healthy {
+		value = 1
+	}
+	gaugeSet(metricPriceSourceHealthy, value, map[string]string{labelSource: source})
+}
+
+// RecordPriceSourceError records a failed query of a price source
+func RecordPriceSourceError(source string) {
+	counterInc(metricPriceSourceErrors, map[string]string{labelSource: source})
+}
+
+// RecordStalePrices records the prices of a source discarded because they were older than the max price age
+func RecordStalePrices(source string, count int) {
+	counterAdd(metricPriceSourceStaleness, float64(count), map[string]string{labelSource: source})
+}
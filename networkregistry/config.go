@@ -0,0 +1,44 @@
+package networkregistry
+
+import (
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Config is the configuration of the network registry
+type Config struct {
+	// Networks are the L2 networks bridged by the service. When it's empty they are read from Etherman.L2URLs,
+	// Etherman.L2ChainIds, Etherman.L2WSURLs and NetworkConfig.L2PolygonBridgeAddresses. The networks can be
+	// added, changed or removed at runtime with the apollo key networkRegistry.networks, as a JSON array
+	Networks []Network `mapstructure:"Networks"`
+	// RefreshInterval is the interval to read the networks from apollo
+	RefreshInterval types.Duration `mapstructure:"RefreshInterval"`
+}
+
+// Network is a network bridged by the service
+type Network struct {
+	// ID is the network ID of the bridge contract of the network
+	ID uint `mapstructure:"ID" json:"id"`
+	// ChainID is the chain ID of the network
+	ChainID uint `mapstructure:"ChainID" json:"chainId"`
+	// RPCURL is the url of the node of the network
+	RPCURL string `mapstructure:"RPCURL" json:"rpcUrl"`
+	// WSURL is the websocket url of the node of the network, empty disables the subscription
+	WSURL string `mapstructure:"WSURL" json:"wsUrl"`
+	// BridgeAddress is the address of the bridge contract of the network
+	BridgeAddress common.Address `mapstructure:"BridgeAddress" json:"bridgeAddress"`
+	// AutoClaim whether the claim tx manager claims the deposits bridged to the network
+	AutoClaim bool `mapstructure:"AutoClaim" json:"autoClaim"`
+	// EstimateTime is the estimated time in minutes for a deposit of the network to be ready for claim,
+	// 0 uses the estimation of the rollup
+	EstimateTime uint32 `mapstructure:"EstimateTime" json:"estimateTime"`
+	// GlobalExitRootAddress is the address of the global exit root manager of a sovereign chain, the global exit
+	// roots injected on it are used to claim the deposits bridged to the chain. It's zero for the rollups
+	GlobalExitRootAddress common.Address `mapstructure:"GlobalExitRootAddress" json:"globalExitRootAddress"`
+}
+
+// IsSovereign whether the network is a sovereign chain, whose global exit roots are injected on its global exit
+// root manager instead of being updated by the sequencer
+func (n Network) IsSovereign() bool {
+	return n.GlobalExitRootAddress != (common.Address{})
+}
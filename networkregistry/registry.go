@@ -0,0 +1,217 @@
+package networkregistry
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/config/apolloconfig"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+)
+
+const (
+	networksConfigKey = "networkRegistry.networks"
+	eventBufferSize   = 16
+)
+
+// EventType is the change of a network of the registry
+type EventType int
+
+// Changes of the networks of the registry
+const (
+	NetworkAdded EventType = iota
+	NetworkRemoved
+)
+
+// Event is a network added or removed from the registry. A changed network is removed and added again
+type Event struct {
+	Type    EventType
+	Network Network
+}
+
+var defaultRegistry = NewRegistry()
+
+// InitDefault sets the registry used by the package level lookups
+func InitDefault(r *Registry) {
+	defaultRegistry = r
+}
+
+// Default returns the registry used by the package level lookups
+func Default() *Registry {
+	return defaultRegistry
+}
+
+// Registry keeps the networks bridged by the service. The components that run per network subscribe to it
+// to start and stop with the networks added and removed at runtime
+type Registry struct {
+	mu              sync.RWMutex
+	networks        map[uint]Network
+	rollupNetworkID uint
+	subscribers     []chan Event
+}
+
+// NewRegistry creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{networks: make(map[uint]Network)}
+}
+
+// Add adds a network, the network ID and the chain ID must not be registered
+func (r *Registry) Add(n Network) error {
+	r.mu.Lock()
+	if _, ok := r.networks[n.ID]; ok {
+		r.mu.Unlock()
+		return fmt.Errorf("network %d is already registered", n.ID)
+	}
+	for _, other := range r.networks {
+		if n.ChainID != 0 && other.ChainID == n.ChainID {
+			r.mu.Unlock()
+			return fmt.Errorf("chain ID %d of network %d is already registered for network %d", n.ChainID, n.ID, other.ID)
+		}
+	}
+	r.networks[n.ID] = n
+	r.mu.Unlock()
+	r.publish(Event{Type: NetworkAdded, Network: n})
+	return nil
+}
+
+// Remove removes a registered network
+func (r *Registry) Remove(networkID uint) error {
+	r.mu.Lock()
+	n, ok := r.networks[networkID]
+	if !ok {
+		r.mu.Unlock()
+		return fmt.Errorf("network %d is not registered", networkID)
+	}
+	if networkID == r.rollupNetworkID {
+		r.mu.Unlock()
+		return fmt.Errorf("network %d is the rollup of the service and it can't be removed", networkID)
+	}
+	delete(r.networks, networkID)
+	r.mu.Unlock()
+	r.publish(Event{Type: NetworkRemoved, Network: n})
+	return nil
+}
+
+// Get returns the registered network
+func (r *Registry) Get(networkID uint) (Network, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	n, ok := r.networks[networkID]
+	return n, ok
+}
+
+// GetByChainID returns the registered network of the chain
+func (r *Registry) GetByChainID(chainID uint) (Network, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, n := range r.networks {
+		if n.ChainID == chainID {
+			return n, true
+		}
+	}
+	return Network{}, false
+}
+
+// ChainID returns the chain ID of the network, 0 if it's not registered
+func (r *Registry) ChainID(networkID uint) uint32 {
+	n, _ := r.Get(networkID)
+	return uint32(n.ChainID)
+}
+
+// Networks returns the registered networks ordered by network ID
+func (r *Registry) Networks() []Network {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	networks := make([]Network, 0, len(r.networks))
+	for _, n := range r.networks {
+		networks = append(networks, n)
+	}
+	sort.Slice(networks, func(i, j int) bool { return networks[i].ID < networks[j].ID })
+	return networks
+}
+
+// SetRollupNetworkID sets the network of the rollup of the service, the L2 -> L1 deposits are only
+// claimed on L1 for it
+func (r *Registry) SetRollupNetworkID(networkID uint) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.rollupNetworkID = networkID
+}
+
+// RollupNetworkID returns the network of the rollup of the service
+func (r *Registry) RollupNetworkID() uint {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.rollupNetworkID
+}
+
+// Subscribe returns a channel that receives the networks added and removed after the call. The channel
+// must be read, the changes of the registry are blocked while it's full
+func (r *Registry) Subscribe() <-chan Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ch := make(chan Event, eventBufferSize)
+	r.subscribers = append(r.subscribers, ch)
+	return ch
+}
+
+func (r *Registry) publish(e Event) {
+	r.mu.RLock()
+	subscribers := r.subscribers
+	r.mu.RUnlock()
+	for _, ch := range subscribers {
+		ch <- e
+	}
+}
+
+// Apply updates the registry to the networks: the new ones are added, the missing ones are removed and the
+// changed ones are removed and added again. The networks in keep are never removed
+func (r *Registry) Apply(networks []Network, keep ...uint) {
+	wanted := make(map[uint]Network, len(networks))
+	for _, n := range networks {
+		wanted[n.ID] = n
+	}
+	kept := make(map[uint]bool, len(keep))
+	for _, id := range keep {
+		kept[id] = true
+	}
+	for _, n := range r.Networks() {
+		w, ok := wanted[n.ID]
+		if kept[n.ID] || (ok && w == n) {
+			continue
+		}
+		if err := r.Remove(n.ID); err != nil {
+			log.Errorf("error removing network %d from the registry. Error: %v", n.ID, err)
+		}
+	}
+	for _, n := range networks {
+		if _, ok := r.Get(n.ID); ok {
+			continue
+		}
+		if err := r.Add(n); err != nil {
+			log.Errorf("error adding network %d to the registry. Error: %v", n.ID, err)
+		}
+	}
+}
+
+// Watch applies the L2 networks of apollo to the registry every interval until the context is done. The
+// L1 network and the rollup of the service are never removed
+func (r *Registry) Watch(ctx context.Context, defaultNetworks []Network, keep []uint, interval time.Duration) {
+	entry := apolloconfig.NewJSONEntry(networksConfigKey, defaultNetworks)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			networks, err := entry.GetWithErr()
+			if err != nil {
+				continue
+			}
+			r.Apply(networks, keep...)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package networkregistry
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+	events := r.Subscribe()
+	l1 := Network{ID: 0, ChainID: 1}
+	rollup := Network{ID: 1, ChainID: 196, BridgeAddress: common.HexToAddress("0x1")}
+	require.NoError(t, r.Add(l1))
+	require.NoError(t, r.Add(rollup))
+	r.SetRollupNetworkID(1)
+	assert.Equal(t, Event{Type: NetworkAdded, Network: l1}, <-events)
+	assert.Equal(t, Event{Type: NetworkAdded, Network: rollup}, <-events)
+
+	assert.Error(t, r.Add(Network{ID: 1, ChainID: 5}))
+	assert.Error(t, r.Add(Network{ID: 2, ChainID: 196}))
+	assert.Error(t, r.Remove(1))
+	assert.Error(t, r.Remove(3))
+
+	n, ok := r.GetByChainID(196)
+	require.True(t, ok)
+	assert.Equal(t, rollup, n)
+	assert.Equal(t, uint32(1), r.ChainID(0))
+	assert.Equal(t, uint32(0), r.ChainID(7))
+	assert.Equal(t, uint(1), r.RollupNetworkID())
+	assert.Equal(t, []Network{l1, rollup}, r.Networks())
+}
+
+func TestRegistryApply(t *testing.T) {
+	r := NewRegistry()
+	l1 := Network{ID: 0, ChainID: 1}
+	rollup := Network{ID: 1, ChainID: 196}
+	other := Network{ID: 2, ChainID: 197, RPCURL: "http://node2"}
+	require.NoError(t, r.Add(l1))
+	require.NoError(t, r.Add(rollup))
+	require.NoError(t, r.Add(other))
+	r.SetRollupNetworkID(1)
+	events := r.Subscribe()
+
+	// the changed network is removed and added again, the kept L1 is not removed
+	changed := other
+	changed.RPCURL = "http://node2-new"
+	added := Network{ID: 3, ChainID: 198}
+	r.Apply([]Network{rollup, changed, added}, 0)
+	assert.Equal(t, Event{Type: NetworkRemoved, Network: other}, <-events)
+	assert.Equal(t, Event{Type: NetworkAdded, Network: changed}, <-events)
+	assert.Equal(t, Event{Type: NetworkAdded, Network: added}, <-events)
+	assert.Equal(t, []Network{l1, rollup, changed, added}, r.Networks())
+
+	r.Apply([]Network{rollup}, 0)
+	assert.Equal(t, Event{Type: NetworkRemoved, Network: changed}, <-events)
+	assert.Equal(t, Event{Type: NetworkRemoved, Network: added}, <-events)
+	assert.Equal(t, []Network{l1, rollup}, r.Networks())
+	assert.Empty(t, events)
+}
@@ -0,0 +1,47 @@
+package priceoracle
+
+import (
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Config is the configuration of the aggregation of the coin prices from several sources
+type Config struct {
+	// Enabled whether the coin prices are aggregated from the sources, only the prices of the coin middleware
+	// consumer are used otherwise
+	Enabled bool `mapstructure:"Enabled"`
+	// MaxPriceAge is the max age of a price to be used, the older ones are stale
+	MaxPriceAge types.Duration `mapstructure:"MaxPriceAge"`
+	// CacheDuration is how long the prices and the errors of the on-chain and HTTP sources are reused
+	CacheDuration types.Duration `mapstructure:"CacheDuration"`
+	// RequestTimeout is the timeout of a query to a source
+	RequestTimeout types.Duration `mapstructure:"RequestTimeout"`
+	// Chainlink is the list of the Chainlink price feeds, read from L1
+	Chainlink []ChainlinkFeedConfig `mapstructure:"Chainlink"`
+	// HTTP is the list of the HTTP JSON sources
+	HTTP []HTTPSourceConfig `mapstructure:"HTTP"`
+}
+
+// ChainlinkFeedConfig is the USD price feed of a token
+type ChainlinkFeedConfig struct {
+	// ChainID is the standard chain id of the token
+	ChainID uint64 `mapstructure:"ChainID"`
+	// TokenAddress is the address of the token, empty for the native coin
+	TokenAddress string `mapstructure:"TokenAddress"`
+	// Aggregator is the address of the feed aggregator on L1
+	Aggregator common.Address `mapstructure:"Aggregator"`
+}
+
+// HTTPSourceConfig is a source returning the USD price of a token as JSON
+type HTTPSourceConfig struct {
+	// Name is the name of the source in the health and the metrics
+	Name string `mapstructure:"Name"`
+	// URL is the url of the price, {chainId} and {address} are replaced with the standard chain id and the
+	// address of the token
+	URL string `mapstructure:"URL"`
+	// PricePath is the dot separated path of the price in the JSON response, e.g. data.0.price, the price can
+	// be a number or a string
+	PricePath string `mapstructure:"PricePath"`
+	// TimePath is the path of the unix time in seconds of the price, the time of the response is used if it's empty
+	TimePath string `mapstructure:"TimePath"`
+}
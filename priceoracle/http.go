@@ -0,0 +1,135 @@
+package priceoracle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
+	"github.com/pkg/errors"
+)
+
+// maxConcurrentHTTPRequests is the max number of price requests sent at once to a JSON API
+const maxConcurrentHTTPRequests = 8
+
+// httpSource gets the prices from a JSON API, one request per token
+type httpSource struct {
+	cfg    HTTPSourceConfig
+	client *http.Client
+}
+
+// NewHTTPSource creates a source of the prices of a JSON API
+func NewHTTPSource(cfg HTTPSourceConfig, timeout time.Duration) PriceSource {
+	return &httpSource{
+		cfg:    cfg,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (s *httpSource) Name() string {
+	return s.cfg.Name
+}
+
+// GetPrices requests the prices of the tokens concurrently, so a slow token doesn't use up the request timeout
+// of the others. A failed request doesn't drop the prices of the other tokens
+func (s *httpSource) GetPrices(ctx context.Context, symbols []*pb.SymbolInfo) ([]*pb.SymbolPrice, error) {
+	prices := make([]*pb.SymbolPrice, len(symbols))
+	errs := make([]error, len(symbols))
+	sem := make(chan struct{}, maxConcurrentHTTPRequests)
+	var wg sync.WaitGroup
+	for i, symbol := range symbols {
+		wg.Add(1)
+		go func(i int, symbol *pb.SymbolInfo) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			prices[i], errs[i] = s.getPrice(ctx, symbol)
+		}(i, symbol)
+	}
+	wg.Wait()
+
+	failed := make(symbolErrors)
+	for i, err := range errs {
+		if err != nil {
+			failed[i] = errors.Wrapf(err, "get the price of token %v of chain %v error", symbols[i].Address, symbols[i].ChainId)
+		}
+	}
+	if len(failed) > 0 {
+		return prices, failed
+	}
+	return prices, nil
+}
+
+func (s *httpSource) getPrice(ctx context.Context, symbol *pb.SymbolInfo) (*pb.SymbolPrice, error) {
+	url := strings.NewReplacer("{chainId}", strconv.FormatUint(symbol.ChainId, 10), "{address}", symbol.Address).Replace(s.cfg.URL) //nolint:gomnd
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "price request error")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("price request error, status code: %v", resp.StatusCode)
+	}
+
+	var body interface{}
+	if err = json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, errors.Wrap(err, "decode price response error")
+	}
+	price, err := jsonNumber(body, s.cfg.PricePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "read price from response error")
+	}
+	priceTime := time.Now()
+	if s.cfg.TimePath != "" {
+		sec, err := jsonNumber(body, s.cfg.TimePath)
+		if err != nil {
+			return nil, errors.Wrap(err, "read time from response error")
+		}
+		priceTime = time.Unix(int64(sec), 0)
+	}
+	return &pb.SymbolPrice{
+		ChainId: symbol.ChainId,
+		Address: symbol.Address,
+		Price:   price,
+		Time:    uint64(priceTime.UnixMilli()),
+	}, nil
+}
+
+// jsonNumber returns the number or the numeric string at the dot separated path of a decoded JSON value, the
+// array elements are selected by their index
+func jsonNumber(value interface{}, path string) (float64, error) {
+	for _, field := range strings.Split(path, ".") {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			value = v[field]
+		case []interface{}:
+			i, err := strconv.Atoi(field)
+			if err != nil || i < 0 || i >= len(v) {
+				return 0, fmt.Errorf("invalid index %q in path %q", field, path)
+			}
+			value = v[i]
+		default:
+			return 0, fmt.Errorf("field %q not found in path %q", field, path)
+		}
+	}
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case string:
+		return strconv.ParseFloat(v, 64) //nolint:gomnd
+	default:
+		return 0, fmt.Errorf("the value of path %q is not a number", path)
+	}
+}
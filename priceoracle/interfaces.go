@@ -0,0 +1,26 @@
+package priceoracle
+
+import (
+	"context"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// PriceSource is a source of the USD prices of the coins
+type PriceSource interface {
+	// Name is the name of the source in the health and the metrics
+	Name() string
+	// GetPrices returns the prices in the order of the symbols, with a nil or zero price for the ones the source
+	// doesn't know. The prices returned along with an error are still used
+	GetPrices(ctx context.Context, symbols []*pb.SymbolInfo) ([]*pb.SymbolPrice, error)
+}
+
+type priceStorage interface {
+	GetCoinPrice(ctx context.Context, symbols []*pb.SymbolInfo) ([]*pb.SymbolPrice, error)
+}
+
+type chainlinkReader interface {
+	GetChainlinkPrice(ctx context.Context, aggregator common.Address) (float64, time.Time, error)
+}
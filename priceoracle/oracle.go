@@ -0,0 +1,207 @@
+package priceoracle
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/config/apolloconfig"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/metrics"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/redisstorage"
+	"github.com/pkg/errors"
+)
+
+// Oracle aggregates the coin prices of several sources by their median, the stale prices are discarded
+type Oracle struct {
+	cfg     Config
+	sources []PriceSource
+
+	mu     sync.Mutex
+	health map[string]*SourceHealth
+}
+
+// NewOracle creates an oracle of the prices consumed from the coin middleware, the Chainlink feeds read with the L1
+// client and the HTTP sources of the config. The names of the sources must be unique
+func NewOracle(cfg Config, storage redisstorage.RedisStorage, l1Client chainlinkReader) (*Oracle, error) {
+	sources := []PriceSource{NewFeedSource(storage)}
+	if len(cfg.Chainlink) > 0 {
+		sources = append(sources, newCachedSource(NewChainlinkSource(l1Client, cfg.Chainlink), cfg.CacheDuration.Duration))
+	}
+	for _, httpCfg := range cfg.HTTP {
+		sources = append(sources, newCachedSource(NewHTTPSource(httpCfg, cfg.RequestTimeout.Duration), cfg.CacheDuration.Duration))
+	}
+	return newOracle(cfg, sources...)
+}
+
+func newOracle(cfg Config, sources ...PriceSource) (*Oracle, error) {
+	o := &Oracle{
+		cfg:     cfg,
+		sources: sources,
+		health:  make(map[string]*SourceHealth),
+	}
+	// the health and the metrics of the sources are kept by name
+	for _, source := range sources {
+		if source.Name() == "" {
+			return nil, errors.New("the name of a price source is empty")
+		}
+		if _, ok := o.health[source.Name()]; ok {
+			return nil, errors.Errorf("the name of the price source %v is duplicated", source.Name())
+		}
+		o.health[source.Name()] = &SourceHealth{Name: source.Name()}
+	}
+	return o, nil
+}
+
+// GetCoinPrice returns the median of the fresh prices of the sources for each symbol, the price is empty if no
+// source has a fresh one. It only fails if all the sources fail
+func (o *Oracle) GetCoinPrice(ctx context.Context, symbols []*pb.SymbolInfo) ([]*pb.SymbolPrice, error) {
+	if len(symbols) == 0 {
+		return nil, nil
+	}
+
+	// The sources are queried concurrently, a slow one doesn't delay the others
+	results := make([][]*pb.SymbolPrice, len(o.sources))
+	errs := make([]error, len(o.sources))
+	var wg sync.WaitGroup
+	for i, source := range o.sources {
+		wg.Add(1)
+		go func(i int, source PriceSource) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, o.cfg.RequestTimeout.Duration)
+			defer cancel()
+			results[i], errs[i] = source.GetPrices(ctx, symbols)
+		}(i, source)
+	}
+	wg.Wait()
+
+	now := time.Now()
+	candidates := make([][]*pb.SymbolPrice, len(symbols))
+	stale := make([]bool, len(symbols))
+	failed := 0
+	for i, source := range o.sources {
+		freshCount, staleCount := 0, 0
+		for j, price := range results[i] {
+			if j >= len(symbols) || price == nil || price.Price <= 0 {
+				continue
+			}
+			if now.Sub(priceTime(price)) > o.cfg.MaxPriceAge.Duration {
+				staleCount++
+				stale[j] = true
+				continue
+			}
+			freshCount++
+			candidates[j] = append(candidates[j], price)
+		}
+		if errs[i] != nil {
+			// a source that only failed to price some of the symbols is not failed
+			var partial symbolErrors
+			if !errors.As(errs[i], &partial) || len(partial) == len(symbols) {
+				failed++
+			}
+			log.Warnf("get coin prices from source %v error: %v", source.Name(), errs[i])
+		}
+		o.updateHealth(source.Name(), now, errs[i], freshCount, staleCount)
+	}
+	if failed == len(o.sources) {
+		return nil, errors.Wrap(errs[0], "all the price sources failed")
+	}
+
+	prices := make([]*pb.SymbolPrice, len(symbols))
+	for j, symbol := range symbols {
+		prices[j] = median(symbol, candidates[j])
+		if len(candidates[j]) == 0 && stale[j] {
+			log.Warnf("the prices of token %v of chain %v are stale in all the sources", symbol.Address, symbol.ChainId)
+		}
+	}
+	return prices, nil
+}
+
+// Health returns the health of the sources, in the order they're queried
+func (o *Oracle) Health() []SourceHealth {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	health := make([]SourceHealth, 0, len(o.sources))
+	for _, source := range o.sources {
+		health = append(health, *o.health[source.Name()])
+	}
+	return health
+}
+
+func (o *Oracle) updateHealth(name string, now time.Time, err error, freshCount int, staleCount int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	h := o.health[name]
+	if err != nil {
+		h.LastError = err.Error()
+		h.LastErrorTime = now
+		metrics.RecordPriceSourceError(name)
+	} else {
+		h.LastSuccess = now
+	}
+	// The staleness is kept if the source had no price of the symbols
+	if freshCount+staleCount > 0 {
+		h.Stale = freshCount == 0
+	}
+	if staleCount > 0 {
+		h.StaleCount += uint64(staleCount)
+		metrics.RecordStalePrices(name, staleCount)
+	}
+	metrics.RecordPriceSourceHealthy(name, h.Healthy())
+}
+
+// median aggregates the prices of a symbol, the time is the one of the newest price
+func median(symbol *pb.SymbolInfo, prices []*pb.SymbolPrice) *pb.SymbolPrice {
+	result := &pb.SymbolPrice{ChainId: symbol.ChainId, Address: symbol.Address}
+	if len(prices) == 0 {
+		return result
+	}
+	values := make([]float64, 0, len(prices))
+	for _, price := range prices {
+		values = append(values, price.Price)
+		if result.Symbol == "" {
+			result.Symbol = price.Symbol
+		}
+		if price.Time > result.Time {
+			result.Time = price.Time
+		}
+	}
+	sort.Float64s(values)
+	mid := len(values) / 2 //nolint:gomnd
+	if len(values)%2 == 0 {
+		result.Price = (values[mid-1] + values[mid]) / 2 //nolint:gomnd
+	} else {
+		result.Price = values[mid]
+	}
+	return result
+}
+
+// redisStorage is a redis storage whose coin prices are the ones of the oracle
+type redisStorage struct {
+	redisstorage.RedisStorage
+	oracle  *Oracle
+	enabled apolloconfig.Entry[bool]
+}
+
+// NewRedisStorage returns the storage with the coin prices aggregated by the oracle, the other methods are the
+// ones of the storage. The prices are empty if the coin prices are disabled, like the ones of the storage
+func NewRedisStorage(storage redisstorage.RedisStorage, oracle *Oracle, enablePrice bool) redisstorage.RedisStorage {
+	return &redisStorage{
+		RedisStorage: storage,
+		oracle:       oracle,
+		enabled:      apolloconfig.NewBoolEntry("CoinPrice.Enabled", enablePrice),
+	}
+}
+
+func (s *redisStorage) GetCoinPrice(ctx context.Context, symbols []*pb.SymbolInfo) ([]*pb.SymbolPrice, error) {
+	if !s.enabled.Get() {
+		prices := make([]*pb.SymbolPrice, 0, len(symbols))
+		for _, symbol := range symbols {
+			prices = append(prices, &pb.SymbolPrice{ChainId: symbol.ChainId, Address: symbol.Address})
+		}
+		return prices, nil
+	}
+	return s.oracle.GetCoinPrice(ctx, symbols)
+}
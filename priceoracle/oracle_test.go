@@ -0,0 +1,313 @@
+package priceoracle
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testToken = "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+
+type fakeSource struct {
+	name   string
+	prices map[string]*pb.SymbolPrice
+	err    error
+	calls  int
+}
+
+func (s *fakeSource) Name() string {
+	return s.name
+}
+
+func (s *fakeSource) GetPrices(ctx context.Context, symbols []*pb.SymbolInfo) ([]*pb.SymbolPrice, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	prices := make([]*pb.SymbolPrice, len(symbols))
+	for i, symbol := range symbols {
+		prices[i] = s.prices[symbolKey(symbol.ChainId, symbol.Address)]
+	}
+	return prices, nil
+}
+
+func newFakeSource(name string, price float64, age time.Duration) *fakeSource {
+	return &fakeSource{
+		name: name,
+		prices: map[string]*pb.SymbolPrice{
+			symbolKey(1, testToken): {Symbol: "USDC", ChainId: 1, Address: testToken, Price: price, Time: uint64(time.Now().Add(-age).UnixMilli())},
+		},
+	}
+}
+
+type fakeChainlinkReader struct {
+	price     float64
+	updatedAt time.Time
+	failing   common.Address
+}
+
+func (r *fakeChainlinkReader) GetChainlinkPrice(ctx context.Context, aggregator common.Address) (float64, time.Time, error) {
+	if aggregator == r.failing {
+		return 0, time.Time{}, errors.New("execution reverted")
+	}
+	return r.price, r.updatedAt, nil
+}
+
+func newTestConfig() Config {
+	return Config{
+		MaxPriceAge:    types.NewDuration(10 * time.Minute),
+		CacheDuration:  types.NewDuration(time.Minute),
+		RequestTimeout: types.NewDuration(time.Second),
+	}
+}
+
+func TestGetCoinPrice(t *testing.T) {
+	ctx := context.Background()
+	symbols := []*pb.SymbolInfo{{ChainId: 1, Address: testToken}, {ChainId: 1, Address: "0x01"}}
+	feed := newFakeSource("kafka", 1.3, time.Hour)
+	oracle, err := newOracle(newTestConfig(), feed, newFakeSource("chainlink", 1.0, 0), newFakeSource("http", 1.02, time.Minute))
+	require.NoError(t, err)
+
+	// the stale price of the feed is discarded
+	prices, err := oracle.GetCoinPrice(ctx, symbols)
+	require.NoError(t, err)
+	require.Len(t, prices, 2)
+	assert.Equal(t, 1.01, prices[0].Price)
+	assert.Equal(t, "USDC", prices[0].Symbol)
+	assert.Equal(t, testToken, prices[0].Address)
+	assert.Zero(t, prices[1].Price)
+	assert.Equal(t, "0x01", prices[1].Address)
+
+	health := oracle.Health()
+	require.Len(t, health, 3)
+	assert.Equal(t, "kafka", health[0].Name)
+	assert.True(t, health[0].Stale)
+	assert.False(t, health[0].Healthy())
+	assert.Equal(t, uint64(1), health[0].StaleCount)
+	assert.True(t, health[1].Healthy())
+	assert.True(t, health[2].Healthy())
+
+	// the median of an odd number of prices is the middle one
+	feed.prices[symbolKey(1, testToken)].Time = uint64(time.Now().UnixMilli())
+	prices, err = oracle.GetCoinPrice(ctx, symbols)
+	require.NoError(t, err)
+	assert.Equal(t, 1.02, prices[0].Price)
+	assert.True(t, oracle.Health()[0].Healthy())
+
+	// a failed source is ignored, it only fails if all of them fail
+	feed.err = errors.New("redis error")
+	prices, err = oracle.GetCoinPrice(ctx, symbols)
+	require.NoError(t, err)
+	assert.Equal(t, 1.01, prices[0].Price)
+	health = oracle.Health()
+	assert.False(t, health[0].Healthy())
+	assert.Equal(t, "redis error", health[0].LastError)
+	assert.Equal(t, "redis error", health[0].ToPb().LastError)
+
+	oracle, err = newOracle(newTestConfig(), feed)
+	require.NoError(t, err)
+	_, err = oracle.GetCoinPrice(ctx, symbols)
+	require.Error(t, err)
+
+	// a source that failed to price some of the symbols is not failed
+	oracle, err = newOracle(newTestConfig(), feed, &partialSource{fakeSource: newFakeSource("chainlink", 1.0, 0)})
+	require.NoError(t, err)
+	prices, err = oracle.GetCoinPrice(ctx, symbols)
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, prices[0].Price)
+}
+
+// partialSource fails to price the symbols without a price
+type partialSource struct {
+	*fakeSource
+}
+
+func (s *partialSource) GetPrices(ctx context.Context, symbols []*pb.SymbolInfo) ([]*pb.SymbolPrice, error) {
+	prices, err := s.fakeSource.GetPrices(ctx, symbols)
+	failed := make(symbolErrors)
+	for i, price := range prices {
+		if price == nil {
+			failed[i] = errors.New("no price")
+		}
+	}
+	if err == nil && len(failed) > 0 {
+		err = failed
+	}
+	return prices, err
+}
+
+func TestNewOracleSourceNames(t *testing.T) {
+	_, err := newOracle(newTestConfig(), newFakeSource("kafka", 1, 0), newFakeSource("", 1, 0))
+	require.Error(t, err)
+	// the HTTP sources can't take the name of the other sources
+	_, err = newOracle(newTestConfig(), newFakeSource("kafka", 1, 0), newFakeSource("chainlink", 1, 0), newFakeSource("chainlink", 1, 0))
+	require.Error(t, err)
+	_, err = NewOracle(Config{HTTP: []HTTPSourceConfig{{Name: "kafka"}}}, nil, nil)
+	require.Error(t, err)
+	_, err = NewOracle(Config{HTTP: []HTTPSourceConfig{{Name: "a"}, {Name: "b"}}}, nil, nil)
+	require.NoError(t, err)
+}
+
+func TestRedisStorageCoinPriceDisabled(t *testing.T) {
+	source := newFakeSource("kafka", 1, 0)
+	oracle, err := newOracle(newTestConfig(), source)
+	require.NoError(t, err)
+
+	// the prices are empty while the coin prices are disabled, and the sources are not queried
+	storage := NewRedisStorage(nil, oracle, false)
+	prices, err := storage.GetCoinPrice(context.Background(), []*pb.SymbolInfo{{ChainId: 1, Address: testToken}})
+	require.NoError(t, err)
+	require.Len(t, prices, 1)
+	assert.Zero(t, prices[0].Price)
+	assert.Equal(t, testToken, prices[0].Address)
+	assert.Zero(t, source.calls)
+
+	storage = NewRedisStorage(nil, oracle, true)
+	prices, err = storage.GetCoinPrice(context.Background(), []*pb.SymbolInfo{{ChainId: 1, Address: testToken}})
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, prices[0].Price)
+}
+
+func TestCachedSource(t *testing.T) {
+	ctx := context.Background()
+	symbols := []*pb.SymbolInfo{{ChainId: 1, Address: testToken}}
+	source := newFakeSource("http", 1.0, 0)
+	cached := newCachedSource(source, time.Minute)
+
+	for i := 0; i < 2; i++ {
+		prices, err := cached.GetPrices(ctx, symbols)
+		require.NoError(t, err)
+		assert.Equal(t, 1.0, prices[0].Price)
+	}
+	assert.Equal(t, 1, source.calls)
+
+	// the errors are cached too
+	source.err = errors.New("timeout")
+	symbols = append(symbols, &pb.SymbolInfo{ChainId: 1, Address: "0x01"})
+	for i := 0; i < 2; i++ {
+		prices, err := cached.GetPrices(ctx, symbols)
+		require.Error(t, err)
+		assert.Equal(t, 1.0, prices[0].Price)
+		assert.Nil(t, prices[1])
+	}
+	assert.Equal(t, 2, source.calls)
+}
+
+func TestChainlinkSource(t *testing.T) {
+	updatedAt := time.Unix(1700000000, 0)
+	source := NewChainlinkSource(&fakeChainlinkReader{price: 2000.5, updatedAt: updatedAt}, []ChainlinkFeedConfig{
+		{ChainID: 1, TokenAddress: "", Aggregator: common.HexToAddress("0x5f4eC3Df9cbd43714FE2740f5E3616155c5b8419")},
+	})
+	prices, err := source.GetPrices(context.Background(), []*pb.SymbolInfo{{ChainId: 1}, {ChainId: 1, Address: testToken}})
+	require.NoError(t, err)
+	require.NotNil(t, prices[0])
+	assert.Equal(t, 2000.5, prices[0].Price)
+	assert.Equal(t, uint64(updatedAt.UnixMilli()), prices[0].Time)
+	assert.Nil(t, prices[1])
+
+	// a failing feed only drops its own price, and the cache keeps the price of the rest
+	failing := common.HexToAddress("0xf")
+	source = newCachedSource(NewChainlinkSource(&fakeChainlinkReader{price: 1.0, updatedAt: updatedAt, failing: failing}, []ChainlinkFeedConfig{
+		{ChainID: 1, TokenAddress: "", Aggregator: common.HexToAddress("0x1")},
+		{ChainID: 1, TokenAddress: testToken, Aggregator: failing},
+	}), time.Minute)
+	for i := 0; i < 2; i++ {
+		prices, err = source.GetPrices(context.Background(), []*pb.SymbolInfo{{ChainId: 1}, {ChainId: 1, Address: testToken}})
+		var failed symbolErrors
+		require.ErrorAs(t, err, &failed)
+		assert.Len(t, failed, 1)
+		assert.Contains(t, failed[1].Error(), "execution reverted")
+		require.NotNil(t, prices[0])
+		assert.Equal(t, 1.0, prices[0].Price)
+		assert.Nil(t, prices[1])
+	}
+}
+
+func TestHTTPSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/prices/1/"+testToken {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":[{"price":"0.9998","ts":1700000000}]}`))
+	}))
+	defer server.Close()
+
+	source := NewHTTPSource(HTTPSourceConfig{
+		Name:      "http",
+		URL:       server.URL + "/prices/{chainId}/{address}",
+		PricePath: "data.0.price",
+		TimePath:  "data.0.ts",
+	}, time.Second)
+	prices, err := source.GetPrices(context.Background(), []*pb.SymbolInfo{{ChainId: 1, Address: testToken}, {ChainId: 2, Address: testToken}})
+	require.NoError(t, err)
+	require.NotNil(t, prices[0])
+	assert.Equal(t, 0.9998, prices[0].Price)
+	assert.Equal(t, uint64(1700000000000), prices[0].Time)
+	assert.Nil(t, prices[1])
+}
+
+func TestHTTPSourcePartialFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/prices/1":
+			_, _ = w.Write([]byte(`{"price":1.5}`))
+		case "/prices/2":
+			w.WriteHeader(http.StatusInternalServerError)
+		case "/prices/3":
+			// a slow token doesn't delay the others past the request timeout
+			time.Sleep(200 * time.Millisecond)
+			_, _ = w.Write([]byte(`{"price":3}`))
+		}
+	}))
+	defer server.Close()
+
+	source := NewHTTPSource(HTTPSourceConfig{Name: "http", URL: server.URL + "/prices/{chainId}", PricePath: "price"}, time.Second)
+	var symbols []*pb.SymbolInfo
+	for i := 0; i < maxConcurrentHTTPRequests; i++ {
+		symbols = append(symbols, &pb.SymbolInfo{ChainId: 3})
+	}
+	symbols = append(symbols, &pb.SymbolInfo{ChainId: 1}, &pb.SymbolInfo{ChainId: 2})
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	prices, err := source.GetPrices(ctx, symbols)
+
+	// the failed token is reported by its position, the prices of the other tokens are kept
+	var failed symbolErrors
+	require.ErrorAs(t, err, &failed)
+	require.Len(t, failed, 1)
+	assert.Contains(t, failed[len(symbols)-1].Error(), "status code: 500")
+	require.NotNil(t, prices[len(symbols)-2])
+	assert.Equal(t, 1.5, prices[len(symbols)-2].Price)
+	for _, price := range prices[:maxConcurrentHTTPRequests] {
+		require.NotNil(t, price)
+		assert.Equal(t, 3.0, price.Price)
+	}
+	assert.Nil(t, prices[len(symbols)-1])
+}
+
+func TestJSONNumber(t *testing.T) {
+	value := map[string]interface{}{"data": map[string]interface{}{"price": 1.5, "list": []interface{}{"2.5"}}}
+	price, err := jsonNumber(value, "data.price")
+	require.NoError(t, err)
+	assert.Equal(t, 1.5, price)
+	price, err = jsonNumber(value, "data.list.0")
+	require.NoError(t, err)
+	assert.Equal(t, 2.5, price)
+
+	_, err = jsonNumber(value, "data.list.1")
+	assert.Error(t, err)
+	_, err = jsonNumber(value, "data.price.usd")
+	assert.Error(t, err)
+	_, err = jsonNumber(value, "data.missing")
+	assert.Error(t, err)
+}
@@ -0,0 +1,172 @@
+package priceoracle
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
+	"github.com/pkg/errors"
+)
+
+const (
+	feedSourceName      = "kafka"
+	chainlinkSourceName = "chainlink"
+)
+
+// feedSource returns the prices stored by the coin middleware consumer
+type feedSource struct {
+	storage priceStorage
+}
+
+// NewFeedSource creates the source of the prices consumed from the coin middleware
+func NewFeedSource(storage priceStorage) PriceSource {
+	return &feedSource{storage: storage}
+}
+
+func (s *feedSource) Name() string {
+	return feedSourceName
+}
+
+func (s *feedSource) GetPrices(ctx context.Context, symbols []*pb.SymbolInfo) ([]*pb.SymbolPrice, error) {
+	return s.storage.GetCoinPrice(ctx, symbols)
+}
+
+// chainlinkSource reads the prices of the tokens with a Chainlink feed
+type chainlinkSource struct {
+	reader chainlinkReader
+	feeds  map[string]ChainlinkFeedConfig
+}
+
+// NewChainlinkSource creates the source of the prices of the Chainlink feeds
+func NewChainlinkSource(reader chainlinkReader, feeds []ChainlinkFeedConfig) PriceSource {
+	s := &chainlinkSource{reader: reader, feeds: make(map[string]ChainlinkFeedConfig)}
+	for _, feed := range feeds {
+		s.feeds[symbolKey(feed.ChainID, feed.TokenAddress)] = feed
+	}
+	return s
+}
+
+func (s *chainlinkSource) Name() string {
+	return chainlinkSourceName
+}
+
+func (s *chainlinkSource) GetPrices(ctx context.Context, symbols []*pb.SymbolInfo) ([]*pb.SymbolPrice, error) {
+	prices := make([]*pb.SymbolPrice, len(symbols))
+	failed := make(symbolErrors)
+	for i, symbol := range symbols {
+		feed, ok := s.feeds[symbolKey(symbol.ChainId, symbol.Address)]
+		if !ok {
+			continue
+		}
+		price, updatedAt, err := s.reader.GetChainlinkPrice(ctx, feed.Aggregator)
+		if err != nil {
+			// a failing feed doesn't drop the prices of the other feeds
+			failed[i] = errors.Wrapf(err, "read chainlink aggregator %v error", feed.Aggregator)
+			continue
+		}
+		prices[i] = &pb.SymbolPrice{
+			ChainId: symbol.ChainId,
+			Address: symbol.Address,
+			Price:   price,
+			Time:    uint64(updatedAt.UnixMilli()),
+		}
+	}
+	if len(failed) > 0 {
+		return prices, failed
+	}
+	return prices, nil
+}
+
+// symbolErrors are the errors of the symbols a source failed to price by their position, the prices of the rest
+// of the symbols are valid
+type symbolErrors map[int]error
+
+func (e symbolErrors) Error() string {
+	positions := make([]int, 0, len(e))
+	for i := range e {
+		positions = append(positions, i)
+	}
+	sort.Ints(positions)
+	msgs := make([]string, 0, len(e))
+	for _, i := range positions {
+		msgs = append(msgs, e[i].Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// cachedSource reuses the prices and the errors of a source for a while, so the slow sources aren't queried on
+// each request
+type cachedSource struct {
+	PriceSource
+	duration time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+type cacheEntry struct {
+	price     *pb.SymbolPrice
+	err       error
+	fetchedAt time.Time
+}
+
+func newCachedSource(source PriceSource, duration time.Duration) PriceSource {
+	return &cachedSource{
+		PriceSource: source,
+		duration:    duration,
+		entries:     make(map[string]*cacheEntry),
+	}
+}
+
+func (s *cachedSource) GetPrices(ctx context.Context, symbols []*pb.SymbolInfo) ([]*pb.SymbolPrice, error) {
+	now := time.Now()
+	prices := make([]*pb.SymbolPrice, len(symbols))
+	failed := make(symbolErrors)
+	var (
+		missing    []*pb.SymbolInfo
+		missingPos []int
+	)
+	s.mu.Lock()
+	for i, symbol := range symbols {
+		entry, ok := s.entries[symbolKey(symbol.ChainId, symbol.Address)]
+		if !ok || now.Sub(entry.fetchedAt) > s.duration {
+			missing = append(missing, symbol)
+			missingPos = append(missingPos, i)
+			continue
+		}
+		if entry.err != nil {
+			failed[i] = entry.err
+		}
+		prices[i] = entry.price
+	}
+	s.mu.Unlock()
+
+	if len(missing) > 0 {
+		fetched, err := s.PriceSource.GetPrices(ctx, missing)
+		// the error is of the symbols that failed if the source priced the rest, or of all of them otherwise
+		var partial symbolErrors
+		errors.As(err, &partial)
+		s.mu.Lock()
+		for j, symbol := range missing {
+			entry := &cacheEntry{err: err, fetchedAt: now}
+			if partial != nil {
+				entry.err = partial[j]
+			}
+			if entry.err == nil {
+				entry.price = fetched[j]
+			} else {
+				failed[missingPos[j]] = entry.err
+			}
+			prices[missingPos[j]] = entry.price
+			s.entries[symbolKey(symbol.ChainId, symbol.Address)] = entry
+		}
+		s.mu.Unlock()
+	}
+	if len(failed) > 0 {
+		return prices, failed
+	}
+	return prices, nil
+}
@@ -0,0 +1,56 @@
+package priceoracle
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
+)
+
+// SourceHealth is the state of a price source after its last queries
+type SourceHealth struct {
+	Name string
+	// Stale whether all the prices of the last query were older than the max price age
+	Stale         bool
+	LastSuccess   time.Time
+	LastErrorTime time.Time
+	LastError     string
+	// StaleCount is the number of prices discarded because they were stale
+	StaleCount uint64
+}
+
+// Healthy returns whether the last query of the source succeeded with fresh prices
+func (h SourceHealth) Healthy() bool {
+	return !h.LastSuccess.IsZero() && !h.LastSuccess.Before(h.LastErrorTime) && !h.Stale
+}
+
+// ToPb converts the health to the API response
+func (h SourceHealth) ToPb() *pb.PriceSourceHealth {
+	return &pb.PriceSourceHealth{
+		Name:            h.Name,
+		Healthy:         h.Healthy(),
+		Stale:           h.Stale,
+		LastSuccessTime: unixTime(h.LastSuccess),
+		LastErrorTime:   unixTime(h.LastErrorTime),
+		LastError:       h.LastError,
+		StaleCount:      h.StaleCount,
+	}
+}
+
+func unixTime(t time.Time) uint64 {
+	if t.IsZero() {
+		return 0
+	}
+	return uint64(t.Unix())
+}
+
+// priceTime returns the time of a price, the times of the prices are unix timestamps in milliseconds
+func priceTime(price *pb.SymbolPrice) time.Time {
+	return time.UnixMilli(int64(price.Time))
+}
+
+// symbolKey is the key of the price of a token, the addresses are compared case insensitively
+func symbolKey(chainID uint64, address string) string {
+	return fmt.Sprintf("%d_%s", chainID, strings.ToLower(address))
+}
@@ -0,0 +1,13 @@
+package pushoutbox
+
+import "github.com/0xPolygonHermez/zkevm-node/config/types"
+
+// Config is the configuration of the relay of the push outbox
+type Config struct {
+	// PollInterval is the interval to look for the messages to push
+	PollInterval types.Duration `mapstructure:"PollInterval"`
+	// BatchSize is the max number of messages pushed on each poll
+	BatchSize uint `mapstructure:"BatchSize"`
+	// Retention is how long the pushed messages are kept before they're deleted
+	Retention types.Duration `mapstructure:"Retention"`
+}
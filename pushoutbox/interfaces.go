@@ -0,0 +1,17 @@
+package pushoutbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+)
+
+type storageInterface interface {
+	GetPendingPushOutboxMessages(ctx context.Context, limit uint, dbTx pgx.Tx) ([]*Message, error)
+	UpdatePushOutboxMessage(ctx context.Context, msg *Message, dbTx pgx.Tx) error
+	DeleteSentPushOutboxMessages(ctx context.Context, sentBefore time.Time, dbTx pgx.Tx) (int64, error)
+	BeginDBTransaction(ctx context.Context) (pgx.Tx, error)
+	Commit(ctx context.Context, dbTx pgx.Tx) error
+	Rollback(ctx context.Context, dbTx pgx.Tx) error
+}
@@ -0,0 +1,151 @@
+package pushoutbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/messagepush"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/redisstorage"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/server/tokenlogoinfo"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils"
+)
+
+const (
+	maxErrorLength  = 256
+	cleanUpInterval = time.Hour
+)
+
+// Relay pushes the outbox messages with the producer, in the order they were stored. A message is marked as
+// sent once the producer accepts it, so it's pushed at least once: it's pushed again if the relay stops before
+// marking it
+type Relay struct {
+	cfg          Config
+	storage      storageInterface
+	producer     messagepush.KafkaProducer
+	redisStorage redisstorage.RedisStorage
+
+	cleanedAt time.Time
+}
+
+// NewRelay creates the push outbox relay. The logo info of the created transactions is filled with the redis
+// storage before they're pushed, it's skipped if it's nil
+func NewRelay(cfg Config, producer messagepush.KafkaProducer, storage interface{}, redisStorage redisstorage.RedisStorage) *Relay {
+	return &Relay{
+		cfg:          cfg,
+		storage:      storage.(storageInterface),
+		producer:     producer,
+		redisStorage: redisStorage,
+	}
+}
+
+// Start pushes the pending messages every poll interval until the context is done. Several replicas can run
+// it, only the one holding the relay lock pushes so the updates of a transaction keep their order
+func (r *Relay) Start(ctx context.Context) {
+	log.Info("push outbox relay started")
+	ticker := time.NewTicker(r.cfg.PollInterval.Duration)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("push outbox relay stopped")
+			return
+		case <-ticker.C:
+			if err := r.relayPending(ctx); err != nil {
+				log.Errorf("error pushing the outbox messages: %v", err)
+			}
+			r.cleanUp(ctx)
+		}
+	}
+}
+
+func (r *Relay) relayPending(ctx context.Context) error {
+	dbTx, err := r.storage.BeginDBTransaction(ctx)
+	if err != nil {
+		return err
+	}
+	messages, err := r.storage.GetPendingPushOutboxMessages(ctx, r.cfg.BatchSize, dbTx)
+	if err != nil || len(messages) == 0 {
+		if rollbackErr := r.storage.Rollback(ctx, dbTx); rollbackErr != nil {
+			log.Errorf("error rolling back the outbox messages: %v", rollbackErr)
+		}
+		return err
+	}
+
+	txs := r.decodeTransactions(ctx, messages)
+	for i, msg := range messages {
+		// The messages that can't be decoded are failed, they're not pushed
+		var pushErr error
+		if txs[i] != nil {
+			pushErr = r.push(msg, txs[i])
+		}
+		if err = r.storage.UpdatePushOutboxMessage(ctx, msg, dbTx); err != nil {
+			if rollbackErr := r.storage.Rollback(ctx, dbTx); rollbackErr != nil {
+				log.Errorf("error rolling back the outbox messages: %v", rollbackErr)
+			}
+			return err
+		}
+		// The next messages can be updates of the same transaction, they wait for this one to keep the order
+		if pushErr != nil {
+			log.Warnf("outbox message %s failed after %d attempts, retrying on the next poll: %v", msg.DedupKey, msg.Attempts, pushErr)
+			break
+		}
+	}
+	return r.storage.Commit(ctx, dbTx)
+}
+
+// decodeTransactions returns the transaction updates of the messages, with the logo info of the created ones.
+// The ones that can't be decoded are nil, and their messages are failed with the decoding error
+func (r *Relay) decodeTransactions(ctx context.Context, messages []*Message) []*pb.Transaction {
+	txs := make([]*pb.Transaction, len(messages))
+	transactionMap := make(map[string][]*pb.Transaction)
+	for i, msg := range messages {
+		tx, err := msg.Transaction()
+		if err != nil {
+			log.Errorf("outbox message %s can't be decoded: %v", msg.DedupKey, err)
+			failedAt := time.Now().UTC()
+			msg.FailedAt = &failedAt
+			msg.setLastError(err)
+			continue
+		}
+		txs[i] = tx
+		if tx.Status == uint32(pb.TransactionStatus_TX_CREATED) && tx.LogoInfo == nil {
+			logoCacheKey := tokenlogoinfo.GetTokenLogoMapKey(tx.GetBridgeToken(), utils.GetChainIdByNetworkId(uint(tx.OriginalNetwork)))
+			transactionMap[logoCacheKey] = append(transactionMap[logoCacheKey], tx)
+		}
+	}
+	if r.redisStorage != nil && len(transactionMap) > 0 {
+		tokenlogoinfo.FillLogoInfos(ctx, r.redisStorage, transactionMap)
+	}
+	return txs
+}
+
+// push pushes the transaction update of the message and updates its status
+func (r *Relay) push(msg *Message, tx *pb.Transaction) error {
+	msg.Attempts++
+	err := r.producer.PushTransactionUpdate(tx, messagepush.WithRequestID(msg.DedupKey))
+	if err != nil {
+		msg.setLastError(err)
+		return err
+	}
+	sentAt := time.Now().UTC()
+	msg.SentAt = &sentAt
+	msg.LastError = ""
+	log.Debugf("outbox message %s pushed", msg.DedupKey)
+	return nil
+}
+
+// cleanUp deletes the messages pushed or failed before the retention, at most once per clean up interval
+func (r *Relay) cleanUp(ctx context.Context) {
+	if time.Since(r.cleanedAt) < cleanUpInterval {
+		return
+	}
+	r.cleanedAt = time.Now()
+	deleted, err := r.storage.DeleteSentPushOutboxMessages(ctx, time.Now().Add(-r.cfg.Retention.Duration), nil)
+	if err != nil {
+		log.Errorf("error deleting the pushed outbox messages: %v", err)
+		return
+	}
+	log.Debugf("%d pushed or failed outbox messages deleted", deleted)
+}
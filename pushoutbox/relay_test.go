@@ -0,0 +1,161 @@
+package pushoutbox
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/messagepush"
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTopic = "bridge_test"
+
+type memStorage struct {
+	messages  []*Message
+	committed int
+}
+
+func (s *memStorage) add(t *testing.T, tx *pb.Transaction) {
+	msg, err := NewMessage(tx, 1)
+	require.NoError(t, err)
+	msg.ID = uint64(len(s.messages) + 1)
+	s.messages = append(s.messages, msg)
+}
+
+func (s *memStorage) GetPendingPushOutboxMessages(ctx context.Context, limit uint, dbTx pgx.Tx) ([]*Message, error) {
+	var pending []*Message
+	for _, msg := range s.messages {
+		if msg.SentAt == nil && msg.FailedAt == nil && uint(len(pending)) < limit {
+			copied := *msg
+			pending = append(pending, &copied)
+		}
+	}
+	return pending, nil
+}
+
+func (s *memStorage) UpdatePushOutboxMessage(ctx context.Context, msg *Message, dbTx pgx.Tx) error {
+	*s.messages[msg.ID-1] = *msg
+	return nil
+}
+
+func (s *memStorage) DeleteSentPushOutboxMessages(ctx context.Context, sentBefore time.Time, dbTx pgx.Tx) (int64, error) {
+	var (
+		kept    []*Message
+		deleted int64
+	)
+	for _, msg := range s.messages {
+		if (msg.SentAt != nil && msg.SentAt.Before(sentBefore)) || (msg.FailedAt != nil && msg.FailedAt.Before(sentBefore)) {
+			deleted++
+			continue
+		}
+		kept = append(kept, msg)
+	}
+	s.messages = kept
+	return deleted, nil
+}
+
+func (s *memStorage) BeginDBTransaction(ctx context.Context) (pgx.Tx, error) {
+	return nil, nil
+}
+
+func (s *memStorage) Commit(ctx context.Context, dbTx pgx.Tx) error {
+	s.committed++
+	return nil
+}
+
+func (s *memStorage) Rollback(ctx context.Context, dbTx pgx.Tx) error {
+	return nil
+}
+
+func TestDedupKey(t *testing.T) {
+	tx := &pb.Transaction{FromChain: 1, Index: 7, Status: uint32(pb.TransactionStatus_TX_CREATED), TxHash: "0x01"}
+	assert.Equal(t, "1-7-TX_CREATED-0x01-3", DedupKey(tx, 3))
+	// the deposit count reused after a reorg has another key
+	assert.NotEqual(t, DedupKey(tx, 3), DedupKey(tx, 4))
+	assert.NotEqual(t, DedupKey(tx, 3), DedupKey(&pb.Transaction{FromChain: 1, Index: 7, Status: tx.Status, TxHash: "0x02"}, 3))
+
+	tx = &pb.Transaction{FromChain: 1, Index: 7, Status: uint32(pb.TransactionStatus_TX_CLAIMED), TxHash: "0x01", ClaimTxHash: "0x0a"}
+	assert.Equal(t, "1-7-TX_CLAIMED-0x0a-5", DedupKey(tx, 5))
+
+	msg, err := NewMessage(tx, 5)
+	require.NoError(t, err)
+	decoded, err := msg.Transaction()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), decoded.Index)
+	assert.Equal(t, uint32(pb.TransactionStatus_TX_CLAIMED), decoded.Status)
+}
+
+func TestRelayPending(t *testing.T) {
+	ctx := context.Background()
+	producer, err := messagepush.NewKafkaProducer(messagepush.Config{UseFakeProducer: true, Topic: testTopic})
+	require.NoError(t, err)
+	storage := &memStorage{}
+	storage.add(t, &pb.Transaction{FromChain: 1, Index: 7, TxHash: "0x01", Status: uint32(pb.TransactionStatus_TX_PENDING_USER_CLAIM)})
+	storage.add(t, &pb.Transaction{FromChain: 1, Index: 7, TxHash: "0x01", Status: uint32(pb.TransactionStatus_TX_CLAIMED)})
+	storage.add(t, &pb.Transaction{FromChain: 0, Index: 8, TxHash: "0x02", Status: uint32(pb.TransactionStatus_TX_CLAIMED)})
+	r := NewRelay(Config{BatchSize: 2, Retention: types.NewDuration(time.Hour)}, producer, storage, nil)
+
+	// the messages are pushed in order, a batch at a time, with the dedup key as request id
+	require.NoError(t, r.relayPending(ctx))
+	assert.Equal(t, 1, storage.committed)
+	pushed := producer.GetFakeMessages(testTopic)
+	require.Len(t, pushed, 2)
+	for i, key := range []string{"1-7-TX_PENDING_USER_CLAIM-0x01-1", "1-7-TX_CLAIMED-0x01-1"} {
+		var msg messagepush.PushMessage
+		require.NoError(t, json.Unmarshal([]byte(pushed[i]), &msg))
+		assert.Equal(t, key, msg.RequestID)
+		assert.Equal(t, uint(1), storage.messages[i].Attempts)
+		assert.NotNil(t, storage.messages[i].SentAt)
+	}
+	assert.Nil(t, storage.messages[2].SentAt)
+
+	require.NoError(t, r.relayPending(ctx))
+	pushed = producer.GetFakeMessages(testTopic)
+	require.Len(t, pushed, 1)
+	assert.Contains(t, pushed[0], "0x02")
+
+	// nothing is pending
+	require.NoError(t, r.relayPending(ctx))
+	assert.Empty(t, producer.GetFakeMessages(testTopic))
+	assert.Equal(t, 2, storage.committed)
+
+	// the messages pushed before the retention are deleted
+	sentAt := time.Now().Add(-2 * time.Hour)
+	storage.messages[0].SentAt = &sentAt
+	r.cleanUp(ctx)
+	assert.Len(t, storage.messages, 2)
+}
+
+func TestRelayUndecodableMessage(t *testing.T) {
+	ctx := context.Background()
+	producer, err := messagepush.NewKafkaProducer(messagepush.Config{UseFakeProducer: true, Topic: testTopic})
+	require.NoError(t, err)
+	storage := &memStorage{}
+	storage.add(t, &pb.Transaction{FromChain: 1, Index: 7, TxHash: "0x01", Status: uint32(pb.TransactionStatus_TX_CREATED)})
+	storage.messages[0].Payload = []byte("invalid")
+	storage.add(t, &pb.Transaction{FromChain: 0, Index: 8, TxHash: "0x02", Status: uint32(pb.TransactionStatus_TX_CLAIMED)})
+	r := NewRelay(Config{BatchSize: 2, Retention: types.NewDuration(time.Hour)}, producer, storage, nil)
+
+	// the message that can't be decoded is failed with the error and leaves the pending ones, the next is pushed
+	require.NoError(t, r.relayPending(ctx))
+	require.Len(t, producer.GetFakeMessages(testTopic), 1)
+	assert.NotNil(t, storage.messages[0].FailedAt)
+	assert.Nil(t, storage.messages[0].SentAt)
+	assert.Contains(t, storage.messages[0].LastError, "unmarshal transaction error")
+	assert.NotNil(t, storage.messages[1].SentAt)
+
+	require.NoError(t, r.relayPending(ctx))
+	assert.Empty(t, producer.GetFakeMessages(testTopic))
+
+	// the failed messages are deleted after the retention too
+	failedAt := time.Now().Add(-2 * time.Hour)
+	storage.messages[0].FailedAt = &failedAt
+	r.cleanUp(ctx)
+	assert.Len(t, storage.messages, 1)
+}
@@ -0,0 +1,68 @@
+package pushoutbox
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Message is a transaction update waiting in the outbox to be pushed. It's stored in the same db tx as the
+// change it notifies, so it's only pushed if that change is committed
+type Message struct {
+	ID uint64
+	// DedupKey identifies the transaction update, the same update is only stored once and it's pushed with
+	// it as request id so the consumers can drop the duplicates
+	DedupKey  string
+	Payload   []byte
+	Attempts  uint
+	LastError string
+	SentAt    *time.Time
+	// FailedAt is the time the message was dropped because it can't be pushed, it isn't retried
+	FailedAt  *time.Time
+	CreatedAt time.Time
+}
+
+// NewMessage returns the outbox message of the transaction update, blockID is the id of the block of the
+// deposit, or of the claim for the claimed updates
+func NewMessage(tx *pb.Transaction, blockID uint64) (*Message, error) {
+	b, err := protojson.Marshal(tx)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal transaction error")
+	}
+	return &Message{
+		DedupKey:  DedupKey(tx, blockID),
+		Payload:   b,
+		CreatedAt: time.Now().UTC(),
+	}, nil
+}
+
+// DedupKey returns the key of the status change of the deposit, with its source network and deposit count,
+// the hash of the tx making the change and the id of its block. The blocks get a new id when they're synced
+// again, so a deposit count reused after a reorg, or a claim confirmed again in another block, has a new key
+func DedupKey(tx *pb.Transaction, blockID uint64) string {
+	txHash := tx.TxHash
+	if tx.ClaimTxHash != "" {
+		txHash = tx.ClaimTxHash
+	}
+	return fmt.Sprintf("%d-%d-%s-%s-%d", tx.FromChain, tx.Index, pb.TransactionStatus(tx.Status).String(), txHash, blockID)
+}
+
+// Transaction returns the transaction update of the message
+func (m *Message) Transaction() (*pb.Transaction, error) {
+	tx := &pb.Transaction{}
+	if err := protojson.Unmarshal(m.Payload, tx); err != nil {
+		return nil, errors.Wrap(err, "unmarshal transaction error")
+	}
+	return tx, nil
+}
+
+// setLastError records the error of the message, truncated to the max length
+func (m *Message) setLastError(err error) {
+	m.LastError = err.Error()
+	if len(m.LastError) > maxErrorLength {
+		m.LastError = m.LastError[:maxErrorLength]
+	}
+}
@@ -21,6 +21,8 @@ type RedisStorage interface {
 	AddBlockDeposit(ctx context.Context, deposit *etherman.Deposit) error
 	DeleteBlockDeposit(ctx context.Context, deposit *etherman.Deposit) error
 	GetBlockDepositList(ctx context.Context, networkID uint, blockNum uint64) ([]*etherman.Deposit, error)
+	SetUnconfirmedDeposits(ctx context.Context, networkID uint, deposits []*etherman.Deposit) error
+	GetUnconfirmedDeposits(ctx context.Context, networkID uint) ([]*etherman.Deposit, error)
 
 	// General lock
 	TryLock(ctx context.Context, lockKey string) (success bool, err error)
@@ -55,6 +57,20 @@ type RedisStorage interface {
 	GetLargeTransactions(ctx context.Context, keySuffix string) ([]*pb.LargeTxInfo, error)
 	DelLargeTransactions(ctx context.Context, keySuffix string) error
 	ExpireLargeTransactions(ctx context.Context, key string, expiration time.Duration) (bool, error)
+
+	// rate limit storage
+	IncrRateLimit(ctx context.Context, keySuffix string, limit uint64, window time.Duration) (allowed bool, count int64, err error)
+	DecrRateLimit(ctx context.Context, keySuffix string, window time.Duration) error
+
+	// Lease storage, used for the leader election
+	AcquireLease(ctx context.Context, key string, holder string, ttl time.Duration) (bool, error)
+	RenewLease(ctx context.Context, key string, holder string, ttl time.Duration) (bool, error)
+	ReleaseLease(ctx context.Context, key string, holder string) error
+	IncrFencingToken(ctx context.Context, key string) (int64, error)
+
+	// Transaction updates channel, used to stream the transaction updates to the API instances
+	PublishTransactionUpdate(ctx context.Context, tx *pb.Transaction) error
+	SubscribeTransactionUpdates(ctx context.Context) (<-chan *pb.Transaction, error)
 }
 
 type RedisClient interface {
@@ -72,4 +88,9 @@ type RedisClient interface {
 	LLen(ctx context.Context, key string) *redis.IntCmd
 	LRange(ctx context.Context, key string, start, stop int64) *redis.StringSliceCmd
 	Expire(ctx context.Context, key string, expiration time.Duration) *redis.BoolCmd
+	Incr(ctx context.Context, key string) *redis.IntCmd
+	Decr(ctx context.Context, key string) *redis.IntCmd
+	Eval(ctx context.Context, script string, keys []string, args ...interface{}) *redis.Cmd
+	Publish(ctx context.Context, channel string, message interface{}) *redis.IntCmd
+	Subscribe(ctx context.Context, channels ...string) *redis.PubSub
 }
@@ -25,6 +25,9 @@ const (
 	l1BlockDepositListKey    = "bridge_block_deposits_%d_%d" // Params: networkID and block num
 	l1BlockDepositListExpiry = 24 * time.Hour
 
+	unconfirmedDepositsKey    = "bridge_unconfirmed_deposits_%d" // Params: networkID
+	unconfirmedDepositsExpiry = 1 * time.Hour
+
 	//batch info key
 	latestCommitBatchNumKey = "bridge_latest_commit_batch_num"
 	maxCommitBlockNumKey    = "bridge_max_commit_block_num"
@@ -41,6 +44,21 @@ const (
 	// large transaction cache key
 	largeTxInfosKey = "bridge_large_tx_infos_"
 
+	// rate limit counter key, params: the key suffix and the time window index
+	rateLimitKey = "bridge_rate_limit_%s_%d"
+
+	// leader lease keys
+	leaseKey             = "bridge_lease_"
+	leaseFencingTokenKey = "bridge_lease_fencing_token_"
+
+	// pub/sub channel of the transaction updates
+	transactionUpdatesChannel = "bridge_transaction_updates"
+	transactionUpdatesBuffer  = 100
+
+	// the lease is only renewed or released by its holder
+	renewLeaseScript   = `if redis.call("GET", KEYS[1]) == ARGV[1] then return redis.call("PEXPIRE", KEYS[1], ARGV[2]) else return 0 end`
+	releaseLeaseScript = `if redis.call("GET", KEYS[1]) == ARGV[1] then return redis.call("DEL", KEYS[1]) else return 0 end`
+
 	// Set a default expiration for locks to prevent a process from keeping the lock for too long
 	lockExpire = 1 * time.Minute
 
@@ -273,6 +291,39 @@ func (s *redisStorageImpl) GetBlockDepositList(ctx context.Context, networkID ui
 	return depositList, nil
 }
 
+// SetUnconfirmedDeposits replaces the deposits of the network that are newer than its sync target
+func (s *redisStorageImpl) SetUnconfirmedDeposits(ctx context.Context, networkID uint, deposits []*etherman.Deposit) error {
+	if s == nil || s.client == nil {
+		return errors.New("redis client is nil")
+	}
+	val, err := json.Marshal(deposits)
+	if err != nil {
+		return errors.Wrap(err, "json encode error")
+	}
+	err = s.client.Set(ctx, s.addKeyPrefix(fmt.Sprintf(unconfirmedDepositsKey, networkID)), string(val), unconfirmedDepositsExpiry).Err()
+	return errors.Wrap(err, "SetUnconfirmedDeposits error")
+}
+
+// GetUnconfirmedDeposits returns the deposits of the network that are newer than its sync target
+func (s *redisStorageImpl) GetUnconfirmedDeposits(ctx context.Context, networkID uint) ([]*etherman.Deposit, error) {
+	if s == nil || s.client == nil {
+		return nil, errors.New("redis client is nil")
+	}
+	res, err := s.client.Get(ctx, s.addKeyPrefix(fmt.Sprintf(unconfirmedDepositsKey, networkID))).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "GetUnconfirmedDeposits error")
+	}
+	var deposits []*etherman.Deposit
+	err = json.Unmarshal([]byte(res), &deposits)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetUnconfirmedDeposits json decode error")
+	}
+	return deposits, nil
+}
+
 func (s *redisStorageImpl) TryLock(ctx context.Context, lockKey string) (bool, error) {
 	if s == nil || s.client == nil {
 		return false, errors.New("redis client is nil")
@@ -554,3 +605,139 @@ func (s *redisStorageImpl) expireKeyFoundation(ctx context.Context, key string,
 	}
 	return s.client.Expire(ctx, key, expiration).Result()
 }
+
+// IncrRateLimit increments the counter of the rate limit in the current time window. If the counter
+// already reached the limit, it's not incremented and false is returned
+func (s *redisStorageImpl) IncrRateLimit(ctx context.Context, keySuffix string, limit uint64, window time.Duration) (bool, int64, error) {
+	if s == nil || s.client == nil {
+		return false, 0, errors.New("redis client is nil")
+	}
+	key := s.addKeyPrefix(getRateLimitKey(keySuffix, window))
+	count, err := s.client.Incr(ctx, key).Result()
+	if err != nil {
+		return false, 0, errors.Wrap(err, "Incr error")
+	}
+	if count == 1 {
+		s.client.Expire(ctx, key, window)
+	}
+	if uint64(count) > limit {
+		err = s.client.Decr(ctx, key).Err()
+		return false, count - 1, errors.Wrap(err, "Decr error")
+	}
+	return true, count, nil
+}
+
+// DecrRateLimit gives back a unit of the rate limit in the current time window
+func (s *redisStorageImpl) DecrRateLimit(ctx context.Context, keySuffix string, window time.Duration) error {
+	if s == nil || s.client == nil {
+		return errors.New("redis client is nil")
+	}
+	key := s.addKeyPrefix(getRateLimitKey(keySuffix, window))
+	count, err := s.client.Decr(ctx, key).Result()
+	if err != nil {
+		return errors.Wrap(err, "Decr error")
+	}
+	// the window may have changed since the counter was incremented
+	if count <= 0 {
+		return s.delFoundation(ctx, key)
+	}
+	return nil
+}
+
+func getRateLimitKey(keySuffix string, window time.Duration) string {
+	return fmt.Sprintf(rateLimitKey, keySuffix, time.Now().UnixNano()/int64(window))
+}
+
+// AcquireLease sets the holder of the lease if nobody holds it
+func (s *redisStorageImpl) AcquireLease(ctx context.Context, key string, holder string, ttl time.Duration) (bool, error) {
+	if s == nil || s.client == nil {
+		return false, errors.New("redis client is nil")
+	}
+	success, err := s.client.SetNX(ctx, s.addKeyPrefix(leaseKey+key), holder, ttl).Result()
+	return success, errors.Wrap(err, "SetNX error")
+}
+
+// RenewLease extends the lease, only if it's still held by the holder
+func (s *redisStorageImpl) RenewLease(ctx context.Context, key string, holder string, ttl time.Duration) (bool, error) {
+	if s == nil || s.client == nil {
+		return false, errors.New("redis client is nil")
+	}
+	res, err := s.client.Eval(ctx, renewLeaseScript, []string{s.addKeyPrefix(leaseKey + key)}, holder, ttl.Milliseconds()).Int64()
+	if err != nil {
+		return false, errors.Wrap(err, "RenewLease error")
+	}
+	return res == 1, nil
+}
+
+// ReleaseLease deletes the lease, only if it's still held by the holder
+func (s *redisStorageImpl) ReleaseLease(ctx context.Context, key string, holder string) error {
+	if s == nil || s.client == nil {
+		return errors.New("redis client is nil")
+	}
+	err := s.client.Eval(ctx, releaseLeaseScript, []string{s.addKeyPrefix(leaseKey + key)}, holder).Err()
+	return errors.Wrap(err, "ReleaseLease error")
+}
+
+// IncrFencingToken increments and returns the fencing token of the lease
+func (s *redisStorageImpl) IncrFencingToken(ctx context.Context, key string) (int64, error) {
+	if s == nil || s.client == nil {
+		return 0, errors.New("redis client is nil")
+	}
+	token, err := s.client.Incr(ctx, s.addKeyPrefix(leaseFencingTokenKey+key)).Result()
+	return token, errors.Wrap(err, "Incr error")
+}
+
+// PublishTransactionUpdate publishes the transaction update to the API instances streaming the transactions
+func (s *redisStorageImpl) PublishTransactionUpdate(ctx context.Context, tx *pb.Transaction) error {
+	if s == nil || s.client == nil {
+		return errors.New("redis client is nil")
+	}
+	b, err := protojson.Marshal(tx)
+	if err != nil {
+		return errors.Wrap(err, "marshal transaction error")
+	}
+	err = s.client.Publish(ctx, s.addKeyPrefix(transactionUpdatesChannel), b).Err()
+	return errors.Wrap(err, "Publish error")
+}
+
+// SubscribeTransactionUpdates returns a channel that receives the transaction updates published after the call,
+// it's closed when the context is done
+func (s *redisStorageImpl) SubscribeTransactionUpdates(ctx context.Context) (<-chan *pb.Transaction, error) {
+	if s == nil || s.client == nil {
+		return nil, errors.New("redis client is nil")
+	}
+	pubSub := s.client.Subscribe(ctx, s.addKeyPrefix(transactionUpdatesChannel))
+	// Wait for the subscription to be confirmed, so no update published after the call is missed
+	if _, err := pubSub.Receive(ctx); err != nil {
+		_ = pubSub.Close()
+		return nil, errors.Wrap(err, "Subscribe error")
+	}
+
+	ch := make(chan *pb.Transaction, transactionUpdatesBuffer)
+	go func() {
+		defer close(ch)
+		defer pubSub.Close()
+		msgs := pubSub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-msgs:
+				if !ok {
+					return
+				}
+				tx := &pb.Transaction{}
+				if err := protojson.Unmarshal([]byte(msg.Payload), tx); err != nil {
+					log.Errorf("unmarshal transaction update error: %v, payload: %v", err, msg.Payload)
+					continue
+				}
+				select {
+				case ch <- tx:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return ch, nil
+}
@@ -0,0 +1,109 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "bearer "
+)
+
+// adminMethods are the methods that can only be called with the token of an admin
+var adminMethods = map[string]bool{
+	"/bridge.v1.BridgeService/OperateMonitoredTx":       true,
+	"/bridge.v1.BridgeService/GetMonitoredTxOperations": true,
+	"/bridge.v1.BridgeService/GetReorgJournals":         true,
+}
+
+// integratorMethods are the methods that can only be called with the token of an integrator, they
+// only act on the webhook subscriptions owned by the caller
+var integratorMethods = map[string]bool{
+	"/bridge.v1.BridgeService/AddWebhookSubscription":    true,
+	"/bridge.v1.BridgeService/GetWebhookSubscriptions":   true,
+	"/bridge.v1.BridgeService/DeleteWebhookSubscription": true,
+	"/bridge.v1.BridgeService/GetWebhookDeliveries":      true,
+	"/bridge.v1.BridgeService/ReplayWebhookDelivery":     true,
+}
+
+// Credential identifies the caller of a restricted API by its bearer token
+type Credential struct {
+	// Name identifies the caller, it is recorded as the author of the changes it requests
+	Name string `mapstructure:"Name"`
+	// Token is the bearer token sent in the authorization header
+	Token string `mapstructure:"Token"`
+}
+
+// AuthConfig holds the credentials of the callers of the restricted APIs.
+// The restricted APIs are disabled if no credential is configured
+type AuthConfig struct {
+	// Admins are the operators allowed to act on the monitored txs and to read the reorg journals
+	Admins []Credential `mapstructure:"Admins"`
+	// Integrators are the callers allowed to manage their own webhook subscriptions
+	Integrators []Credential `mapstructure:"Integrators"`
+}
+
+type callerKey struct{}
+
+// callerFromContext returns the name of the authenticated caller of the request
+func callerFromContext(ctx context.Context) string {
+	caller, _ := ctx.Value(callerKey{}).(string)
+	return caller
+}
+
+// NewAuthInterceptor authenticates the requests to the admin and integrator methods with the bearer
+// token of the authorization header, and stores the name of the caller in the context of the request
+func NewAuthInterceptor(cfg AuthConfig) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		var credentials []Credential
+		switch {
+		case adminMethods[info.FullMethod]:
+			credentials = cfg.Admins
+		case integratorMethods[info.FullMethod]:
+			credentials = cfg.Integrators
+		default:
+			return handler(ctx, req)
+		}
+		caller, ok := authenticate(ctx, credentials)
+		if !ok {
+			log.Warnf("method[%v] unauthenticated request", info.FullMethod)
+			return nil, status.Error(codes.Unauthenticated, "invalid or missing token")
+		}
+		return handler(context.WithValue(ctx, callerKey{}, caller), req)
+	}
+}
+
+// authenticate returns the name of the credential matching the bearer token of the request
+func authenticate(ctx context.Context, credentials []Credential) (string, bool) {
+	token := bearerToken(ctx)
+	if token == "" {
+		return "", false
+	}
+	for _, c := range credentials {
+		if c.Name != "" && c.Token != "" && subtle.ConstantTimeCompare([]byte(c.Token), []byte(token)) == 1 {
+			return c.Name, true
+		}
+	}
+	return "", false
+}
+
+// bearerToken returns the token of the authorization header, the HTTP gateway forwards it as metadata
+func bearerToken(ctx context.Context) string {
+	headers, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	vals := headers.Get(authorizationHeader)
+	if len(vals) == 0 || len(vals[0]) <= len(bearerPrefix) || !strings.EqualFold(vals[0][:len(bearerPrefix)], bearerPrefix) {
+		return ""
+	}
+	return strings.TrimSpace(vals[0][len(bearerPrefix):])
+}
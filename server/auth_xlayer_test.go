@@ -0,0 +1,63 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthInterceptor(t *testing.T) {
+	interceptor := NewAuthInterceptor(AuthConfig{
+		Admins:      []Credential{{Name: "alice", Token: "secret"}},
+		Integrators: []Credential{{Name: "wallet", Token: "integrator"}, {Token: "nameless"}},
+	})
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return callerFromContext(ctx), nil
+	}
+	adminInfo := &grpc.UnaryServerInfo{FullMethod: "/bridge.v1.BridgeService/OperateMonitoredTx"}
+	withToken := func(value string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationHeader, value))
+	}
+
+	caller, err := interceptor(withToken("Bearer secret"), nil, adminInfo, handler)
+	require.NoError(t, err)
+	require.Equal(t, "alice", caller)
+
+	for _, ctx := range []context.Context{context.Background(), withToken("Bearer wrong"), withToken("secret"), withToken("Bearer ")} {
+		_, err = interceptor(ctx, nil, adminInfo, handler)
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	}
+
+	// the integrators can't call the admin methods, and the admins can't act on the webhook subscriptions
+	integratorInfo := &grpc.UnaryServerInfo{FullMethod: "/bridge.v1.BridgeService/GetWebhookSubscriptions"}
+	caller, err = interceptor(withToken("Bearer integrator"), nil, integratorInfo, handler)
+	require.NoError(t, err)
+	require.Equal(t, "wallet", caller)
+	_, err = interceptor(withToken("Bearer integrator"), nil, adminInfo, handler)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = interceptor(withToken("Bearer secret"), nil, integratorInfo, handler)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = interceptor(withToken("Bearer nameless"), nil, integratorInfo, handler)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// the reorg journals are only read by the admins
+	reorgsInfo := &grpc.UnaryServerInfo{FullMethod: "/bridge.v1.BridgeService/GetReorgJournals"}
+	_, err = interceptor(context.Background(), nil, reorgsInfo, handler)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	caller, err = interceptor(withToken("Bearer secret"), nil, reorgsInfo, handler)
+	require.NoError(t, err)
+	require.Equal(t, "alice", caller)
+
+	caller, err = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/bridge.v1.BridgeService/GetDeposits"}, handler)
+	require.NoError(t, err)
+	require.Equal(t, "", caller)
+
+	interceptor = NewAuthInterceptor(AuthConfig{})
+	_, err = interceptor(withToken("Bearer "), nil, adminInfo, handler)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
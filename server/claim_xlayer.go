@@ -0,0 +1,179 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/networkregistry"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// GetClaimCalldata builds the claim calldata of a deposit of any network, to be signed and sent by the user to the
+// bridge of the destination network. The calldata is only built when the deposit can be claimed, otherwise the
+// response says why it can't
+func (s *bridgeService) GetClaimCalldata(ctx context.Context, req *pb.GetClaimCalldataRequest) (*pb.CommonClaimCalldataResponse, error) {
+	deposit, err := s.getCalldataDeposit(ctx, req)
+	if err != nil {
+		msg := "failed to get deposit info"
+		if errors.Is(err, gerror.ErrStorageNotFound) {
+			msg = "deposit not found"
+		} else {
+			log.Errorf("failed to get deposit for the claim calldata, request: %+v, error: %v", req, err)
+		}
+		return &pb.CommonClaimCalldataResponse{
+			Code: uint32(pb.ErrorCode_ERROR_DEFAULT),
+			Msg:  msg,
+		}, nil
+	}
+	destNetwork, ok := networkregistry.Default().Get(deposit.DestinationNetwork)
+	if !ok {
+		return &pb.CommonClaimCalldataResponse{
+			Code: uint32(pb.ErrorCode_ERROR_DEFAULT),
+			Msg:  "destination network not registered",
+		}, nil
+	}
+	data := &pb.ClaimCalldata{
+		NetworkId:   uint32(deposit.NetworkID),
+		DepositCnt:  uint64(deposit.DepositCount),
+		DestNet:     uint32(deposit.DestinationNetwork),
+		DestChainId: uint64(destNetwork.ChainID),
+		To:          destNetwork.BridgeAddress.Hex(),
+		GlobalIndex: utils.GetGlobalIndex(deposit).String(),
+	}
+
+	// the claims of the deposits of every source network with the same deposit count are on the destination network
+	mainnetFlag := deposit.NetworkID == 0
+	var rollupIndex uint
+	if !mainnetFlag {
+		rollupIndex = deposit.NetworkID - 1
+	}
+	_, err = s.storage.GetClaimBySource(ctx, deposit.DepositCount, deposit.DestinationNetwork, mainnetFlag, rollupIndex, nil)
+	if err == nil {
+		data.Claimed = true
+		data.Reason = "the deposit is already claimed"
+		return &pb.CommonClaimCalldataResponse{Code: uint32(pb.ErrorCode_ERROR_OK), Data: data}, nil
+	} else if !errors.Is(err, gerror.ErrStorageNotFound) {
+		log.Errorf("failed to get the claim of deposit %d to network %d: %v", deposit.DepositCount, deposit.DestinationNetwork, err)
+		return &pb.CommonClaimCalldataResponse{
+			Code: uint32(pb.ErrorCode_ERROR_DEFAULT),
+			Msg:  "failed to get claim info",
+		}, nil
+	}
+	if !deposit.ReadyForClaim {
+		data.Reason = "the deposit is not ready for claim yet"
+		return &pb.CommonClaimCalldataResponse{Code: uint32(pb.ErrorCode_ERROR_OK), Data: data}, nil
+	}
+	if destNetwork.IsSovereign() {
+		// the claim is proved against the latest global exit root injected on the sovereign chain, which may
+		// not include the deposit yet
+		injected, err := s.storage.GetLatestSovereignExitRoot(ctx, deposit.DestinationNetwork, nil)
+		if errors.Is(err, gerror.ErrStorageNotFound) {
+			data.Reason = "no global exit root is injected on the destination network yet"
+			return &pb.CommonClaimCalldataResponse{Code: uint32(pb.ErrorCode_ERROR_OK), Data: data}, nil
+		}
+		var covered bool
+		if err == nil {
+			covered, err = s.exitRootCoversDeposit(ctx, injected, deposit)
+		}
+		if err != nil {
+			log.Errorf("failed to check the injected global exit root of network %d for deposit %d networkID %d: %v", deposit.DestinationNetwork, deposit.DepositCount, deposit.NetworkID, err)
+			return &pb.CommonClaimCalldataResponse{
+				Code: uint32(pb.ErrorCode_ERROR_DEFAULT),
+				Msg:  "failed to get the injected global exit root",
+			}, nil
+		}
+		if !covered {
+			data.Reason = "the deposit is not in the global exit root injected on the destination network yet"
+			return &pb.CommonClaimCalldataResponse{Code: uint32(pb.ErrorCode_ERROR_OK), Data: data}, nil
+		}
+	}
+
+	ger, proof, rollupProof, err := s.GetClaimProof(deposit.DepositCount, deposit.NetworkID, nil)
+	if err != nil {
+		log.Errorf("failed to get claim proof for deposit %v networkID %v: %v", deposit.DepositCount, deposit.NetworkID, err)
+		return &pb.CommonClaimCalldataResponse{
+			Code: uint32(pb.ErrorCode_ERROR_DEFAULT),
+			Msg:  "failed to get the claim proof",
+		}, nil
+	}
+	var smtProof, rollupSmtProof [mtHeight][bridgectrl.KeyLen]byte
+	for i := 0; i < mtHeight; i++ {
+		smtProof[i] = proof[i]
+		rollupSmtProof[i] = rollupProof[i]
+	}
+	method, calldata, err := utils.BuildClaimCalldata(deposit, smtProof, rollupSmtProof, ger)
+	if err != nil {
+		log.Errorf("failed to build the claim calldata of deposit %v networkID %v: %v", deposit.DepositCount, deposit.NetworkID, err)
+		return &pb.CommonClaimCalldataResponse{
+			Code: uint32(pb.ErrorCode_ERROR_DEFAULT),
+			Msg:  "failed to build the claim calldata",
+		}, nil
+	}
+	data.Ready = true
+	data.Method = method
+	data.Calldata = hexutil.Encode(calldata)
+	data.GlobalExitRoot = ger.GlobalExitRoot.Hex()
+	data.MainnetExitRoot = ger.ExitRoots[0].Hex()
+	data.RollupExitRoot = ger.ExitRoots[1].Hex()
+	return &pb.CommonClaimCalldataResponse{Code: uint32(pb.ErrorCode_ERROR_OK), Data: data}, nil
+}
+
+// exitRootCoversDeposit checks that the deposit is in the exit root of its network in the global exit root, the
+// mainnet exit root for the L1 deposits and the leaf of the rollup in the rollup exit root for the others
+func (s *bridgeService) exitRootCoversDeposit(ctx context.Context, ger *etherman.GlobalExitRoot, deposit *etherman.Deposit) (bool, error) {
+	root := ger.ExitRoots[0]
+	if deposit.NetworkID != 0 {
+		leaves, err := s.storage.GetRollupExitLeavesByRoot(ctx, ger.ExitRoots[1], nil)
+		if errors.Is(err, gerror.ErrStorageNotFound) {
+			return false, nil
+		} else if err != nil {
+			return false, err
+		}
+		root = common.Hash{}
+		for _, leaf := range leaves {
+			// The network ID of the networks settled on L1 is their rollup ID
+			if leaf.RollupId == deposit.NetworkID {
+				root = leaf.Leaf
+				break
+			}
+		}
+		if root == (common.Hash{}) {
+			return false, nil
+		}
+	}
+	depositCount, err := s.storage.GetDepositCountByRoot(ctx, root.Bytes(), uint8(deposit.NetworkID), nil)
+	if errors.Is(err, gerror.ErrStorageNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return depositCount >= deposit.DepositCount, nil
+}
+
+// getCalldataDeposit returns the deposit of the request, by tx hash and log index or by deposit count. The log index
+// is only checked when the tx has several deposits
+func (s *bridgeService) getCalldataDeposit(ctx context.Context, req *pb.GetClaimCalldataRequest) (*etherman.Deposit, error) {
+	if req.DepositTxHash == "" {
+		return s.storage.GetClaimDeposit(ctx, uint(req.DepositCnt), uint(req.NetworkId), nil)
+	}
+	deposits, err := s.storage.GetClaimDepositsByTxHash(ctx, uint(req.NetworkId), common.HexToHash(req.DepositTxHash), nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(deposits) == 1 {
+		return deposits[0], nil
+	}
+	for _, deposit := range deposits {
+		if deposit.LogIndex == uint(req.LogIndex) {
+			return deposit, nil
+		}
+	}
+	return nil, gerror.ErrStorageNotFound
+}
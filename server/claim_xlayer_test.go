@@ -0,0 +1,82 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/networkregistry"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCalldataStorage has a deposit of the rollup 1 to a sovereign chain, and the exit roots injected on it
+type fakeCalldataStorage struct {
+	bridgeServiceStorage
+
+	deposit       *etherman.Deposit
+	injected      *etherman.GlobalExitRoot
+	rollupLeaves  map[common.Hash][]etherman.RollupExitLeaf
+	depositCounts map[common.Hash]uint
+}
+
+func (s *fakeCalldataStorage) GetClaimDeposit(ctx context.Context, depositCnt, networkID uint, dbTx pgx.Tx) (*etherman.Deposit, error) {
+	return s.deposit, nil
+}
+
+func (s *fakeCalldataStorage) GetClaimBySource(ctx context.Context, depositCount, networkID uint, mainnetFlag bool, rollupIndex uint, dbTx pgx.Tx) (*etherman.Claim, error) {
+	return nil, gerror.ErrStorageNotFound
+}
+
+func (s *fakeCalldataStorage) GetLatestSovereignExitRoot(ctx context.Context, networkID uint, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error) {
+	return s.injected, nil
+}
+
+func (s *fakeCalldataStorage) GetRollupExitLeavesByRoot(ctx context.Context, root common.Hash, dbTx pgx.Tx) ([]etherman.RollupExitLeaf, error) {
+	return s.rollupLeaves[root], nil
+}
+
+func (s *fakeCalldataStorage) GetDepositCountByRoot(ctx context.Context, root []byte, network uint8, dbTx pgx.Tx) (uint, error) {
+	depositCount, ok := s.depositCounts[common.BytesToHash(root)]
+	if !ok {
+		return 0, gerror.ErrStorageNotFound
+	}
+	return depositCount, nil
+}
+
+func TestGetClaimCalldataSovereignExitRoot(t *testing.T) {
+	registry := networkregistry.NewRegistry()
+	require.NoError(t, registry.Add(networkregistry.Network{ID: 1, ChainID: 1}))
+	require.NoError(t, registry.Add(networkregistry.Network{ID: 2, ChainID: 2, GlobalExitRootAddress: common.HexToAddress("0x2")}))
+	defaultRegistry := networkregistry.Default()
+	networkregistry.InitDefault(registry)
+	defer networkregistry.InitDefault(defaultRegistry)
+
+	rollupExitRoot, rollupLeaf := common.HexToHash("0x10"), common.HexToHash("0x11")
+	storage := &fakeCalldataStorage{
+		deposit:       &etherman.Deposit{NetworkID: 1, DestinationNetwork: 2, DepositCount: 5, ReadyForClaim: true},
+		injected:      &etherman.GlobalExitRoot{ExitRoots: []common.Hash{{}, rollupExitRoot}},
+		rollupLeaves:  map[common.Hash][]etherman.RollupExitLeaf{rollupExitRoot: {{RollupId: 1, Leaf: rollupLeaf}}},
+		depositCounts: map[common.Hash]uint{rollupLeaf: 4},
+	}
+	s := &bridgeService{storage: storage}
+
+	// the deposit is ready on L1, but the global exit root injected on the sovereign chain doesn't include it yet
+	resp, err := s.GetClaimCalldata(context.Background(), &pb.GetClaimCalldataRequest{NetworkId: 1, DepositCnt: 5})
+	require.NoError(t, err)
+	require.Equal(t, uint32(pb.ErrorCode_ERROR_OK), resp.Code)
+	assert.False(t, resp.Data.Ready)
+	assert.Equal(t, "the deposit is not in the global exit root injected on the destination network yet", resp.Data.Reason)
+
+	covered, err := s.exitRootCoversDeposit(context.Background(), storage.injected, storage.deposit)
+	require.NoError(t, err)
+	assert.False(t, covered)
+	storage.depositCounts[rollupLeaf] = 5
+	covered, err = s.exitRootCoversDeposit(context.Background(), storage.injected, storage.deposit)
+	require.NoError(t, err)
+	assert.True(t, covered)
+}
@@ -25,4 +25,6 @@ type Config struct {
 	Redis redisstorage.Config `mapstructure:"Redis" apollo:"Redis"`
 	// SentinelConfigFilePath is the file path to store the sentinel config
 	SentinelConfigFilePath string `mapstructure:"SentinelConfigFilePath"`
+	// Auth holds the credentials of the callers of the restricted APIs
+	Auth AuthConfig `mapstructure:"Auth"`
 }
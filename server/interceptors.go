@@ -11,7 +11,9 @@ import (
 	"github.com/0xPolygonHermez/zkevm-bridge-service/metrics"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/server/iprestriction"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 )
@@ -96,14 +98,7 @@ func getRespErrorInfo(resp any, err error) (code int64, msg string) {
 
 func NewIPCheckInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		headers, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			log.Warnf("cannot get headers from incoming context, skipped checking IP")
-			return handler(ctx, req)
-		}
-		ip := getIPAddrFromHeaders(headers)
-		log.Debugf("method[%v] client IP: %v", info.FullMethod, ip)
-		if ip != "" && iprestriction.GetClient().CheckIPRestricted(ip) {
+		if isIPRestricted(ctx, info.FullMethod) {
 			// IP is restricted, need to block the request
 			return &pb.CommonResponse{
 				Code: uint32(pb.ErrorCode_ERROR_IP_RESTRICTED),
@@ -116,6 +111,28 @@ func NewIPCheckInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
+// NewIPCheckStreamInterceptor blocks the streams of the restricted IPs, the streams can't return a CommonResponse
+// so the restriction is returned as a PermissionDenied error
+func NewIPCheckStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if isIPRestricted(ss.Context(), info.FullMethod) {
+			return status.Error(codes.PermissionDenied, ipRestrictionErrorMsg)
+		}
+		return handler(srv, ss)
+	}
+}
+
+func isIPRestricted(ctx context.Context, method string) bool {
+	headers, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		log.Warnf("cannot get headers from incoming context, skipped checking IP")
+		return false
+	}
+	ip := getIPAddrFromHeaders(headers)
+	log.Debugf("method[%v] client IP: %v", method, ip)
+	return ip != "" && iprestriction.GetClient().CheckIPRestricted(ip)
+}
+
 func getIPAddrFromHeaders(headers metadata.MD) string {
 	ipHeaders := []string{"x-real-ip", "x-forwarded-for", "Proxy-Client-IP", "WL-Proxy-Client-IP"}
 
@@ -7,6 +7,7 @@ import (
 
 	ctmtypes "github.com/0xPolygonHermez/zkevm-bridge-service/claimtxman/types"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/webhook"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/jackc/pgx/v4"
 )
@@ -31,9 +32,21 @@ type bridgeServiceStorage interface {
 	GetPendingTransactions(ctx context.Context, destAddr string, limit uint, offset uint, messageAllowlist []common.Address, dbTx pgx.Tx) ([]*etherman.Deposit, error)
 	GetNotReadyTransactions(ctx context.Context, limit uint, offset uint, dbTx pgx.Tx) ([]*etherman.Deposit, error)
 	GetReadyPendingTransactions(ctx context.Context, networkID uint, limit uint, offset uint, minReadyTime time.Time, dbTx pgx.Tx) ([]*etherman.Deposit, error)
-	GetClaimTxById(ctx context.Context, id uint, dbTx pgx.Tx) (*ctmtypes.MonitoredTx, error)
+	GetClaimTxById(ctx context.Context, id uint, networkID uint, dbTx pgx.Tx) (*ctmtypes.MonitoredTx, error)
 	GetClaimTxsByStatusWithLimit(ctx context.Context, statuses []ctmtypes.MonitoredTxStatus, limit uint, offset uint, dbTx pgx.Tx) ([]ctmtypes.MonitoredTx, error)
+	AddClaimTxOperation(ctx context.Context, op ctmtypes.MonitoredTxOperation, dbTx pgx.Tx) (uint64, error)
+	GetClaimTxOperations(ctx context.Context, depositID uint, networkID uint, dbTx pgx.Tx) ([]ctmtypes.MonitoredTxOperation, error)
+	GetReorgJournals(ctx context.Context, networkID uint, limit, offset uint, dbTx pgx.Tx) ([]*etherman.ReorgJournal, error)
 	GetDepositsForUnitTest(ctx context.Context, destAddr string, limit uint, offset uint, dbTx pgx.Tx) ([]*etherman.Deposit, error)
 	GetBridgeBalance(ctx context.Context, originalTokenAddr common.Address, networkID uint, forUpdate bool, dbTx pgx.Tx) (*big.Int, error)
+	GetLatestSovereignExitRoot(ctx context.Context, networkID uint, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error)
+	GetClaimBySource(ctx context.Context, depositCount, networkID uint, mainnetFlag bool, rollupIndex uint, dbTx pgx.Tx) (*etherman.Claim, error)
+	GetClaimDeposit(ctx context.Context, depositCnt, networkID uint, dbTx pgx.Tx) (*etherman.Deposit, error)
+	GetClaimDepositsByTxHash(ctx context.Context, networkID uint, txHash common.Hash, dbTx pgx.Tx) ([]*etherman.Deposit, error)
 	SetBridgeBalance(ctx context.Context, originalTokenAddr common.Address, networkID uint, balance *big.Int, dbTx pgx.Tx) error
+	AddWebhookSubscription(ctx context.Context, sub *webhook.Subscription, dbTx pgx.Tx) (uint64, error)
+	DisableWebhookSubscription(ctx context.Context, id uint64, owner string, dbTx pgx.Tx) (bool, error)
+	GetWebhookSubscriptionsByOwner(ctx context.Context, owner string, dbTx pgx.Tx) ([]*webhook.Subscription, error)
+	GetWebhookDeliveries(ctx context.Context, subscriptionID uint64, owner string, status webhook.DeliveryStatus, limit, offset uint, dbTx pgx.Tx) ([]*webhook.Delivery, error)
+	ReplayWebhookDelivery(ctx context.Context, id uint64, owner string, dbTx pgx.Tx) (*webhook.Delivery, error)
 }
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/networkregistry"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/jackc/pgx/v4"
+)
+
+// AddNetwork adds an L2 network registered at runtime, its tree index is the next one
+func (s *bridgeService) AddNetwork(networkID uint, client *utils.Client, auth *bind.TransactOpts) {
+	s.networksMu.Lock()
+	defer s.networksMu.Unlock()
+	if _, found := s.networkIDs[networkID]; !found {
+		var tID uint8
+		for _, id := range s.networkIDs {
+			if id >= tID {
+				tID = id + 1
+			}
+		}
+		s.networkIDs[networkID] = tID
+	}
+	s.nodeClients[networkID] = client
+	s.auths[networkID] = auth
+}
+
+// RemoveNetwork removes the node client of an L2 network removed at runtime, so the manual claims to it are
+// rejected. The proofs of its synced deposits are still served
+func (s *bridgeService) RemoveNetwork(networkID uint) {
+	s.networksMu.Lock()
+	defer s.networksMu.Unlock()
+	delete(s.nodeClients, networkID)
+	delete(s.auths, networkID)
+}
+
+// networkList returns the IDs of the networks of the service
+func (s *bridgeService) networkList() []uint {
+	s.networksMu.RLock()
+	defer s.networksMu.RUnlock()
+	networks := make([]uint, 0, len(s.networkIDs))
+	for networkID := range s.networkIDs {
+		networks = append(networks, networkID)
+	}
+	return networks
+}
+
+// nodeClient returns the node client and the claim signer of the L2 network
+func (s *bridgeService) nodeClient(networkID uint) (*utils.Client, *bind.TransactOpts, bool) {
+	s.networksMu.RLock()
+	defer s.networksMu.RUnlock()
+	client, ok := s.nodeClients[networkID]
+	return client, s.auths[networkID], ok
+}
+
+// getClaimExitRoot returns the global exit root to claim the deposit. The deposits bridged to a sovereign chain are
+// claimed with the latest global exit root injected on the chain, the rest with the latest one of their origin
+func (s *bridgeService) getClaimExitRoot(ctx context.Context, depositCnt, networkID uint, isRollup bool, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error) {
+	deposit, err := s.storage.GetDeposit(ctx, depositCnt, networkID, dbTx)
+	if err != nil {
+		return nil, err
+	}
+	if n, ok := networkregistry.Default().Get(deposit.DestinationNetwork); ok && n.IsSovereign() {
+		return s.storage.GetLatestSovereignExitRoot(ctx, deposit.DestinationNetwork, dbTx)
+	}
+	return s.storage.GetLatestExitRoot(ctx, isRollup, dbTx)
+}
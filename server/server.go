@@ -27,6 +27,12 @@ const (
 	bridgeEndpointPath = "/priapi/v1/ob/bridge"
 )
 
+// jsonMarshalOptions encodes the responses of the HTTP gateway
+var jsonMarshalOptions = protojson.MarshalOptions{
+	UseProtoNames:   true,
+	EmitUnpopulated: true,
+}
+
 func RegisterNacos(cfg nacos.Config) {
 	var err error
 	if cfg.NacosUrls != "" {
@@ -52,7 +58,7 @@ func RunServer(cfg Config, bridgeService pb.BridgeServiceServer) error {
 	}()
 
 	go func() {
-		_ = runGRPCServer(ctx, bridgeService, cfg.GRPCPort)
+		_ = runGRPCServer(ctx, bridgeService, cfg.GRPCPort, cfg.Auth)
 	}()
 
 	return nil
@@ -85,7 +91,7 @@ func (s *healthChecker) Watch(req *grpc_health_v1.HealthCheckRequest, server grp
 	})
 }
 
-func runGRPCServer(ctx context.Context, bridgeServer pb.BridgeServiceServer, port string) error {
+func runGRPCServer(ctx context.Context, bridgeServer pb.BridgeServiceServer, port string, authCfg AuthConfig) error {
 	listen, err := net.Listen("tcp", ":"+port)
 	if err != nil {
 		return err
@@ -103,6 +109,9 @@ func runGRPCServer(ctx context.Context, bridgeServer pb.BridgeServiceServer, por
 		sentinelGrpc.NewUnaryServerInterceptor(sentinelGrpc.WithUnaryServerBlockFallback(blockErrFallbackFn)),
 		NewRequestLogInterceptor(),
 		NewIPCheckInterceptor(),
+		NewAuthInterceptor(authCfg),
+	), grpc.ChainStreamInterceptor(
+		NewIPCheckStreamInterceptor(),
 	))
 	pb.RegisterBridgeServiceServer(server, bridgeServer)
 
@@ -158,17 +167,18 @@ func runRestServer(ctx context.Context, grpcPort, httpPort string) error {
 
 	muxHealthOpt := runtime.WithHealthzEndpoint(grpc_health_v1.NewHealthClient(conn))
 	muxJSONOpt := runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
-		MarshalOptions: protojson.MarshalOptions{
-			UseProtoNames:   true,
-			EmitUnpopulated: true,
-		},
+		MarshalOptions: jsonMarshalOptions,
 		UnmarshalOptions: protojson.UnmarshalOptions{
 			DiscardUnknown: true,
 		},
 	})
 	mux := runtime.NewServeMux(muxJSONOpt, muxHealthOpt)
 
+	transactionStreamHandler := newTransactionStreamHandler(pb.NewBridgeServiceClient(conn))
+
 	httpMux := http.NewServeMux()
+	httpMux.Handle(bridgeEndpointPath+transactionStreamPath, transactionStreamHandler)
+	httpMux.Handle(transactionStreamPath, transactionStreamHandler)
 	httpMux.Handle(bridgeEndpointPath+"/", http.StripPrefix(bridgeEndpointPath, mux))
 	httpMux.Handle("/", mux)
 
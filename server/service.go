@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"sync"
 
 	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
@@ -12,9 +13,11 @@ import (
 	"github.com/0xPolygonHermez/zkevm-bridge-service/localcache"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/messagepush"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/priceoracle"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/redisstorage"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/utils"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/webhook"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	lru "github.com/hashicorp/golang-lru/v2"
@@ -38,6 +41,10 @@ type bridgeService struct {
 	nodeClients         map[uint]*utils.Client
 	auths               map[uint]*bind.TransactOpts
 	messagePushProducer messagepush.KafkaProducer
+	transactionHub      *messagepush.TransactionHub
+	priceOracle         *priceoracle.Oracle
+	webhookDispatcher   *webhook.Dispatcher
+	networksMu          sync.RWMutex
 }
 
 // NewBridgeService creates new bridge service.
@@ -71,6 +78,8 @@ func NewBridgeService(cfg Config, height uint8, networks []uint, l2Clients []*ut
 }
 
 func (s *bridgeService) getNetworkID(networkID uint) (uint8, error) {
+	s.networksMu.RLock()
+	defer s.networksMu.RUnlock()
 	tID, found := s.networkIDs[networkID]
 	if !found {
 		return 0, gerror.ErrNetworkNotRegister
@@ -191,11 +200,16 @@ func (s *bridgeService) GetClaimProof(depositCnt, networkID uint, dbTx pgx.Tx) (
 		return nil, nil, nil, err
 	}
 
-	globalExitRoot, err := s.storage.GetLatestExitRoot(ctx, tID != 0, dbTx)
+	globalExitRoot, err := s.getClaimExitRoot(ctx, depositCnt, networkID, tID != 0, dbTx)
 	if err != nil {
 		log.Errorf("get latest exit root failed fot network: %v, error: %v", networkID, err)
 		return nil, nil, nil, gerror.ErrInternalErrorForRpcCall
 	}
+	// The first exit root is the mainnet one and the second one the root of the exit roots of all the rollups
+	var exitRootIndex uint8
+	if tID != 0 {
+		exitRootIndex = 1
+	}
 
 	var (
 		merkleProof       [][bridgectrl.KeyLen]byte
@@ -203,14 +217,15 @@ func (s *bridgeService) GetClaimProof(depositCnt, networkID uint, dbTx pgx.Tx) (
 		rollupLeaf        common.Hash
 	)
 	if networkID == 0 { // Mainnet
-		merkleProof, err = s.getProof(depositCnt, globalExitRoot.ExitRoots[tID], dbTx)
+		merkleProof, err = s.getProof(depositCnt, globalExitRoot.ExitRoots[exitRootIndex], dbTx)
 		if err != nil {
 			log.Error("error getting merkleProof. Error: ", err)
 			return nil, nil, nil, fmt.Errorf("getting the proof failed, error: %v, network: %d", err, networkID)
 		}
 		rollupMerkleProof = emptyProof()
 	} else { // Rollup
-		rollupMerkleProof, rollupLeaf, err = s.getRollupExitProof(s.rollupID-1, globalExitRoot.ExitRoots[tID], dbTx)
+		// The network ID of the networks settled on L1 is their rollup ID
+		rollupMerkleProof, rollupLeaf, err = s.getRollupExitProof(networkID-1, globalExitRoot.ExitRoots[exitRootIndex], dbTx)
 		if err != nil {
 			log.Error("error getting rollupProof. Error: ", err)
 			return nil, nil, nil, fmt.Errorf("getting the rollup proof failed, error: %v, network: %d", err, networkID)
@@ -3,7 +3,9 @@ package server
 import (
 	"context"
 	"encoding/hex"
+	"fmt"
 	"math/big"
+	"sort"
 	"time"
 
 	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl"
@@ -15,12 +17,14 @@ import (
 	"github.com/0xPolygonHermez/zkevm-bridge-service/localcache"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/messagepush"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/priceoracle"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/pushtask"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/redisstorage"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/server/tokenlogoinfo"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/utils"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/messagebridge"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/redis/go-redis/v9"
 )
@@ -49,6 +53,11 @@ func (s *bridgeService) WithMessagePushProducer(producer messagepush.KafkaProduc
 	return s
 }
 
+func (s *bridgeService) WithPriceOracle(oracle *priceoracle.Oracle) *bridgeService {
+	s.priceOracle = oracle
+	return s
+}
+
 func (s *bridgeService) GetSmtProof(ctx context.Context, req *pb.GetSmtProofRequest) (*pb.CommonProofResponse, error) {
 	globalExitRoot, merkleProof, rollupMerkleProof, err := s.GetClaimProof(uint(req.Index), uint(req.FromChain), nil)
 	if err != nil || len(merkleProof) != len(rollupMerkleProof) {
@@ -103,6 +112,21 @@ func (s *bridgeService) GetCoinPrice(ctx context.Context, req *pb.GetCoinPriceRe
 	}, nil
 }
 
+// GetPriceSources returns the health of the price sources, the list is empty if the prices aren't aggregated
+// Bridge rest API endpoint
+func (s *bridgeService) GetPriceSources(ctx context.Context, req *pb.GetPriceSourcesRequest) (*pb.CommonPriceSourcesResponse, error) {
+	var data []*pb.PriceSourceHealth
+	if s.priceOracle != nil {
+		for _, health := range s.priceOracle.Health() {
+			data = append(data, health.ToPb())
+		}
+	}
+	return &pb.CommonPriceSourcesResponse{
+		Code: uint32(pb.ErrorCode_ERROR_OK),
+		Data: data,
+	}, nil
+}
+
 // GetMainCoins returns the info of the main coins in a network
 // Bridge rest API endpoint
 func (s *bridgeService) GetMainCoins(ctx context.Context, req *pb.GetMainCoinsRequest) (*pb.CommonCoinsResponse, error) {
@@ -166,13 +190,11 @@ func (s *bridgeService) GetPendingTransactions(ctx context.Context, req *pb.GetP
 		transaction.GlobalIndex = s.getGlobalIndex(deposit).String()
 		if deposit.ReadyForClaim {
 			transaction.Status = uint32(pb.TransactionStatus_TX_PENDING_USER_CLAIM)
-			// For L1->L2, if backend is trying to auto-claim, set the status to 0 to block the user from manual-claim
+			// If backend is trying to auto-claim, set the status to 0 to block the user from manual-claim
 			// When the auto-claim failed, set status to 1 to let the user claim manually through front-end
-			if deposit.NetworkID == 0 {
-				mTx, err := s.storage.GetClaimTxById(ctx, deposit.DepositCount, nil)
-				if err == nil && mTx.Status != ctmtypes.MonitoredTxStatusFailed {
-					transaction.Status = uint32(pb.TransactionStatus_TX_PENDING_AUTO_CLAIM)
-				}
+			mTx, err := s.storage.GetClaimTxById(ctx, deposit.DepositCount, deposit.DestinationNetwork, nil)
+			if err == nil && !mTx.Status.IsAutoClaimStopped() {
+				transaction.Status = uint32(pb.TransactionStatus_TX_PENDING_AUTO_CLAIM)
 			}
 		} else {
 			// For L1->L2, when ready_for_claim is false, but there have been more than 64 block confirmations,
@@ -274,13 +296,11 @@ func (s *bridgeService) GetAllTransactions(ctx context.Context, req *pb.GetAllTr
 						Msg:  errors.Wrap(err, "load claim error").Error(),
 					}, nil
 				}
-				// For L1->L2, if backend is trying to auto-claim, set the status to 0 to block the user from manual-claim
+				// If backend is trying to auto-claim, set the status to 0 to block the user from manual-claim
 				// When the auto-claim failed, set status to 1 to let the user claim manually through front-end
-				if deposit.NetworkID == 0 {
-					mTx, err := s.storage.GetClaimTxById(ctx, deposit.DepositCount, nil)
-					if err == nil && mTx.Status != ctmtypes.MonitoredTxStatusFailed {
-						transaction.Status = uint32(pb.TransactionStatus_TX_PENDING_AUTO_CLAIM)
-					}
+				mTx, err := s.storage.GetClaimTxById(ctx, deposit.DepositCount, deposit.DestinationNetwork, nil)
+				if err == nil && !mTx.Status.IsAutoClaimStopped() {
+					transaction.Status = uint32(pb.TransactionStatus_TX_PENDING_AUTO_CLAIM)
 				}
 			} else {
 				transaction.Status = uint32(pb.TransactionStatus_TX_CLAIMED) // Claimed
@@ -359,6 +379,52 @@ func (s *bridgeService) GetNotReadyTransactions(ctx context.Context, req *pb.Get
 	}, nil
 }
 
+// GetUnconfirmedTransactions returns the deposits to the address that are newer than the sync target of
+// their network. They are not stored yet, so they may be reorged and they can't be claimed
+func (s *bridgeService) GetUnconfirmedTransactions(ctx context.Context, req *pb.GetUnconfirmedTransactionsRequest) (*pb.CommonTransactionsResponse, error) {
+	destAddr := common.HexToAddress(req.DestAddr)
+	var deposits []*etherman.Deposit
+	for _, networkID := range s.networkList() {
+		networkDeposits, err := s.redisStorage.GetUnconfirmedDeposits(ctx, networkID)
+		if err != nil {
+			log.Errorf("get unconfirmed deposits from redis failed for network: %v, error: %v", networkID, err)
+			return &pb.CommonTransactionsResponse{
+				Code: uint32(pb.ErrorCode_ERROR_DEFAULT),
+				Msg:  gerror.ErrInternalErrorForRpcCall.Error(),
+			}, nil
+		}
+		for _, deposit := range networkDeposits {
+			if deposit.DestinationAddress == destAddr {
+				deposits = append(deposits, deposit)
+			}
+		}
+	}
+	sort.Slice(deposits, func(i, j int) bool {
+		return deposits[i].Time.After(deposits[j].Time)
+	})
+
+	var pbTransactions []*pb.Transaction
+	transactionMap := make(map[string][]*pb.Transaction)
+	for _, deposit := range deposits {
+		// replace contract address to real token address
+		messagebridge.ReplaceDepositInfo(deposit, false)
+		transaction := utils.EthermanDepositToPbTransaction(deposit)
+		transaction.EstimateTime = estimatetime.GetDefaultCalculator().Get(deposit.NetworkID)
+		transaction.Status = uint32(pb.TransactionStatus_TX_CREATED)
+		transaction.GlobalIndex = s.getGlobalIndex(deposit).String()
+		pbTransactions = append(pbTransactions, transaction)
+		chainId := utils.GetChainIdByNetworkId(deposit.OriginalNetwork)
+		logoCacheKey := tokenlogoinfo.GetTokenLogoMapKey(transaction.GetBridgeToken(), chainId)
+		transactionMap[logoCacheKey] = append(transactionMap[logoCacheKey], transaction)
+	}
+	tokenlogoinfo.FillLogoInfos(ctx, s.redisStorage, transactionMap)
+
+	return &pb.CommonTransactionsResponse{
+		Code: uint32(pb.ErrorCode_ERROR_OK),
+		Data: &pb.TransactionDetail{Transactions: pbTransactions},
+	}, nil
+}
+
 // GetMonitoredTxsByStatus returns list of monitored transactions, filtered by status
 func (s *bridgeService) GetMonitoredTxsByStatus(ctx context.Context, req *pb.GetMonitoredTxsByStatusRequest) (*pb.CommonMonitoredTxsResponse, error) {
 	limit := req.Limit
@@ -385,17 +451,19 @@ func (s *bridgeService) GetMonitoredTxsByStatus(ctx context.Context, req *pb.Get
 	var pbTransactions []*pb.MonitoredTx
 	for _, mTx := range mTxs {
 		transaction := &pb.MonitoredTx{
-			Id:        uint64(mTx.DepositID),
-			From:      mTx.From.String(),
-			To:        mTx.To.String(),
-			Nonce:     mTx.Nonce,
-			Value:     mTx.Value.String(),
-			Data:      "0x" + hex.EncodeToString(mTx.Data),
-			Gas:       mTx.Gas,
-			GasPrice:  mTx.GasPrice.String(),
-			Status:    string(mTx.Status),
-			CreatedAt: uint64(mTx.CreatedAt.UnixMilli()),
-			UpdatedAt: uint64(mTx.UpdatedAt.UnixMilli()),
+			Id:           uint64(mTx.DepositID),
+			From:         mTx.From.String(),
+			To:           mTx.To.String(),
+			Nonce:        mTx.Nonce,
+			Value:        mTx.Value.String(),
+			Data:         "0x" + hex.EncodeToString(mTx.Data),
+			Gas:          mTx.Gas,
+			GasPrice:     mTx.GasPrice.String(),
+			Status:       string(mTx.Status),
+			CreatedAt:    uint64(mTx.CreatedAt.UnixMilli()),
+			UpdatedAt:    uint64(mTx.UpdatedAt.UnixMilli()),
+			RevertReason: mTx.RevertReason,
+			NetworkId:    uint32(mTx.NetworkID),
 		}
 		for h := range mTx.History {
 			transaction.History = append(transaction.History, h.String())
@@ -409,6 +477,165 @@ func (s *bridgeService) GetMonitoredTxsByStatus(ctx context.Context, req *pb.Get
 	}, nil
 }
 
+// OperateMonitoredTx requests an operator action on the monitored tx of a deposit. The action is
+// executed asynchronously by the claim tx manager that owns the signer of the tx. The operator
+// recorded in the audit trail is the authenticated admin, see NewAuthInterceptor
+func (s *bridgeService) OperateMonitoredTx(ctx context.Context, req *pb.OperateMonitoredTxRequest) (*pb.CommonMonitoredTxOperationResponse, error) {
+	action := ctmtypes.MonitoredTxAction(req.Action)
+	if !action.IsValid() {
+		return &pb.CommonMonitoredTxOperationResponse{
+			Code: uint32(pb.ErrorCode_ERROR_DEFAULT),
+			Msg:  "invalid action, it must be requeue, cancel or resend",
+		}, nil
+	}
+	mTx, err := s.storage.GetClaimTxById(ctx, uint(req.Id), uint(req.NetworkId), nil)
+	if err != nil {
+		log.Errorf("get monitored tx failed for id: %v, error: %v", req.Id, err)
+		return &pb.CommonMonitoredTxOperationResponse{
+			Code: uint32(pb.ErrorCode_ERROR_DEFAULT),
+			Msg:  "failed to get the monitored tx",
+		}, nil
+	}
+	if !action.IsAllowed(mTx.Status) {
+		return &pb.CommonMonitoredTxOperationResponse{
+			Code: uint32(pb.ErrorCode_ERROR_DEFAULT),
+			Msg:  fmt.Sprintf("action %s is not allowed on a %s monitored tx", action, mTx.Status),
+		}, nil
+	}
+	ops, err := s.storage.GetClaimTxOperations(ctx, uint(req.Id), uint(req.NetworkId), nil)
+	if err != nil {
+		log.Errorf("get monitored tx operations failed for id: %v, error: %v", req.Id, err)
+		return &pb.CommonMonitoredTxOperationResponse{
+			Code: uint32(pb.ErrorCode_ERROR_DEFAULT),
+			Msg:  gerror.ErrInternalErrorForRpcCall.Error(),
+		}, nil
+	}
+	for _, op := range ops {
+		if op.Status == ctmtypes.MonitoredTxOperationStatusPending {
+			return &pb.CommonMonitoredTxOperationResponse{
+				Code: uint32(pb.ErrorCode_ERROR_DEFAULT),
+				Msg:  fmt.Sprintf("operation %d is still pending for the monitored tx", op.ID),
+			}, nil
+		}
+	}
+
+	op := ctmtypes.MonitoredTxOperation{
+		DepositID: uint(req.Id),
+		NetworkID: uint(req.NetworkId),
+		Action:    action,
+		Operator:  callerFromContext(ctx),
+		Reason:    req.Reason,
+		Status:    ctmtypes.MonitoredTxOperationStatusPending,
+	}
+	op.ID, err = s.storage.AddClaimTxOperation(ctx, op, nil)
+	if err != nil {
+		log.Errorf("add monitored tx operation failed for id: %v, error: %v", req.Id, err)
+		return &pb.CommonMonitoredTxOperationResponse{
+			Code: uint32(pb.ErrorCode_ERROR_DEFAULT),
+			Msg:  gerror.ErrInternalErrorForRpcCall.Error(),
+		}, nil
+	}
+	log.Infof("%s operation %d requested by %s for monitored tx %d, reason: %s", op.Action, op.ID, op.Operator, op.DepositID, op.Reason)
+	op.CreatedAt = time.Now()
+	op.UpdatedAt = op.CreatedAt
+	return &pb.CommonMonitoredTxOperationResponse{
+		Code: uint32(pb.ErrorCode_ERROR_OK),
+		Data: monitoredTxOperationToPb(op),
+	}, nil
+}
+
+// GetMonitoredTxOperations returns the audit trail of the operations requested on the monitored tx of a deposit
+func (s *bridgeService) GetMonitoredTxOperations(ctx context.Context, req *pb.GetMonitoredTxOperationsRequest) (*pb.CommonMonitoredTxOperationsResponse, error) {
+	ops, err := s.storage.GetClaimTxOperations(ctx, uint(req.Id), uint(req.NetworkId), nil)
+	if err != nil {
+		log.Errorf("get monitored tx operations failed for id: %v, error: %v", req.Id, err)
+		return &pb.CommonMonitoredTxOperationsResponse{
+			Code: uint32(pb.ErrorCode_ERROR_DEFAULT),
+			Msg:  gerror.ErrInternalErrorForRpcCall.Error(),
+		}, nil
+	}
+	var pbOps []*pb.MonitoredTxOperation
+	for _, op := range ops {
+		pbOps = append(pbOps, monitoredTxOperationToPb(op))
+	}
+	return &pb.CommonMonitoredTxOperationsResponse{
+		Code: uint32(pb.ErrorCode_ERROR_OK),
+		Data: pbOps,
+	}, nil
+}
+
+func monitoredTxOperationToPb(op ctmtypes.MonitoredTxOperation) *pb.MonitoredTxOperation {
+	pbOp := &pb.MonitoredTxOperation{
+		Id:        op.ID,
+		DepositId: uint64(op.DepositID),
+		NetworkId: uint32(op.NetworkID),
+		Action:    string(op.Action),
+		Operator:  op.Operator,
+		Reason:    op.Reason,
+		Status:    string(op.Status),
+		Result:    op.Result,
+		CreatedAt: uint64(op.CreatedAt.UnixMilli()),
+		UpdatedAt: uint64(op.UpdatedAt.UnixMilli()),
+	}
+	if op.TxHash != (common.Hash{}) {
+		pbOp.TxHash = op.TxHash.String()
+	}
+	return pbOp
+}
+
+// GetReorgJournals returns the journal of the reorgs of a network, newest first
+func (s *bridgeService) GetReorgJournals(ctx context.Context, req *pb.GetReorgJournalsRequest) (*pb.CommonReorgJournalsResponse, error) {
+	limit := req.Limit
+	if limit == 0 {
+		limit = s.defaultPageLimit.Get()
+	}
+	if limit > s.maxPageLimit.Get() {
+		limit = s.maxPageLimit.Get()
+	}
+	journals, err := s.storage.GetReorgJournals(ctx, uint(req.NetworkId), uint(limit), uint(req.Offset), nil)
+	if err != nil {
+		log.Errorf("get reorg journals failed for network: %v, error: %v", req.NetworkId, err)
+		return &pb.CommonReorgJournalsResponse{
+			Code: uint32(pb.ErrorCode_ERROR_DEFAULT),
+			Msg:  gerror.ErrInternalErrorForRpcCall.Error(),
+		}, nil
+	}
+	var pbJournals []*pb.ReorgJournal
+	for _, journal := range journals {
+		pbJournal := &pb.ReorgJournal{
+			Id:        journal.ID,
+			NetworkId: uint32(journal.NetworkID),
+			FromBlock: journal.FromBlock,
+			ToBlock:   journal.ToBlock,
+			Depth:     journal.Depth,
+			CreatedAt: uint64(journal.CreatedAt.UnixMilli()),
+		}
+		for _, deposit := range journal.Deposits {
+			transaction := utils.EthermanDepositToPbTransaction(deposit)
+			transaction.Status = uint32(pb.TransactionStatus_TX_DROPPED)
+			pbJournal.Deposits = append(pbJournal.Deposits, transaction)
+		}
+		for _, claim := range journal.Claims {
+			pbJournal.Claims = append(pbJournal.Claims, &pb.ReorgedClaim{
+				Index:           uint64(claim.Index),
+				OriginalNetwork: uint32(claim.OriginalNetwork),
+				DestAddr:        claim.DestinationAddress.Hex(),
+				Amount:          claim.Amount.String(),
+				BlockNumber:     claim.BlockNumber,
+				TxHash:          claim.TxHash.String(),
+			})
+		}
+		for _, ger := range journal.GlobalExitRoots {
+			pbJournal.GlobalExitRoots = append(pbJournal.GlobalExitRoots, ger.GlobalExitRoot.String())
+		}
+		pbJournals = append(pbJournals, pbJournal)
+	}
+	return &pb.CommonReorgJournalsResponse{
+		Code: uint32(pb.ErrorCode_ERROR_OK),
+		Data: pbJournals,
+	}, nil
+}
+
 // GetEstimateTime returns the estimated deposit waiting time for L1 and L2
 func (s *bridgeService) GetEstimateTime(ctx context.Context, req *pb.GetEstimateTimeRequest) (*pb.CommonEstimateTimeResponse, error) {
 	return &pb.CommonEstimateTimeResponse{
@@ -460,7 +687,7 @@ func (s *bridgeService) ManualClaim(ctx context.Context, req *pb.ManualClaimRequ
 	}
 
 	destNet := deposit.DestinationNetwork
-	client, ok := s.nodeClients[destNet]
+	client, auth, ok := s.nodeClient(destNet)
 	if !ok || client == nil {
 		log.Errorf("node client for networkID %v not found", destNet)
 		return &pb.CommonManualClaimResponse{
@@ -481,7 +708,7 @@ func (s *bridgeService) ManualClaim(ctx context.Context, req *pb.ManualClaimRequ
 		mtRollupProves[i] = rollupProves[i]
 	}
 	// Send claim transaction to the node
-	tx, err := client.SendClaimXLayer(ctx, deposit, mtProves, mtRollupProves, ger, s.rollupID, s.auths[destNet])
+	tx, err := client.SendClaimXLayer(ctx, deposit, mtProves, mtRollupProves, ger, s.rollupID, auth)
 	if err != nil {
 		log.Errorf("failed to send claim transaction: %v", err)
 		return &pb.CommonManualClaimResponse{
@@ -538,10 +765,7 @@ func (s *bridgeService) GetReadyPendingTransactions(ctx context.Context, req *pb
 }
 
 func (s *bridgeService) getGlobalIndex(deposit *etherman.Deposit) *big.Int {
-	mainnetFlag := deposit.NetworkID == 0
-	rollupIndex := s.rollupID - 1
-	localExitRootIndex := deposit.DepositCount
-	return etherman.GenerateGlobalIndex(mainnetFlag, rollupIndex, localExitRootIndex)
+	return utils.GetGlobalIndex(deposit)
 }
 
 func (s *bridgeService) GetFakePushMessages(ctx context.Context, req *pb.GetFakePushMessagesRequest) (*pb.GetFakePushMessagesResponse, error) {
@@ -0,0 +1,146 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/messagepush"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	transactionStreamPath = "/transactions/subscribe"
+)
+
+func (s *bridgeService) WithTransactionHub(hub *messagepush.TransactionHub) *bridgeService {
+	s.transactionHub = hub
+	return s
+}
+
+// SubscribeTransactions streams the status changes of the transactions of a destination address or a tx hash
+func (s *bridgeService) SubscribeTransactions(req *pb.SubscribeTransactionsRequest, stream pb.BridgeService_SubscribeTransactionsServer) error {
+	if s.transactionHub == nil {
+		return status.Error(codes.Unimplemented, "transaction stream is disabled")
+	}
+	filter := messagepush.TransactionFilter{DestAddr: req.DestAddr, TxHash: req.TxHash}
+	if filter.DestAddr != "" && !common.IsHexAddress(filter.DestAddr) {
+		return status.Errorf(codes.InvalidArgument, "invalid destAddr: %v", filter.DestAddr)
+	}
+	if filter.DestAddr == "" && len(common.FromHex(filter.TxHash)) != common.HashLength {
+		return status.Error(codes.InvalidArgument, "destAddr or txHash is required")
+	}
+	sub, ok := s.transactionHub.Subscribe(filter)
+	if !ok {
+		return status.Error(codes.ResourceExhausted, "too many transaction subscriptions")
+	}
+	defer sub.Close()
+	// The header tells the clients that the subscription is accepted before the first update
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case tx, ok := <-sub.Updates():
+			if !ok {
+				return status.Error(codes.Unavailable, "transaction subscription closed")
+			}
+			if err := stream.Send(tx); err != nil {
+				log.Debugf("SubscribeTransactions send error: %v, filter: %+v", err, filter)
+				return err
+			}
+		}
+	}
+}
+
+// newTransactionStreamHandler bridges SubscribeTransactions to server-sent events, each transaction update is
+// sent as an event with its JSON encoding
+func newTransactionStreamHandler(client pb.BridgeServiceClient) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+			return
+		}
+		query := r.URL.Query()
+		req := &pb.SubscribeTransactionsRequest{DestAddr: query.Get("destAddr"), TxHash: query.Get("txHash")}
+		stream, err := client.SubscribeTransactions(withClientIPMetadata(r), req)
+		if err != nil {
+			writeStreamError(w, err)
+			return
+		}
+		// The header is nil when the subscription is rejected, the error is returned by Recv
+		if md, _ := stream.Header(); md == nil {
+			_, err = stream.Recv()
+			writeStreamError(w, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+		for {
+			tx, err := stream.Recv()
+			if err != nil {
+				if r.Context().Err() == nil {
+					log.Debugf("transaction stream closed, error: %v", err)
+					_, _ = fmt.Fprintf(w, "event: error\ndata: %s\n\n", status.Convert(err).Message())
+					flusher.Flush()
+				}
+				return
+			}
+			b, err := jsonMarshalOptions.Marshal(tx)
+			if err != nil {
+				log.Errorf("marshal transaction update error: %v", err)
+				continue
+			}
+			if _, err = fmt.Fprintf(w, "data: %s\n\n", b); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	})
+}
+
+// withClientIPMetadata forwards the client IP headers to the gRPC server, like the HTTP gateway does, so the
+// IP restriction applies to the streams
+func withClientIPMetadata(r *http.Request) context.Context {
+	ctx := r.Context()
+	if ip := r.Header.Get("X-Real-Ip"); ip != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-real-ip", ip)
+	}
+	forwardedFor := r.Header.Get("X-Forwarded-For")
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		if forwardedFor == "" {
+			forwardedFor = host
+		} else {
+			forwardedFor = forwardedFor + ", " + host
+		}
+	}
+	if forwardedFor != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-forwarded-for", forwardedFor)
+	}
+	return ctx
+}
+
+func writeStreamError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	http.Error(w, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+}
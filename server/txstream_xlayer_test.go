@@ -0,0 +1,77 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/messagepush"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func newTransactionStreamClient(t *testing.T, s *bridgeService) pb.BridgeServiceClient {
+	listen, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	pb.RegisterBridgeServiceServer(server, s)
+	go func() {
+		_ = server.Serve(listen)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial(listen.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return pb.NewBridgeServiceClient(conn)
+}
+
+func TestTransactionStreamHandler(t *testing.T) {
+	hub := messagepush.NewTransactionHub()
+	client := newTransactionStreamClient(t, (&bridgeService{}).WithTransactionHub(hub))
+	srv := httptest.NewServer(newTransactionStreamHandler(client))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + transactionStreamPath)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+transactionStreamPath+"?destAddr=0x000000000000000000000000000000000000000A", nil)
+	require.NoError(t, err)
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	// the subscription is in the hub once the response headers are received
+	hub.Publish(&pb.Transaction{TxHash: "0x01", DestAddr: "0x000000000000000000000000000000000000000b"})
+	hub.Publish(&pb.Transaction{TxHash: "0x02", DestAddr: "0x000000000000000000000000000000000000000a", Status: uint32(pb.TransactionStatus_TX_CLAIMED)})
+	line, err := bufio.NewReader(resp.Body).ReadString('\n')
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(line, "data: "))
+	assert.Contains(t, line, `"txHash":"0x02"`)
+	assert.Contains(t, line, `"status":2`)
+}
+
+func TestSubscribeTransactionsDisabled(t *testing.T) {
+	client := newTransactionStreamClient(t, &bridgeService{})
+	srv := httptest.NewServer(newTransactionStreamHandler(client))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + transactionStreamPath + "?txHash=0x0000000000000000000000000000000000000000000000000000000000000001")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotImplemented, resp.StatusCode)
+}
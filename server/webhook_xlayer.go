@@ -0,0 +1,248 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/webhook"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	webhookSecretLength = 32
+)
+
+// WithWebhookDispatcher sets the dispatcher checking the urls of the webhook subscriptions, the subscriptions
+// can't be added without it
+func (s *bridgeService) WithWebhookDispatcher(dispatcher *webhook.Dispatcher) *bridgeService {
+	s.webhookDispatcher = dispatcher
+	return s
+}
+
+// AddWebhookSubscription adds a webhook subscription owned by the caller. The dispatchers pick it up when they
+// reload the subscriptions, so the first updates can take up to the refresh interval to be delivered
+func (s *bridgeService) AddWebhookSubscription(ctx context.Context, req *pb.AddWebhookSubscriptionRequest) (*pb.CommonWebhookSubscriptionResponse, error) {
+	if s.webhookDispatcher == nil {
+		return &pb.CommonWebhookSubscriptionResponse{
+			Code: uint32(pb.ErrorCode_ERROR_DEFAULT),
+			Msg:  "webhook delivery is disabled",
+		}, nil
+	}
+	err := s.webhookDispatcher.CheckURL(ctx, req.Url)
+	if err != nil {
+		msg := err.Error()
+		if !errors.Is(err, webhook.ErrInvalidURL) && !errors.Is(err, webhook.ErrPrivateHost) {
+			msg = "the host of the url can't be resolved"
+		}
+		return &pb.CommonWebhookSubscriptionResponse{
+			Code: uint32(pb.ErrorCode_ERROR_DEFAULT),
+			Msg:  msg,
+		}, nil
+	}
+	destAddr, ok := webhookFilterAddress(req.DestAddr)
+	if !ok {
+		return &pb.CommonWebhookSubscriptionResponse{
+			Code: uint32(pb.ErrorCode_ERROR_DEFAULT),
+			Msg:  "invalid destAddr",
+		}, nil
+	}
+	tokenAddr, ok := webhookFilterAddress(req.TokenAddr)
+	if !ok {
+		return &pb.CommonWebhookSubscriptionResponse{
+			Code: uint32(pb.ErrorCode_ERROR_DEFAULT),
+			Msg:  "invalid tokenAddr",
+		}, nil
+	}
+	if destAddr == "" && tokenAddr == "" {
+		return &pb.CommonWebhookSubscriptionResponse{
+			Code: uint32(pb.ErrorCode_ERROR_DEFAULT),
+			Msg:  "destAddr or tokenAddr is required",
+		}, nil
+	}
+	sub := &webhook.Subscription{
+		URL:       req.Url,
+		Secret:    req.Secret,
+		Owner:     callerFromContext(ctx),
+		DestAddr:  destAddr,
+		TokenAddr: tokenAddr,
+		Enabled:   true,
+		CreatedAt: time.Now().UTC(),
+	}
+	for _, networkID := range req.NetworkIds {
+		sub.NetworkIDs = append(sub.NetworkIDs, uint(networkID))
+	}
+	if sub.Secret == "" {
+		secret := make([]byte, webhookSecretLength)
+		if _, err = rand.Read(secret); err != nil {
+			log.Errorf("generate webhook secret failed, error: %v", err)
+			return &pb.CommonWebhookSubscriptionResponse{
+				Code: uint32(pb.ErrorCode_ERROR_DEFAULT),
+				Msg:  gerror.ErrInternalErrorForRpcCall.Error(),
+			}, nil
+		}
+		sub.Secret = hex.EncodeToString(secret)
+	}
+
+	sub.ID, err = s.storage.AddWebhookSubscription(ctx, sub, nil)
+	if err != nil {
+		log.Errorf("add webhook subscription failed for url: %v, error: %v", req.Url, err)
+		return &pb.CommonWebhookSubscriptionResponse{
+			Code: uint32(pb.ErrorCode_ERROR_DEFAULT),
+			Msg:  gerror.ErrInternalErrorForRpcCall.Error(),
+		}, nil
+	}
+	log.Infof("webhook subscription %d added by %s for url: %v", sub.ID, sub.Owner, sub.URL)
+	pbSub := webhookSubscriptionToPb(sub)
+	// The secret is only returned once, the subscriber needs it to verify the signatures
+	pbSub.Secret = sub.Secret
+	return &pb.CommonWebhookSubscriptionResponse{
+		Code: uint32(pb.ErrorCode_ERROR_OK),
+		Data: pbSub,
+	}, nil
+}
+
+// GetWebhookSubscriptions returns the enabled webhook subscriptions of the caller, without their secrets
+func (s *bridgeService) GetWebhookSubscriptions(ctx context.Context, req *pb.GetWebhookSubscriptionsRequest) (*pb.CommonWebhookSubscriptionsResponse, error) {
+	subs, err := s.storage.GetWebhookSubscriptionsByOwner(ctx, callerFromContext(ctx), nil)
+	if err != nil {
+		log.Errorf("get webhook subscriptions failed, error: %v", err)
+		return &pb.CommonWebhookSubscriptionsResponse{
+			Code: uint32(pb.ErrorCode_ERROR_DEFAULT),
+			Msg:  gerror.ErrInternalErrorForRpcCall.Error(),
+		}, nil
+	}
+	var pbSubs []*pb.WebhookSubscription
+	for _, sub := range subs {
+		pbSubs = append(pbSubs, webhookSubscriptionToPb(sub))
+	}
+	return &pb.CommonWebhookSubscriptionsResponse{
+		Code: uint32(pb.ErrorCode_ERROR_OK),
+		Data: pbSubs,
+	}, nil
+}
+
+// DeleteWebhookSubscription disables a webhook subscription of the caller, its pending deliveries are marked as failed
+func (s *bridgeService) DeleteWebhookSubscription(ctx context.Context, req *pb.DeleteWebhookSubscriptionRequest) (*pb.CommonResponse, error) {
+	disabled, err := s.storage.DisableWebhookSubscription(ctx, req.Id, callerFromContext(ctx), nil)
+	if err != nil {
+		log.Errorf("disable webhook subscription failed for id: %v, error: %v", req.Id, err)
+		return &pb.CommonResponse{
+			Code: uint32(pb.ErrorCode_ERROR_DEFAULT),
+			Msg:  gerror.ErrInternalErrorForRpcCall.Error(),
+		}, nil
+	}
+	if !disabled {
+		return &pb.CommonResponse{
+			Code: uint32(pb.ErrorCode_ERROR_DEFAULT),
+			Msg:  "webhook subscription not found",
+		}, nil
+	}
+	log.Infof("webhook subscription %d disabled", req.Id)
+	return &pb.CommonResponse{
+		Code: uint32(pb.ErrorCode_ERROR_OK),
+	}, nil
+}
+
+// GetWebhookDeliveries returns the delivery log of a webhook subscription of the caller, newest first
+func (s *bridgeService) GetWebhookDeliveries(ctx context.Context, req *pb.GetWebhookDeliveriesRequest) (*pb.CommonWebhookDeliveriesResponse, error) {
+	status := webhook.DeliveryStatus(req.Status)
+	if status != "" && !status.IsValid() {
+		return &pb.CommonWebhookDeliveriesResponse{
+			Code: uint32(pb.ErrorCode_ERROR_DEFAULT),
+			Msg:  "invalid status, it must be pending, delivered or failed",
+		}, nil
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = s.defaultPageLimit.Get()
+	}
+	if limit > s.maxPageLimit.Get() {
+		limit = s.maxPageLimit.Get()
+	}
+	deliveries, err := s.storage.GetWebhookDeliveries(ctx, req.SubscriptionId, callerFromContext(ctx), status, uint(limit), uint(req.Offset), nil)
+	if err != nil {
+		log.Errorf("get webhook deliveries failed for subscription: %v, error: %v", req.SubscriptionId, err)
+		return &pb.CommonWebhookDeliveriesResponse{
+			Code: uint32(pb.ErrorCode_ERROR_DEFAULT),
+			Msg:  gerror.ErrInternalErrorForRpcCall.Error(),
+		}, nil
+	}
+	var pbDeliveries []*pb.WebhookDelivery
+	for _, delivery := range deliveries {
+		pbDeliveries = append(pbDeliveries, webhookDeliveryToPb(delivery))
+	}
+	return &pb.CommonWebhookDeliveriesResponse{
+		Code: uint32(pb.ErrorCode_ERROR_OK),
+		Data: pbDeliveries,
+	}, nil
+}
+
+// ReplayWebhookDelivery sends a webhook delivery of a subscription of the caller again on the next poll of the
+// dispatchers, whatever its status
+func (s *bridgeService) ReplayWebhookDelivery(ctx context.Context, req *pb.ReplayWebhookDeliveryRequest) (*pb.CommonWebhookDeliveryResponse, error) {
+	delivery, err := s.storage.ReplayWebhookDelivery(ctx, req.Id, callerFromContext(ctx), nil)
+	if errors.Is(err, gerror.ErrStorageNotFound) {
+		return &pb.CommonWebhookDeliveryResponse{
+			Code: uint32(pb.ErrorCode_ERROR_DEFAULT),
+			Msg:  "webhook delivery not found",
+		}, nil
+	} else if err != nil {
+		log.Errorf("replay webhook delivery failed for id: %v, error: %v", req.Id, err)
+		return &pb.CommonWebhookDeliveryResponse{
+			Code: uint32(pb.ErrorCode_ERROR_DEFAULT),
+			Msg:  gerror.ErrInternalErrorForRpcCall.Error(),
+		}, nil
+	}
+	log.Infof("webhook delivery %d of subscription %d replayed", delivery.ID, delivery.SubscriptionID)
+	return &pb.CommonWebhookDeliveryResponse{
+		Code: uint32(pb.ErrorCode_ERROR_OK),
+		Data: webhookDeliveryToPb(delivery),
+	}, nil
+}
+
+// webhookFilterAddress returns the checksummed address of a filter, empty if there's no filter
+func webhookFilterAddress(addr string) (string, bool) {
+	if addr == "" {
+		return "", true
+	}
+	if !common.IsHexAddress(addr) {
+		return "", false
+	}
+	return common.HexToAddress(addr).Hex(), true
+}
+
+func webhookSubscriptionToPb(sub *webhook.Subscription) *pb.WebhookSubscription {
+	pbSub := &pb.WebhookSubscription{
+		Id:        sub.ID,
+		Url:       sub.URL,
+		DestAddr:  sub.DestAddr,
+		TokenAddr: sub.TokenAddr,
+		CreatedAt: uint64(sub.CreatedAt.UnixMilli()),
+	}
+	for _, networkID := range sub.NetworkIDs {
+		pbSub.NetworkIds = append(pbSub.NetworkIds, uint32(networkID))
+	}
+	return pbSub
+}
+
+func webhookDeliveryToPb(delivery *webhook.Delivery) *pb.WebhookDelivery {
+	return &pb.WebhookDelivery{
+		Id:             delivery.ID,
+		SubscriptionId: delivery.SubscriptionID,
+		Event:          delivery.Event,
+		Payload:        string(delivery.Payload),
+		Status:         string(delivery.Status),
+		Attempts:       uint32(delivery.Attempts),
+		ResponseCode:   int32(delivery.ResponseCode),
+		LastError:      delivery.LastError,
+		NextAttemptAt:  uint64(delivery.NextAttemptAt.UnixMilli()),
+		CreatedAt:      uint64(delivery.CreatedAt.UnixMilli()),
+		UpdatedAt:      uint64(delivery.UpdatedAt.UnixMilli()),
+	}
+}
@@ -0,0 +1,115 @@
+package snapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const manifestFile = "manifest.json"
+
+// writeArchive writes the gzipped tar archive with the manifest followed by the data file of each table
+func writeArchive(w io.Writer, manifest *Manifest, files []string) error {
+	if len(files) != len(manifest.Tables) {
+		return fmt.Errorf("%d data files for %d tables", len(files), len(manifest.Tables))
+	}
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	b, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to encode the manifest")
+	}
+	err = tw.WriteHeader(&tar.Header{Name: manifestFile, Mode: 0644, Size: int64(len(b)), ModTime: manifest.CreatedAt}) //nolint:gomnd
+	if err != nil {
+		return err
+	}
+	if _, err = tw.Write(b); err != nil {
+		return err
+	}
+	for i, table := range manifest.Tables {
+		err = writeArchiveFile(tw, table.File, files[i], manifest.CreatedAt)
+		if err != nil {
+			return errors.Wrapf(err, "failed to archive table %s", table.Name)
+		}
+	}
+	if err = tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+func writeArchiveFile(tw *tar.Writer, name, path string, modTime time.Time) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	err = tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: info.Size(), ModTime: modTime}) //nolint:gomnd
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(tw, f)
+	return err
+}
+
+// archiveReader reads the archive written by writeArchive, the manifest first and then the tables in order
+type archiveReader struct {
+	gr       *gzip.Reader
+	tr       *tar.Reader
+	manifest *Manifest
+	next     int
+}
+
+func newArchiveReader(r io.Reader) (*archiveReader, error) {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "the snapshot is not a gzipped archive")
+	}
+	tr := tar.NewReader(gr)
+	header, err := tr.Next()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read the manifest")
+	}
+	if header.Name != manifestFile {
+		return nil, fmt.Errorf("the snapshot must start with the manifest, found %s", header.Name)
+	}
+	var manifest Manifest
+	if err = json.NewDecoder(tr).Decode(&manifest); err != nil {
+		return nil, errors.Wrap(err, "failed to decode the manifest")
+	}
+	if manifest.Version != Version {
+		return nil, fmt.Errorf("unsupported snapshot version %d, expected %d", manifest.Version, Version)
+	}
+	return &archiveReader{gr: gr, tr: tr, manifest: &manifest}, nil
+}
+
+// nextTable returns the next table of the manifest and the reader of its data, io.EOF after the last one
+func (a *archiveReader) nextTable() (TableEntry, io.Reader, error) {
+	if a.next >= len(a.manifest.Tables) {
+		return TableEntry{}, nil, io.EOF
+	}
+	table := a.manifest.Tables[a.next]
+	header, err := a.tr.Next()
+	if err != nil {
+		return TableEntry{}, nil, errors.Wrapf(err, "failed to read table %s", table.Name)
+	}
+	if header.Name != table.File {
+		return TableEntry{}, nil, fmt.Errorf("expected the data file %s, found %s", table.File, header.Name)
+	}
+	a.next++
+	return table, a.tr, nil
+}
+
+func (a *archiveReader) Close() error {
+	return a.gr.Close()
+}
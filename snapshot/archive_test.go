@@ -0,0 +1,77 @@
+package snapshot
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	data := map[string]string{
+		"sync.block.copy":   "1\t10\t\\\\x01\n2\t11\t\\\\x02\n",
+		"sync.deposit.copy": "",
+	}
+	manifest := &Manifest{Version: Version, Migration: "1009.sql", CreatedAt: time.Now().UTC()}
+	var files []string
+	for _, name := range []string{"sync.block", "sync.deposit"} {
+		file := name + ".copy"
+		path := filepath.Join(dir, file)
+		require.NoError(t, os.WriteFile(path, []byte(data[file]), 0600))
+		sum := sha256.Sum256([]byte(data[file]))
+		manifest.Tables = append(manifest.Tables, TableEntry{Name: name, File: file, SHA256: hex.EncodeToString(sum[:])})
+		files = append(files, path)
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, writeArchive(&buf, manifest, files))
+
+	archive, err := newArchiveReader(&buf)
+	require.NoError(t, err)
+	defer archive.Close()
+	assert.Equal(t, manifest.Migration, archive.manifest.Migration)
+	require.Len(t, archive.manifest.Tables, 2)
+	for _, expected := range manifest.Tables {
+		entry, r, err := archive.nextTable()
+		require.NoError(t, err)
+		assert.Equal(t, expected, entry)
+		h := sha256.New()
+		b, err := io.ReadAll(io.TeeReader(r, h))
+		require.NoError(t, err)
+		assert.Equal(t, data[entry.File], string(b))
+		assert.NoError(t, checkTable(entry, h, entry.Rows))
+	}
+	_, _, err = archive.nextTable()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestArchiveRejectsInvalidSnapshots(t *testing.T) {
+	_, err := newArchiveReader(bytes.NewReader([]byte("not a snapshot")))
+	assert.Error(t, err)
+
+	// unsupported version
+	var buf bytes.Buffer
+	require.NoError(t, writeArchive(&buf, &Manifest{Version: Version + 1}, nil))
+	_, err = newArchiveReader(&buf)
+	assert.Error(t, err)
+
+	// tampered data
+	entry := TableEntry{Name: "sync.block", SHA256: hex.EncodeToString(make([]byte, sha256.Size)), Rows: 1}
+	h := sha256.New()
+	h.Write([]byte("data"))
+	assert.Error(t, checkTable(entry, h, 1))
+}
+
+func TestTableCopySQL(t *testing.T) {
+	assert.Equal(t, "COPY (SELECT * FROM sync.block WHERE id > 0) TO STDOUT", tables[0].copyToSQL())
+	assert.Equal(t, "COPY sync.deposit TO STDOUT", tables[3].copyToSQL())
+	assert.Equal(t, "COPY sync.block FROM STDIN", tables[0].copyFromSQL())
+}
@@ -0,0 +1,246 @@
+package snapshot
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/pkg/errors"
+)
+
+// Version is the version of the snapshot format
+const Version = 1
+
+// Manifest describes the content of a snapshot
+type Manifest struct {
+	Version int `json:"version"`
+	// Migration is the last db migration applied when the snapshot was taken, a snapshot can only be
+	// restored in a db with the same schema
+	Migration  string         `json:"migration"`
+	CreatedAt  time.Time      `json:"createdAt"`
+	LastBlocks []NetworkBlock `json:"lastBlocks"`
+	Tables     []TableEntry   `json:"tables"`
+}
+
+// NetworkBlock is the last synced block of a network, the sync resumes from it after the restore
+type NetworkBlock struct {
+	NetworkID   uint        `json:"networkId"`
+	BlockNumber uint64      `json:"blockNumber"`
+	BlockHash   common.Hash `json:"blockHash"`
+}
+
+// TableEntry is the data file of a table in the snapshot
+type TableEntry struct {
+	Name   string `json:"name"`
+	File   string `json:"file"`
+	Rows   int64  `json:"rows"`
+	SHA256 string `json:"sha256"`
+}
+
+type table struct {
+	name string
+	// filter excludes the rows created by the migrations
+	filter string
+	// serial is the column whose sequence is set after the restore
+	serial string
+}
+
+// Tables of the snapshot, in the order they are restored to satisfy the foreign keys
+var tables = []table{
+	{name: "sync.block", filter: "id > 0", serial: "id"},
+	{name: "sync.sovereign_exit_root", serial: "id"},
+	{name: "sync.exit_root", serial: "id"},
+	{name: "sync.deposit", serial: "id"},
+	{name: "sync.claim"},
+	{name: "sync.token_wrapped"},
+	{name: "sync.bridge_balance", serial: "id"},
+	{name: "mt.root", serial: "id"},
+	{name: "mt.rht"},
+	{name: "mt.rollup_exit", serial: "id"},
+}
+
+func (t table) file() string {
+	return t.name + ".copy"
+}
+
+func (t table) copyToSQL() string {
+	if t.filter == "" {
+		return fmt.Sprintf("COPY %s TO STDOUT", t.name)
+	}
+	return fmt.Sprintf("COPY (SELECT * FROM %s WHERE %s) TO STDOUT", t.name, t.filter)
+}
+
+func (t table) copyFromSQL() string {
+	return fmt.Sprintf("COPY %s FROM STDIN", t.name)
+}
+
+// Export writes a snapshot of the synced state to the writer. The tables are read in the same repeatable
+// read tx, so the snapshot is consistent while the synchronizer keeps running
+func Export(ctx context.Context, pool *pgxpool.Pool, w io.Writer) (*Manifest, error) {
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	manifest := &Manifest{Version: Version, CreatedAt: time.Now().UTC()}
+	manifest.Migration, err = lastMigration(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	manifest.LastBlocks, err = lastBlocks(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := os.MkdirTemp("", "bridge-snapshot")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+	var files []string
+	for _, t := range tables {
+		path := dir + "/" + t.file()
+		entry, err := exportTable(ctx, tx, t, path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to export table %s", t.name)
+		}
+		log.Infof("exported %d rows of table %s", entry.Rows, t.name)
+		manifest.Tables = append(manifest.Tables, entry)
+		files = append(files, path)
+	}
+	err = writeArchive(w, manifest, files)
+	if err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+func exportTable(ctx context.Context, tx pgx.Tx, t table, path string) (TableEntry, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return TableEntry{}, err
+	}
+	defer f.Close()
+	h := sha256.New()
+	tag, err := tx.Conn().PgConn().CopyTo(ctx, io.MultiWriter(f, h), t.copyToSQL())
+	if err != nil {
+		return TableEntry{}, err
+	}
+	return TableEntry{Name: t.name, File: t.file(), Rows: tag.RowsAffected(), SHA256: hex.EncodeToString(h.Sum(nil))}, nil
+}
+
+// Restore loads a snapshot into an empty db, migrated to the same version as the snapshot. The checksums,
+// the row counts and the merkle roots are verified before the restore is committed
+func Restore(ctx context.Context, pool *pgxpool.Pool, r io.Reader) (*Manifest, error) {
+	archive, err := newArchiveReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer archive.Close()
+	manifest := archive.manifest
+
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	migration, err := lastMigration(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	if migration != manifest.Migration {
+		return nil, fmt.Errorf("the snapshot was taken at migration %s, the db is at migration %s", manifest.Migration, migration)
+	}
+	var nBlocks int64
+	err = tx.QueryRow(ctx, "SELECT COUNT(*) FROM sync.block WHERE id > 0").Scan(&nBlocks)
+	if err != nil {
+		return nil, err
+	}
+	if nBlocks > 0 {
+		return nil, errors.New("the db is not empty, the snapshot can only be restored in a new db")
+	}
+
+	for _, t := range tables {
+		entry, data, err := archive.nextTable()
+		if err != nil {
+			return nil, err
+		}
+		if entry.Name != t.name {
+			return nil, fmt.Errorf("expected table %s in the snapshot, found %s", t.name, entry.Name)
+		}
+		h := sha256.New()
+		tag, err := tx.Conn().PgConn().CopyFrom(ctx, io.TeeReader(data, h), t.copyFromSQL())
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to restore table %s", t.name)
+		}
+		if err = checkTable(entry, h, tag.RowsAffected()); err != nil {
+			return nil, err
+		}
+		if t.serial != "" {
+			setvalSQL := fmt.Sprintf("SELECT setval(pg_get_serial_sequence('%s', '%s'), (SELECT COALESCE(MAX(%s), 0) + 1 FROM %s), false)", t.name, t.serial, t.serial, t.name)
+			if _, err = tx.Exec(ctx, setvalSQL); err != nil {
+				return nil, errors.Wrapf(err, "failed to set the sequence of table %s", t.name)
+			}
+		}
+		log.Infof("restored %d rows of table %s", entry.Rows, t.name)
+	}
+
+	if err = verifyMerkleRoots(ctx, tx); err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// checkTable checks the data of a restored table against its manifest entry
+func checkTable(entry TableEntry, h hash.Hash, rows int64) error {
+	if sum := hex.EncodeToString(h.Sum(nil)); sum != entry.SHA256 {
+		return fmt.Errorf("checksum mismatch for table %s: expected %s, got %s", entry.Name, entry.SHA256, sum)
+	}
+	if rows != entry.Rows {
+		return fmt.Errorf("row count mismatch for table %s: expected %d, got %d", entry.Name, entry.Rows, rows)
+	}
+	return nil
+}
+
+func lastMigration(ctx context.Context, tx pgx.Tx) (string, error) {
+	var migration string
+	err := tx.QueryRow(ctx, "SELECT COALESCE(MAX(id), '') FROM public.gorp_migrations").Scan(&migration)
+	return migration, err
+}
+
+func lastBlocks(ctx context.Context, tx pgx.Tx) ([]NetworkBlock, error) {
+	const lastBlocksSQL = `SELECT DISTINCT ON (network_id) network_id, block_num, block_hash FROM sync.block
+		WHERE id > 0 ORDER BY network_id, block_num DESC`
+	rows, err := tx.Query(ctx, lastBlocksSQL)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var blocks []NetworkBlock
+	for rows.Next() {
+		var b NetworkBlock
+		if err = rows.Scan(&b.NetworkID, &b.BlockNumber, &b.BlockHash); err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, b)
+	}
+	return blocks, rows.Err()
+}
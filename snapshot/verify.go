@@ -0,0 +1,59 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+	"github.com/lib/pq"
+)
+
+// verifyMerkleRoots checks the restored merkle trees against the exit roots synced from L1: the exit roots
+// of the latest L1 global exit root must be roots of the mainnet and rollup exit trees, and the latest root
+// of every tree must be one of its stored nodes
+func verifyMerkleRoots(ctx context.Context, tx pgx.Tx) error {
+	var exitRoots [][]byte
+	err := tx.QueryRow(ctx, "SELECT exit_roots FROM sync.exit_root WHERE block_id > 0 ORDER BY id DESC LIMIT 1").Scan(pq.Array(&exitRoots))
+	if err != nil && err != pgx.ErrNoRows {
+		return err
+	}
+	if len(exitRoots) == 2 { //nolint:gomnd
+		mainnetExitRoot, rollupExitRoot := common.BytesToHash(exitRoots[0]), common.BytesToHash(exitRoots[1])
+		var nRoots, found int64
+		const mainnetRootSQL = "SELECT COUNT(*), COUNT(*) FILTER (WHERE root = $1) FROM mt.root WHERE network = 0"
+		if err = tx.QueryRow(ctx, mainnetRootSQL, mainnetExitRoot.Bytes()).Scan(&nRoots, &found); err != nil {
+			return err
+		}
+		if nRoots > 0 && found == 0 {
+			return fmt.Errorf("the mainnet exit root %s of the latest global exit root is not a root of the mainnet exit tree", mainnetExitRoot)
+		}
+		const rollupRootSQL = "SELECT COUNT(*), COUNT(*) FILTER (WHERE root = $1) FROM mt.rollup_exit"
+		if err = tx.QueryRow(ctx, rollupRootSQL, rollupExitRoot.Bytes()).Scan(&nRoots, &found); err != nil {
+			return err
+		}
+		if nRoots > 0 && found == 0 {
+			return fmt.Errorf("the rollup exit root %s of the latest global exit root is not a root of the rollup exit tree", rollupExitRoot)
+		}
+	}
+
+	const missingRootsSQL = `SELECT r.network, r.root FROM mt.root AS r
+		WHERE r.id IN (SELECT MAX(id) FROM mt.root GROUP BY network)
+		AND NOT EXISTS (SELECT 1 FROM mt.rht WHERE key = r.root)`
+	rows, err := tx.Query(ctx, missingRootsSQL)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	if rows.Next() {
+		var (
+			network uint
+			root    common.Hash
+		)
+		if err = rows.Scan(&network, &root); err != nil {
+			return err
+		}
+		return fmt.Errorf("the latest root %s of the exit tree of network %d has no stored node", root, network)
+	}
+	return rows.Err()
+}
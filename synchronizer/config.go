@@ -12,6 +12,29 @@ type Config struct {
 	// SyncChunkSize is the number of blocks to sync on each chunk
 	SyncChunkSize uint64 `mapstructure:"SyncChunkSize"`
 
+	// FetchWorkers is the number of block ranges fetched concurrently while the synchronizer is far
+	// behind the sync target, the ranges are processed in order. 1 fetches them sequentially
+	FetchWorkers uint64 `mapstructure:"FetchWorkers"`
+
 	// for x layer
 	LargeTxUsdLimit uint64 `mapstructure:"LargeTxUsdLimit"`
+
+	// SyncTargets are the blocks each network is synced up to, the networks that are not listed
+	// are synced up to the latest block
+	SyncTargets []SyncTargetConfig `mapstructure:"SyncTargets"`
+
+	// TrustedL2Deposits marks the deposits of the L2 networks as trusted until their blocks are virtualized,
+	// the L2 blocks are synced from the sequencer RPC before their batches are sent to L1
+	TrustedL2Deposits bool `mapstructure:"TrustedL2Deposits"`
+}
+
+// SyncTargetConfig is the block a network is synced up to. Only the blocks up to the target drive
+// the claims and the ready for claim status, the deposits of the newer ones are kept as unconfirmed
+type SyncTargetConfig struct {
+	// NetworkID is the network of the sync target
+	NetworkID uint `mapstructure:"NetworkID"`
+	// Target is the block the network is synced up to: latest, safe, finalized or confirmations
+	Target string `mapstructure:"Target"`
+	// Confirmations is the number of blocks behind the latest one of the confirmations target
+	Confirmations uint64 `mapstructure:"Confirmations"`
 }
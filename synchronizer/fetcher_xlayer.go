@@ -0,0 +1,198 @@
+package synchronizer
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/metrics"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Errors returned by the RPC providers when the logs of a range exceed their limits
+var logLimitErrors = []string{
+	"query returned more than",
+	"block range",
+	"range is too large",
+	"limit exceeded",
+	"response size exceeded",
+	"response is too big",
+	"too many results",
+}
+
+// fetchedRange is a block range fetched from the chain
+type fetchedRange struct {
+	fromBlock uint64
+	toBlock   uint64
+	blocks    []etherman.Block
+	order     map[common.Hash][]etherman.Order
+	// lastBlock is the last block of a range without events, it's stored to keep the sync progress
+	lastBlock *etherman.Block
+	err       error
+}
+
+func isLogLimitError(err error) bool {
+	msg := strings.ToLower(err.Error())
+	for _, e := range logLimitErrors {
+		if strings.Contains(msg, e) {
+			return true
+		}
+	}
+	return false
+}
+
+// getChunkSize returns the number of blocks fetched on each range, it's reduced while the RPC rejects
+// the ranges for returning too many logs
+func (s *ClientSynchronizer) getChunkSize() uint64 {
+	if chunkSize := s.chunkSize.Load(); chunkSize > 0 {
+		return chunkSize
+	}
+	return s.cfg.SyncChunkSize
+}
+
+func (s *ClientSynchronizer) shrinkChunkSize(rangeSize uint64) {
+	chunkSize := max(rangeSize/2, 1) //nolint:gomnd
+	if chunkSize < s.getChunkSize() {
+		s.chunkSize.Store(chunkSize)
+		log.Infof("NetworkID: %d, sync chunk size reduced to %d blocks", s.networkID, chunkSize)
+	}
+}
+
+func (s *ClientSynchronizer) growChunkSize() {
+	chunkSize := s.getChunkSize()
+	if chunkSize < s.cfg.SyncChunkSize {
+		s.chunkSize.Store(min(chunkSize*2, s.cfg.SyncChunkSize)) //nolint:gomnd
+	}
+}
+
+// fetchBlockRange gets the rollup info of the block range, splitting it while the RPC rejects it for
+// returning too many logs
+func (s *ClientSynchronizer) fetchBlockRange(ctx context.Context, fromBlock, toBlock uint64) ([]etherman.Block, map[common.Hash][]etherman.Order, error) {
+	blocks, order, err := s.etherMan.GetRollupInfoByBlockRange(ctx, fromBlock, &toBlock)
+	if err == nil {
+		s.growChunkSize()
+		return blocks, order, nil
+	}
+	if !isLogLimitError(err) || fromBlock >= toBlock {
+		return nil, nil, err
+	}
+	s.shrinkChunkSize(toBlock - fromBlock + 1)
+	middle := fromBlock + (toBlock-fromBlock)/2 //nolint:gomnd
+	log.Debugf("NetworkID: %d, too many logs from block %d to %d, splitting the range at block %d. Error: %v", s.networkID, fromBlock, toBlock, middle, err)
+	blocks, order, err = s.fetchBlockRange(ctx, fromBlock, middle)
+	if err != nil {
+		return nil, nil, err
+	}
+	upperBlocks, upperOrder, err := s.fetchBlockRange(ctx, middle+1, toBlock)
+	if err != nil {
+		return nil, nil, err
+	}
+	if order == nil {
+		order = make(map[common.Hash][]etherman.Order)
+	}
+	// The halves don't share blocks, so the orders keyed by block hash don't collide
+	for hash, o := range upperOrder {
+		order[hash] = o
+	}
+	return append(blocks, upperBlocks...), order, nil
+}
+
+// fetchRange fetches the rollup info of the range, and the last block of the range if it has no events
+func (s *ClientSynchronizer) fetchRange(ctx context.Context, fromBlock, toBlock uint64) *fetchedRange {
+	r := &fetchedRange{fromBlock: fromBlock, toBlock: toBlock}
+	r.blocks, r.order, r.err = s.fetchBlockRange(ctx, fromBlock, toBlock)
+	if r.err != nil || len(r.blocks) > 0 {
+		return r
+	}
+	fb, err := s.etherMan.EthBlockByNumber(ctx, toBlock)
+	if err != nil {
+		r.err = err
+		return r
+	}
+	r.lastBlock = &etherman.Block{
+		BlockNumber: fb.NumberU64(),
+		BlockHash:   fb.Hash(),
+		ParentHash:  fb.ParentHash(),
+		ReceivedAt:  time.Unix(int64(fb.Time()), 0),
+	}
+	return r
+}
+
+// fetchRanges fetches the ranges from fromBlock to toBlock with FetchWorkers concurrent workers. The ranges
+// are sent in order, and at most FetchWorkers ranges are fetched ahead of the one being processed. The
+// channel is closed after the last range, after a range with error or when the context is done
+func (s *ClientSynchronizer) fetchRanges(ctx context.Context, fromBlock, toBlock uint64) <-chan *fetchedRange {
+	workers := max(s.cfg.FetchWorkers, 1)
+	results := make(chan *fetchedRange)
+	pending := make(chan chan *fetchedRange, workers)
+	slots := make(chan struct{}, workers)
+
+	// dispatcher, starts a worker per range while there are free slots
+	go func() {
+		defer close(pending)
+		for from := fromBlock; from <= toBlock; {
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			to := min(from+s.getChunkSize(), toBlock)
+			ch := make(chan *fetchedRange, 1)
+			go func(from, to uint64) {
+				ch <- s.fetchRange(ctx, from, to)
+			}(from, to)
+			select {
+			case pending <- ch:
+			case <-ctx.Done():
+				return
+			}
+			from = to + 1
+		}
+	}()
+
+	// reorders the results, the slot of a range is freed once it's handed over to be processed
+	go func() {
+		defer close(results)
+		for ch := range pending {
+			r := <-ch
+			<-slots
+			select {
+			case results <- r:
+			case <-ctx.Done():
+				return
+			}
+			if r.err != nil {
+				return
+			}
+		}
+	}()
+	return results
+}
+
+// syncBlocksParallel syncs the blocks from fromBlock to toBlock, fetching several ranges concurrently and
+// processing them in order. It returns the last synced block
+func (s *ClientSynchronizer) syncBlocksParallel(lastBlockSynced *etherman.Block, fromBlock, toBlock uint64) (*etherman.Block, error) {
+	log.Infof("NetworkID: %d, syncing blocks from %d to %d with %d workers", s.networkID, fromBlock, toBlock, s.cfg.FetchWorkers)
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+	for r := range s.fetchRanges(ctx, fromBlock, toBlock) {
+		if r.err != nil {
+			log.Errorf("NetworkID: %d, error getting bridge info from block %d to block %d. Error: %v", s.networkID, r.fromBlock, r.toBlock, r.err)
+			return lastBlockSynced, r.err
+		}
+		blocks := r.blocks
+		if len(blocks) == 0 {
+			blocks = []etherman.Block{*r.lastBlock}
+		}
+		err := s.processBlockRange(blocks, r.order)
+		if err != nil {
+			return lastBlockSynced, err
+		}
+		lastBlockSynced = &blocks[len(blocks)-1]
+		log.Debugf("NetworkID: %d, synced blocks from %d to %d, %d blocks with events", s.networkID, r.fromBlock, r.toBlock, len(r.blocks))
+		metrics.RecordLastSyncedBlockNum(uint32(s.networkID), lastBlockSynced.BlockNumber)
+	}
+	return lastBlockSynced, s.ctx.Err()
+}
@@ -0,0 +1,90 @@
+package synchronizer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchBlockRangeSplits(t *testing.T) {
+	ctx := context.Background()
+	m := newEthermanMock(t)
+	s := &ClientSynchronizer{etherMan: m, cfg: Config{SyncChunkSize: 15}}
+
+	to := func(n uint64) interface{} {
+		return mock.MatchedBy(func(toBlock *uint64) bool { return *toBlock == n })
+	}
+	limitErr := errors.New("query returned more than 10000 results")
+	m.On("GetRollupInfoByBlockRange", ctx, uint64(0), to(15)).Return(nil, nil, limitErr).Once()
+	m.On("GetRollupInfoByBlockRange", ctx, uint64(0), to(7)).Return(nil, nil, limitErr).Once()
+	m.On("GetRollupInfoByBlockRange", ctx, uint64(0), to(3)).Return([]etherman.Block{{BlockNumber: 2, BlockHash: common.HexToHash("0x2")}},
+		map[common.Hash][]etherman.Order{common.HexToHash("0x2"): {{Name: etherman.DepositsOrder}}}, nil).Once()
+	m.On("GetRollupInfoByBlockRange", ctx, uint64(4), to(7)).Return(nil, map[common.Hash][]etherman.Order{}, nil).Once()
+	m.On("GetRollupInfoByBlockRange", ctx, uint64(8), to(15)).Return([]etherman.Block{{BlockNumber: 12, BlockHash: common.HexToHash("0xc")}},
+		map[common.Hash][]etherman.Order{common.HexToHash("0xc"): {{Name: etherman.ClaimsOrder}}}, nil).Once()
+
+	blocks, order, err := s.fetchBlockRange(ctx, 0, 15)
+	require.NoError(t, err)
+	require.Len(t, blocks, 2)
+	assert.Equal(t, uint64(2), blocks[0].BlockNumber)
+	assert.Equal(t, uint64(12), blocks[1].BlockNumber)
+	assert.Len(t, order, 2)
+	// the chunk size is reduced by the errors and grows back with the successful ranges
+	assert.Equal(t, uint64(15), s.getChunkSize())
+
+	// other errors are not retried
+	m.On("GetRollupInfoByBlockRange", ctx, uint64(16), to(20)).Return(nil, nil, errors.New("connection refused")).Once()
+	_, _, err = s.fetchBlockRange(ctx, 16, 20)
+	assert.Error(t, err)
+}
+
+func TestFetchRangesInOrder(t *testing.T) {
+	ctx := context.Background()
+	m := newEthermanMock(t)
+	s := &ClientSynchronizer{etherMan: m, cfg: Config{SyncChunkSize: 9, FetchWorkers: 4}}
+
+	// the first ranges are the slowest ones, the results must be sent in order anyway
+	for i := uint64(0); i < 8; i++ {
+		from := i * 10
+		m.On("GetRollupInfoByBlockRange", mock.Anything, from, mock.Anything).
+			After(time.Duration(8-i)*10*time.Millisecond).
+			Return([]etherman.Block{{BlockNumber: from}}, map[common.Hash][]etherman.Order{}, nil).Once()
+	}
+
+	var fromBlocks []uint64
+	for r := range s.fetchRanges(ctx, 0, 79) {
+		require.NoError(t, r.err)
+		assert.Equal(t, r.fromBlock+9, r.toBlock)
+		fromBlocks = append(fromBlocks, r.blocks[0].BlockNumber)
+	}
+	assert.Equal(t, []uint64{0, 10, 20, 30, 40, 50, 60, 70}, fromBlocks)
+}
+
+func TestFetchRangesStopsOnError(t *testing.T) {
+	m := newEthermanMock(t)
+	s := &ClientSynchronizer{etherMan: m, cfg: Config{SyncChunkSize: 9, FetchWorkers: 2}}
+
+	m.On("GetRollupInfoByBlockRange", mock.Anything, uint64(0), mock.Anything).
+		Return([]etherman.Block{{BlockNumber: 0}}, map[common.Hash][]etherman.Order{}, nil).Once()
+	m.On("GetRollupInfoByBlockRange", mock.Anything, uint64(10), mock.Anything).
+		Return(nil, nil, errors.New("connection refused")).Once()
+	m.On("GetRollupInfoByBlockRange", mock.Anything, mock.Anything, mock.Anything).
+		Return([]etherman.Block{{BlockNumber: 20}}, map[common.Hash][]etherman.Order{}, nil).Maybe()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var results []*fetchedRange
+	for r := range s.fetchRanges(ctx, 0, 1000) {
+		results = append(results, r)
+	}
+	require.Len(t, results, 2)
+	assert.NoError(t, results[0].err)
+	assert.Error(t, results[1].err)
+}
@@ -0,0 +1,105 @@
+package synchronizer
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Sync targets, the block a network is synced up to
+const (
+	SyncTargetLatest        = "latest"
+	SyncTargetSafe          = "safe"
+	SyncTargetFinalized     = "finalized"
+	SyncTargetConfirmations = "confirmations"
+)
+
+// validateSyncTargets checks that the sync targets are known
+func validateSyncTargets(targets []SyncTargetConfig) error {
+	for _, t := range targets {
+		switch t.Target {
+		case SyncTargetLatest, SyncTargetSafe, SyncTargetFinalized, SyncTargetConfirmations:
+		default:
+			return fmt.Errorf("unknown sync target %q for network %d", t.Target, t.NetworkID)
+		}
+	}
+	return nil
+}
+
+// syncTarget returns the sync target of the network of the synchronizer
+func (s *ClientSynchronizer) syncTarget() SyncTargetConfig {
+	for _, t := range s.cfg.SyncTargets {
+		if t.NetworkID == s.networkID {
+			return t
+		}
+	}
+	return SyncTargetConfig{NetworkID: s.networkID, Target: SyncTargetLatest}
+}
+
+// isLatestSyncTarget returns whether the network is synced up to the latest block
+func (s *ClientSynchronizer) isLatestSyncTarget() bool {
+	return s.syncTarget().Target == SyncTargetLatest
+}
+
+// getSyncTargetBlock returns the block number the network is synced up to and the latest block number
+func (s *ClientSynchronizer) getSyncTargetBlock() (uint64, uint64, error) {
+	header, err := s.etherMan.HeaderByNumber(s.ctx, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	head := header.Number.Uint64()
+	t := s.syncTarget()
+	switch t.Target {
+	case SyncTargetSafe, SyncTargetFinalized:
+		tag := rpc.SafeBlockNumber
+		if t.Target == SyncTargetFinalized {
+			tag = rpc.FinalizedBlockNumber
+		}
+		header, err = s.etherMan.HeaderByNumber(s.ctx, big.NewInt(int64(tag)))
+		if err != nil {
+			return 0, 0, err
+		}
+		return header.Number.Uint64(), head, nil
+	case SyncTargetConfirmations:
+		if head < t.Confirmations {
+			return 0, head, nil
+		}
+		return head - t.Confirmations, head, nil
+	default:
+		return head, head, nil
+	}
+}
+
+// syncUnconfirmedHead keeps the deposits from the block to the latest one in the unconfirmed view used
+// by the UI. These deposits are not stored, so they never drive the claims nor the ready for claim status
+func (s *ClientSynchronizer) syncUnconfirmedHead(fromBlock, head uint64) {
+	if s.redisStorage == nil {
+		return
+	}
+	var deposits []*etherman.Deposit
+	if fromBlock <= head {
+		toBlock := head
+		blocks, _, err := s.etherMan.GetRollupInfoByBlockRange(s.ctx, fromBlock, &toBlock)
+		if err != nil {
+			log.Warnf("networkID: %d, error getting the unconfirmed blocks from %d to %d: %v", s.networkID, fromBlock, toBlock, err)
+			return
+		}
+		for i := range blocks {
+			for j := range blocks[i].Deposits {
+				deposit := blocks[i].Deposits[j]
+				s.beforeProcessDeposit(&deposit)
+				deposit.NetworkID = s.networkID
+				deposits = append(deposits, &deposit)
+			}
+		}
+	}
+	err := s.redisStorage.SetUnconfirmedDeposits(s.ctx, s.networkID, deposits)
+	if err != nil {
+		log.Warnf("networkID: %d, error storing the unconfirmed deposits: %v", s.networkID, err)
+		return
+	}
+	log.Debugf("NetworkID: %d, %d unconfirmed deposits from block %d to %d", s.networkID, len(deposits), fromBlock, head)
+}
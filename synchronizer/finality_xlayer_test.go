@@ -0,0 +1,64 @@
+package synchronizer
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSyncTargets(t *testing.T) {
+	assert.NoError(t, validateSyncTargets(nil))
+	assert.NoError(t, validateSyncTargets([]SyncTargetConfig{
+		{NetworkID: 0, Target: SyncTargetFinalized},
+		{NetworkID: 1, Target: SyncTargetConfirmations, Confirmations: 10},
+	}))
+	assert.Error(t, validateSyncTargets([]SyncTargetConfig{{NetworkID: 0, Target: "pending"}}))
+}
+
+func TestGetSyncTargetBlock(t *testing.T) {
+	ctx := context.Background()
+	var latest *big.Int
+	newSynchronizer := func(m *ethermanMock, target SyncTargetConfig) *ClientSynchronizer {
+		m.On("HeaderByNumber", ctx, latest).Return(&types.Header{Number: big.NewInt(100)}, nil)
+		return &ClientSynchronizer{ctx: ctx, etherMan: m, networkID: 0, cfg: Config{SyncTargets: []SyncTargetConfig{target}}}
+	}
+
+	// the networks without sync target are synced up to the latest block
+	s := newSynchronizer(newEthermanMock(t), SyncTargetConfig{NetworkID: 1, Target: SyncTargetFinalized})
+	assert.True(t, s.isLatestSyncTarget())
+	target, head, err := s.getSyncTargetBlock()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100), target)
+	assert.Equal(t, uint64(100), head)
+
+	m := newEthermanMock(t)
+	m.On("HeaderByNumber", ctx, big.NewInt(int64(rpc.FinalizedBlockNumber))).Return(&types.Header{Number: big.NewInt(36)}, nil)
+	s = newSynchronizer(m, SyncTargetConfig{NetworkID: 0, Target: SyncTargetFinalized})
+	assert.False(t, s.isLatestSyncTarget())
+	target, head, err = s.getSyncTargetBlock()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(36), target)
+	assert.Equal(t, uint64(100), head)
+
+	m = newEthermanMock(t)
+	m.On("HeaderByNumber", ctx, big.NewInt(int64(rpc.SafeBlockNumber))).Return(&types.Header{Number: big.NewInt(68)}, nil)
+	s = newSynchronizer(m, SyncTargetConfig{NetworkID: 0, Target: SyncTargetSafe})
+	target, _, err = s.getSyncTargetBlock()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(68), target)
+
+	s = newSynchronizer(newEthermanMock(t), SyncTargetConfig{NetworkID: 0, Target: SyncTargetConfirmations, Confirmations: 12})
+	target, _, err = s.getSyncTargetBlock()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(88), target)
+
+	s = newSynchronizer(newEthermanMock(t), SyncTargetConfig{NetworkID: 0, Target: SyncTargetConfirmations, Confirmations: 120})
+	target, _, err = s.getSyncTargetBlock()
+	require.NoError(t, err)
+	assert.Zero(t, target)
+}
@@ -5,6 +5,7 @@ import (
 	"math/big"
 
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/pushoutbox"
 	rpcTypes "github.com/0xPolygonHermez/zkevm-node/jsonrpc/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -18,6 +19,15 @@ type ethermanInterface interface {
 	EthBlockByNumber(ctx context.Context, blockNumber uint64) (*types.Block, error)
 	GetNetworkID(ctx context.Context) (uint, error)
 	GetRollupID() uint
+
+	// XLayer
+	IsBlockVirtualized(ctx context.Context, blockNumber uint64) (bool, error)
+}
+
+// headSubscriber is implemented by the ethermans that receive the new blocks through a subscription, the
+// synchronizer syncs on every new block instead of waiting for the sync interval
+type headSubscriber interface {
+	NewHeads() <-chan uint64
 }
 
 type storageInterface interface {
@@ -43,6 +53,16 @@ type storageInterface interface {
 	AddDepositXLayer(ctx context.Context, deposit *etherman.Deposit, dbTx pgx.Tx) (uint64, error)
 	GetBridgeBalance(ctx context.Context, originalTokenAddr common.Address, networkID uint, forUpdate bool, dbTx pgx.Tx) (*big.Int, error)
 	SetBridgeBalance(ctx context.Context, originalTokenAddr common.Address, networkID uint, balance *big.Int, dbTx pgx.Tx) error
+	GetDepositsAfterBlock(ctx context.Context, blockNumber uint64, networkID uint, dbTx pgx.Tx) ([]*etherman.Deposit, error)
+	GetClaimsAfterBlock(ctx context.Context, blockNumber uint64, networkID uint, dbTx pgx.Tx) ([]*etherman.Claim, error)
+	GetGlobalExitRootsAfterBlock(ctx context.Context, blockNumber uint64, networkID uint, dbTx pgx.Tx) ([]*etherman.GlobalExitRoot, error)
+	AddReorgJournal(ctx context.Context, journal *etherman.ReorgJournal, dbTx pgx.Tx) (uint64, error)
+	GetTrustedDepositBlocks(ctx context.Context, networkID uint, dbTx pgx.Tx) ([]uint64, error)
+	PromoteTrustedDeposits(ctx context.Context, networkID uint, blockNumber uint64, dbTx pgx.Tx) (int64, error)
+	AddSovereignGlobalExitRoot(ctx context.Context, ger *etherman.SovereignGlobalExitRoot, dbTx pgx.Tx) error
+	GetLatestSovereignExitRoot(ctx context.Context, networkID uint, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error)
+	DeleteClaim(ctx context.Context, claim *etherman.Claim, dbTx pgx.Tx) (int64, error)
+	AddPushOutboxMessage(ctx context.Context, msg *pushoutbox.Message, dbTx pgx.Tx) error
 }
 
 type bridgectrlInterface interface {
@@ -150,6 +150,30 @@ type mockConstructorTestingTnewEthermanMock interface {
 	Cleanup(func())
 }
 
+// IsBlockVirtualized provides a mock function with given fields: ctx, blockNumber
+func (_m *ethermanMock) IsBlockVirtualized(ctx context.Context, blockNumber uint64) (bool, error) {
+	ret := _m.Called(ctx, blockNumber)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (bool, error)); ok {
+		return rf(ctx, blockNumber)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) bool); ok {
+		r0 = rf(ctx, blockNumber)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, blockNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // newEthermanMock creates a new instance of ethermanMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 func newEthermanMock(t mockConstructorTestingTnewEthermanMock) *ethermanMock {
 	mock := &ethermanMock{}
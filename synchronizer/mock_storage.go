@@ -13,6 +13,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	pgx "github.com/jackc/pgx/v4"
+
+	pushoutbox "github.com/0xPolygonHermez/zkevm-bridge-service/pushoutbox"
 )
 
 // storageMock is an autogenerated mock type for the storageInterface type
@@ -120,6 +122,58 @@ func (_m *storageMock) AddGlobalExitRoot(ctx context.Context, exitRoot *etherman
 	return r0
 }
 
+// AddPushOutboxMessage provides a mock function with given fields: ctx, msg, dbTx
+func (_m *storageMock) AddPushOutboxMessage(ctx context.Context, msg *pushoutbox.Message, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, msg, dbTx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pushoutbox.Message, pgx.Tx) error); ok {
+		r0 = rf(ctx, msg, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddReorgJournal provides a mock function with given fields: ctx, journal, dbTx
+func (_m *storageMock) AddReorgJournal(ctx context.Context, journal *etherman.ReorgJournal, dbTx pgx.Tx) (uint64, error) {
+	ret := _m.Called(ctx, journal, dbTx)
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *etherman.ReorgJournal, pgx.Tx) (uint64, error)); ok {
+		return rf(ctx, journal, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *etherman.ReorgJournal, pgx.Tx) uint64); ok {
+		r0 = rf(ctx, journal, dbTx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *etherman.ReorgJournal, pgx.Tx) error); ok {
+		r1 = rf(ctx, journal, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddSovereignGlobalExitRoot provides a mock function with given fields: ctx, ger, dbTx
+func (_m *storageMock) AddSovereignGlobalExitRoot(ctx context.Context, ger *etherman.SovereignGlobalExitRoot, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, ger, dbTx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *etherman.SovereignGlobalExitRoot, pgx.Tx) error); ok {
+		r0 = rf(ctx, ger, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // AddTokenWrapped provides a mock function with given fields: ctx, tokenWrapped, dbTx
 func (_m *storageMock) AddTokenWrapped(ctx context.Context, tokenWrapped *etherman.TokenWrapped, dbTx pgx.Tx) error {
 	ret := _m.Called(ctx, tokenWrapped, dbTx)
@@ -222,6 +276,30 @@ func (_m *storageMock) Commit(ctx context.Context, dbTx pgx.Tx) error {
 	return r0
 }
 
+// DeleteClaim provides a mock function with given fields: ctx, claim, dbTx
+func (_m *storageMock) DeleteClaim(ctx context.Context, claim *etherman.Claim, dbTx pgx.Tx) (int64, error) {
+	ret := _m.Called(ctx, claim, dbTx)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *etherman.Claim, pgx.Tx) (int64, error)); ok {
+		return rf(ctx, claim, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *etherman.Claim, pgx.Tx) int64); ok {
+		r0 = rf(ctx, claim, dbTx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *etherman.Claim, pgx.Tx) error); ok {
+		r1 = rf(ctx, claim, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBridgeBalance provides a mock function with given fields: ctx, originalTokenAddr, networkID, forUpdate, dbTx
 func (_m *storageMock) GetBridgeBalance(ctx context.Context, originalTokenAddr common.Address, networkID uint, forUpdate bool, dbTx pgx.Tx) (*big.Int, error) {
 	ret := _m.Called(ctx, originalTokenAddr, networkID, forUpdate, dbTx)
@@ -248,6 +326,32 @@ func (_m *storageMock) GetBridgeBalance(ctx context.Context, originalTokenAddr c
 	return r0, r1
 }
 
+// GetClaimsAfterBlock provides a mock function with given fields: ctx, blockNumber, networkID, dbTx
+func (_m *storageMock) GetClaimsAfterBlock(ctx context.Context, blockNumber uint64, networkID uint, dbTx pgx.Tx) ([]*etherman.Claim, error) {
+	ret := _m.Called(ctx, blockNumber, networkID, dbTx)
+
+	var r0 []*etherman.Claim
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint, pgx.Tx) ([]*etherman.Claim, error)); ok {
+		return rf(ctx, blockNumber, networkID, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint, pgx.Tx) []*etherman.Claim); ok {
+		r0 = rf(ctx, blockNumber, networkID, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*etherman.Claim)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint, pgx.Tx) error); ok {
+		r1 = rf(ctx, blockNumber, networkID, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDeposit provides a mock function with given fields: ctx, depositCounterUser, networkID, dbTx
 func (_m *storageMock) GetDeposit(ctx context.Context, depositCounterUser uint, networkID uint, dbTx pgx.Tx) (*etherman.Deposit, error) {
 	ret := _m.Called(ctx, depositCounterUser, networkID, dbTx)
@@ -274,6 +378,32 @@ func (_m *storageMock) GetDeposit(ctx context.Context, depositCounterUser uint,
 	return r0, r1
 }
 
+// GetDepositsAfterBlock provides a mock function with given fields: ctx, blockNumber, networkID, dbTx
+func (_m *storageMock) GetDepositsAfterBlock(ctx context.Context, blockNumber uint64, networkID uint, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
+	ret := _m.Called(ctx, blockNumber, networkID, dbTx)
+
+	var r0 []*etherman.Deposit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint, pgx.Tx) ([]*etherman.Deposit, error)); ok {
+		return rf(ctx, blockNumber, networkID, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint, pgx.Tx) []*etherman.Deposit); ok {
+		r0 = rf(ctx, blockNumber, networkID, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*etherman.Deposit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint, pgx.Tx) error); ok {
+		r1 = rf(ctx, blockNumber, networkID, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLastBlock provides a mock function with given fields: ctx, networkID, dbTx
 func (_m *storageMock) GetLastBlock(ctx context.Context, networkID uint, dbTx pgx.Tx) (*etherman.Block, error) {
 	ret := _m.Called(ctx, networkID, dbTx)
@@ -300,6 +430,32 @@ func (_m *storageMock) GetLastBlock(ctx context.Context, networkID uint, dbTx pg
 	return r0, r1
 }
 
+// GetGlobalExitRootsAfterBlock provides a mock function with given fields: ctx, blockNumber, networkID, dbTx
+func (_m *storageMock) GetGlobalExitRootsAfterBlock(ctx context.Context, blockNumber uint64, networkID uint, dbTx pgx.Tx) ([]*etherman.GlobalExitRoot, error) {
+	ret := _m.Called(ctx, blockNumber, networkID, dbTx)
+
+	var r0 []*etherman.GlobalExitRoot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint, pgx.Tx) ([]*etherman.GlobalExitRoot, error)); ok {
+		return rf(ctx, blockNumber, networkID, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint, pgx.Tx) []*etherman.GlobalExitRoot); ok {
+		r0 = rf(ctx, blockNumber, networkID, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*etherman.GlobalExitRoot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint, pgx.Tx) error); ok {
+		r1 = rf(ctx, blockNumber, networkID, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLatestL1SyncedExitRoot provides a mock function with given fields: ctx, dbTx
 func (_m *storageMock) GetLatestL1SyncedExitRoot(ctx context.Context, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error) {
 	ret := _m.Called(ctx, dbTx)
@@ -326,6 +482,32 @@ func (_m *storageMock) GetLatestL1SyncedExitRoot(ctx context.Context, dbTx pgx.T
 	return r0, r1
 }
 
+// GetLatestSovereignExitRoot provides a mock function with given fields: ctx, networkID, dbTx
+func (_m *storageMock) GetLatestSovereignExitRoot(ctx context.Context, networkID uint, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error) {
+	ret := _m.Called(ctx, networkID, dbTx)
+
+	var r0 *etherman.GlobalExitRoot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, pgx.Tx) (*etherman.GlobalExitRoot, error)); ok {
+		return rf(ctx, networkID, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, pgx.Tx) *etherman.GlobalExitRoot); ok {
+		r0 = rf(ctx, networkID, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*etherman.GlobalExitRoot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, pgx.Tx) error); ok {
+		r1 = rf(ctx, networkID, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetNumberDeposits provides a mock function with given fields: ctx, origNetworkID, blockNumber, dbTx
 func (_m *storageMock) GetNumberDeposits(ctx context.Context, origNetworkID uint, blockNumber uint64, dbTx pgx.Tx) (uint64, error) {
 	ret := _m.Called(ctx, origNetworkID, blockNumber, dbTx)
@@ -376,6 +558,32 @@ func (_m *storageMock) GetPreviousBlock(ctx context.Context, networkID uint, off
 	return r0, r1
 }
 
+// GetTrustedDepositBlocks provides a mock function with given fields: ctx, networkID, dbTx
+func (_m *storageMock) GetTrustedDepositBlocks(ctx context.Context, networkID uint, dbTx pgx.Tx) ([]uint64, error) {
+	ret := _m.Called(ctx, networkID, dbTx)
+
+	var r0 []uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, pgx.Tx) ([]uint64, error)); ok {
+		return rf(ctx, networkID, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, pgx.Tx) []uint64); ok {
+		r0 = rf(ctx, networkID, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, pgx.Tx) error); ok {
+		r1 = rf(ctx, networkID, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IsLxLyActivated provides a mock function with given fields: ctx, dbTx
 func (_m *storageMock) IsLxLyActivated(ctx context.Context, dbTx pgx.Tx) (bool, error) {
 	ret := _m.Called(ctx, dbTx)
@@ -400,6 +608,30 @@ func (_m *storageMock) IsLxLyActivated(ctx context.Context, dbTx pgx.Tx) (bool,
 	return r0, r1
 }
 
+// PromoteTrustedDeposits provides a mock function with given fields: ctx, networkID, blockNumber, dbTx
+func (_m *storageMock) PromoteTrustedDeposits(ctx context.Context, networkID uint, blockNumber uint64, dbTx pgx.Tx) (int64, error) {
+	ret := _m.Called(ctx, networkID, blockNumber, dbTx)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint64, pgx.Tx) (int64, error)); ok {
+		return rf(ctx, networkID, blockNumber, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint64, pgx.Tx) int64); ok {
+		r0 = rf(ctx, networkID, blockNumber, dbTx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, uint64, pgx.Tx) error); ok {
+		r1 = rf(ctx, networkID, blockNumber, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Reset provides a mock function with given fields: ctx, blockNumber, networkID, dbTx
 func (_m *storageMock) Reset(ctx context.Context, blockNumber uint64, networkID uint, dbTx pgx.Tx) error {
 	ret := _m.Called(ctx, blockNumber, networkID, dbTx)
@@ -0,0 +1,125 @@
+package synchronizer
+
+import (
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/messagebridge"
+	"github.com/jackc/pgx/v4"
+)
+
+// journalReorg stores what is rolled back when the state is reset to the block: the deposits, claims and
+// global exit roots of the later blocks. It must be called before the reset, in the same db tx. It returns
+// nil if there is nothing to roll back
+func (s *ClientSynchronizer) journalReorg(blockNumber uint64, dbTx pgx.Tx) (*etherman.ReorgJournal, error) {
+	lastBlock, err := s.storage.GetLastBlock(s.ctx, s.networkID, dbTx)
+	if err == gerror.ErrStorageNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if lastBlock.BlockNumber <= blockNumber {
+		return nil, nil
+	}
+	journal := &etherman.ReorgJournal{
+		NetworkID: s.networkID,
+		FromBlock: lastBlock.BlockNumber,
+		ToBlock:   blockNumber,
+		Depth:     lastBlock.BlockNumber - blockNumber,
+		CreatedAt: time.Now(),
+	}
+	journal.Deposits, err = s.storage.GetDepositsAfterBlock(s.ctx, blockNumber, s.networkID, dbTx)
+	if err != nil {
+		return nil, err
+	}
+	journal.Claims, err = s.storage.GetClaimsAfterBlock(s.ctx, blockNumber, s.networkID, dbTx)
+	if err != nil {
+		return nil, err
+	}
+	journal.GlobalExitRoots, err = s.storage.GetGlobalExitRootsAfterBlock(s.ctx, blockNumber, s.networkID, dbTx)
+	if err != nil {
+		return nil, err
+	}
+	journal.ID, err = s.storage.AddReorgJournal(s.ctx, journal, dbTx)
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("networkID: %d, reorg %d from block %d to %d, depth: %d, rolled back %d deposits, %d claims and %d globalExitRoots",
+		s.networkID, journal.ID, journal.FromBlock, journal.ToBlock, journal.Depth, len(journal.Deposits), len(journal.Claims), len(journal.GlobalExitRoots))
+	return journal, nil
+}
+
+// addReorgedPushMessages stores the updates of the transactions rolled back by the reorg in the outbox, in
+// the db tx of the reorg, so they're pushed in order with the updates stored before. The deposits are
+// dropped, and the deposits of the claims are pending to be claimed again
+func (s *ClientSynchronizer) addReorgedPushMessages(journal *etherman.ReorgJournal, dbTx pgx.Tx) error {
+	if journal == nil {
+		return nil
+	}
+	if s.messagePushProducer == nil {
+		log.Errorf("kafka push producer is nil, so can't push the reorged txs msg!")
+		return nil
+	}
+	rollupNetworkID := utils.GetRollupNetworkId()
+	for _, deposit := range journal.Deposits {
+		// Same filter as the deposits pushed when they were synced
+		if deposit.LeafType != uint8(utils.LeafTypeAsset) && !messagebridge.IsAllowedContractAddress(deposit.OriginalAddress) {
+			continue
+		}
+		if deposit.NetworkID != rollupNetworkID && deposit.DestinationNetwork != rollupNetworkID {
+			continue
+		}
+		messagebridge.ReplaceDepositInfo(deposit, true)
+		err := s.addPushOutboxMessage(&pb.Transaction{
+			FromChain:   uint32(deposit.NetworkID),
+			ToChain:     uint32(deposit.DestinationNetwork),
+			BridgeToken: deposit.OriginalAddress.Hex(),
+			TokenAmount: deposit.Amount.String(),
+			TxHash:      deposit.TxHash.String(),
+			Id:          deposit.Id,
+			Index:       uint64(deposit.DepositCount),
+			Status:      uint32(pb.TransactionStatus_TX_DROPPED),
+			BlockNumber: deposit.BlockNumber,
+			DestAddr:    deposit.DestinationAddress.Hex(),
+			GlobalIndex: s.getGlobalIndex(deposit).String(),
+		}, deposit.BlockID, dbTx)
+		if err != nil {
+			log.Errorf("networkID: %d, failed to add the push message of the dropped deposit %d, err: %v", s.networkID, deposit.DepositCount, err)
+			return err
+		}
+	}
+	for _, claim := range journal.Claims {
+		deposit, err := s.storage.GetDeposit(s.ctx, claim.Index, claim.OriginalNetwork, dbTx)
+		if err != nil {
+			log.Warnf("networkID: %d, failed to get the deposit of the reorged claim, claim: %+v, err: %v", s.networkID, claim, err)
+			continue
+		}
+		if deposit.LeafType != uint8(utils.LeafTypeAsset) && !messagebridge.IsAllowedContractAddress(deposit.OriginalAddress) {
+			continue
+		}
+		// The deposit was ready, the L2 claims are sent again by the claim tx manager
+		status := uint32(pb.TransactionStatus_TX_PENDING_AUTO_CLAIM)
+		if deposit.DestinationNetwork == 0 {
+			status = uint32(pb.TransactionStatus_TX_PENDING_USER_CLAIM)
+		}
+		// The block of the claim is part of the key, the deposit can be pending again after each reorged claim
+		err = s.addPushOutboxMessage(&pb.Transaction{
+			FromChain:   uint32(deposit.NetworkID),
+			ToChain:     uint32(deposit.DestinationNetwork),
+			TxHash:      deposit.TxHash.String(),
+			Index:       uint64(deposit.DepositCount),
+			Status:      status,
+			DestAddr:    deposit.DestinationAddress.Hex(),
+			GlobalIndex: s.getGlobalIndex(deposit).String(),
+		}, claim.BlockID, dbTx)
+		if err != nil {
+			log.Errorf("networkID: %d, failed to add the push message of the unclaimed deposit %d, err: %v", s.networkID, deposit.DepositCount, err)
+			return err
+		}
+	}
+	return nil
+}
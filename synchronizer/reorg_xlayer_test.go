@@ -0,0 +1,86 @@
+package synchronizer
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/messagepush"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/pushoutbox"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJournalReorg(t *testing.T) {
+	ctx := context.Background()
+	m := newStorageMock(t)
+	dbTx := newDbTxMock(t)
+	s := &ClientSynchronizer{ctx: ctx, storage: m, networkID: 1}
+
+	deposits := []*etherman.Deposit{{NetworkID: 1, DepositCount: 5, Amount: big.NewInt(1), BlockNumber: 9}}
+	claims := []*etherman.Claim{{NetworkID: 1, Index: 2, Amount: big.NewInt(1), BlockNumber: 10}}
+	gers := []*etherman.GlobalExitRoot{{BlockNumber: 8, GlobalExitRoot: common.HexToHash("0x1")}}
+	m.On("GetLastBlock", ctx, uint(1), dbTx).Return(&etherman.Block{BlockNumber: 10}, nil)
+	m.On("GetDepositsAfterBlock", ctx, uint64(7), uint(1), dbTx).Return(deposits, nil)
+	m.On("GetClaimsAfterBlock", ctx, uint64(7), uint(1), dbTx).Return(claims, nil)
+	m.On("GetGlobalExitRootsAfterBlock", ctx, uint64(7), uint(1), dbTx).Return(gers, nil)
+	m.On("AddReorgJournal", ctx, mock.MatchedBy(func(journal *etherman.ReorgJournal) bool {
+		return journal.FromBlock == 10 && journal.ToBlock == 7 && journal.Depth == 3
+	}), dbTx).Return(uint64(4), nil)
+
+	journal, err := s.journalReorg(7, dbTx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), journal.ID)
+	assert.Equal(t, uint(1), journal.NetworkID)
+	assert.Equal(t, deposits, journal.Deposits)
+	assert.Equal(t, claims, journal.Claims)
+	assert.Equal(t, gers, journal.GlobalExitRoots)
+}
+
+func TestJournalReorgNothingRolledBack(t *testing.T) {
+	ctx := context.Background()
+	m := newStorageMock(t)
+	dbTx := newDbTxMock(t)
+	s := &ClientSynchronizer{ctx: ctx, storage: m, networkID: 0}
+
+	m.On("GetLastBlock", ctx, uint(0), dbTx).Return(&etherman.Block{BlockNumber: 7}, nil)
+	journal, err := s.journalReorg(7, dbTx)
+	require.NoError(t, err)
+	assert.Nil(t, journal)
+
+	// a nil journal has nothing to push
+	require.NoError(t, s.addReorgedPushMessages(journal, dbTx))
+}
+
+func TestAddReorgedPushMessages(t *testing.T) {
+	ctx := context.Background()
+	m := newStorageMock(t)
+	dbTx := newDbTxMock(t)
+	producer, err := messagepush.NewKafkaProducer(messagepush.Config{UseFakeProducer: true})
+	require.NoError(t, err)
+	s := &ClientSynchronizer{ctx: ctx, storage: m, networkID: 1, rollupID: 1, messagePushProducer: producer}
+	utils.InitRollupNetworkId(1)
+
+	deposit := &etherman.Deposit{NetworkID: 1, DepositCount: 5, Amount: big.NewInt(1), BlockID: 3, TxHash: common.HexToHash("0x5")}
+	claimed := &etherman.Deposit{NetworkID: 0, DestinationNetwork: 1, DepositCount: 2, Amount: big.NewInt(1), TxHash: common.HexToHash("0x2")}
+	journal := &etherman.ReorgJournal{
+		Deposits: []*etherman.Deposit{deposit},
+		Claims:   []*etherman.Claim{{NetworkID: 1, Index: 2, OriginalNetwork: 0, BlockID: 4}},
+	}
+	m.On("GetDeposit", ctx, uint(2), uint(0), dbTx).Return(claimed, nil)
+	// the updates are stored in the outbox in the db tx of the reorg, instead of being pushed at once
+	m.On("AddPushOutboxMessage", ctx, mock.MatchedBy(func(msg *pushoutbox.Message) bool {
+		return msg.DedupKey == pushoutbox.DedupKey(&pb.Transaction{FromChain: 1, Index: 5, TxHash: deposit.TxHash.String(), Status: uint32(pb.TransactionStatus_TX_DROPPED)}, 3)
+	}), dbTx).Return(nil).Once()
+	m.On("AddPushOutboxMessage", ctx, mock.MatchedBy(func(msg *pushoutbox.Message) bool {
+		return msg.DedupKey == pushoutbox.DedupKey(&pb.Transaction{FromChain: 0, Index: 2, TxHash: claimed.TxHash.String(), Status: uint32(pb.TransactionStatus_TX_PENDING_AUTO_CLAIM)}, 4)
+	}), dbTx).Return(nil).Once()
+
+	require.NoError(t, s.addReorgedPushMessages(journal, dbTx))
+	assert.Empty(t, producer.GetFakeMessages(""))
+}
@@ -0,0 +1,63 @@
+package synchronizer
+
+import (
+	"errors"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/jackc/pgx/v4"
+)
+
+func (s *ClientSynchronizer) processSovereignGlobalExitRoot(ger etherman.SovereignGlobalExitRoot, blockID uint64, dbTx pgx.Tx) error {
+	ger.BlockID = blockID
+	ger.NetworkID = s.networkID
+	err := s.storage.AddSovereignGlobalExitRoot(s.ctx, &ger, dbTx)
+	if err != nil {
+		log.Errorf("networkID: %d, error storing the sovereign GlobalExitRoot. BlockNumber: %d. Error: %v", s.networkID, ger.BlockNumber, err)
+		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
+		if rollbackErr != nil {
+			log.Errorf("networkID: %d, error rolling back state. BlockNumber: %d, rollbackErr: %v, error : %s",
+				s.networkID, ger.BlockNumber, rollbackErr, err.Error())
+			return rollbackErr
+		}
+		return err
+	}
+	return nil
+}
+
+// processUnsetClaim deletes the claim unset on a sovereign chain, so the deposit can be claimed again
+func (s *ClientSynchronizer) processUnsetClaim(claim etherman.Claim, dbTx pgx.Tx) error {
+	claim.NetworkID = s.networkID
+	deleted, err := s.storage.DeleteClaim(s.ctx, &claim, dbTx)
+	if err != nil {
+		log.Errorf("networkID: %d, error deleting the unset Claim in Block: %d, Claim: %+v, err: %v", s.networkID, claim.BlockNumber, claim, err)
+		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
+		if rollbackErr != nil {
+			log.Errorf("networkID: %d, error rolling back state. BlockNumber: %d, rollbackErr: %v, err: %s",
+				s.networkID, claim.BlockNumber, rollbackErr, err.Error())
+			return rollbackErr
+		}
+		return err
+	}
+	if deleted == 0 {
+		log.Warnf("networkID: %d, unset claim %d of mainnetFlag: %v, rollupIndex: %d not found", s.networkID, claim.Index, claim.MainnetFlag, claim.RollupIndex)
+	}
+	return nil
+}
+
+// sendSovereignGlobalExitRoot sends the latest global exit root injected on the sovereign chain to its claim tx
+// manager, like the trusted global exit roots of the sequencer of a rollup
+func (s *ClientSynchronizer) sendSovereignGlobalExitRoot() error {
+	ger, err := s.storage.GetLatestSovereignExitRoot(s.ctx, s.networkID, nil)
+	if errors.Is(err, gerror.ErrStorageNotFound) {
+		log.Debugf("networkID: %d, the injected global exit roots are not synced from L1 yet", s.networkID)
+		return nil
+	} else if err != nil {
+		log.Errorf("networkID: %d, error getting the latest sovereign GER. Error: %v", s.networkID, err)
+		return err
+	}
+	ger.NetworkID = s.networkID
+	s.chExitRootEvent <- ger
+	return nil
+}
@@ -0,0 +1,51 @@
+package synchronizer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessSovereignEvents(t *testing.T) {
+	ctx := context.Background()
+	st := newStorageMock(t)
+	dbTx := newDbTxMock(t)
+	chExitRootEvent := make(chan *etherman.GlobalExitRoot, 1)
+	s := &ClientSynchronizer{ctx: ctx, storage: st, networkID: 2, chExitRootEvent: chExitRootEvent}
+
+	blockHash := common.HexToHash("0x10")
+	injected, removed := common.HexToHash("0x1"), common.HexToHash("0x2")
+	blocks := []etherman.Block{{
+		BlockNumber: 10,
+		BlockHash:   blockHash,
+		SovereignGlobalExitRoots: []etherman.SovereignGlobalExitRoot{
+			{BlockNumber: 10, GlobalExitRoot: injected},
+			{BlockNumber: 10, GlobalExitRoot: removed, Removed: true},
+		},
+		UnsetClaims: []etherman.Claim{{BlockNumber: 10, Index: 3, RollupIndex: 1}},
+	}}
+	order := map[common.Hash][]etherman.Order{blockHash: {
+		{Name: etherman.SovereignGlobalExitRootsOrder, Pos: 0},
+		{Name: etherman.SovereignGlobalExitRootsOrder, Pos: 1},
+		{Name: etherman.UnsetClaimsOrder, Pos: 0},
+	}}
+
+	st.On("BeginDBTransaction", ctx).Return(dbTx, nil).Once()
+	st.On("AddBlock", ctx, &blocks[0], dbTx).Return(uint64(5), nil).Once()
+	st.On("AddSovereignGlobalExitRoot", ctx, &etherman.SovereignGlobalExitRoot{BlockID: 5, BlockNumber: 10, NetworkID: 2, GlobalExitRoot: injected}, dbTx).Return(nil).Once()
+	st.On("AddSovereignGlobalExitRoot", ctx, &etherman.SovereignGlobalExitRoot{BlockID: 5, BlockNumber: 10, NetworkID: 2, GlobalExitRoot: removed, Removed: true}, dbTx).Return(nil).Once()
+	st.On("DeleteClaim", ctx, &etherman.Claim{BlockNumber: 10, Index: 3, RollupIndex: 1, NetworkID: 2}, dbTx).Return(int64(1), nil).Once()
+	st.On("Commit", ctx, dbTx).Return(nil).Once()
+	ger := &etherman.GlobalExitRoot{GlobalExitRoot: injected, ExitRoots: []common.Hash{{}, {}}}
+	st.On("GetLatestSovereignExitRoot", ctx, uint(2), nil).Return(ger, nil).Once()
+
+	err := s.processBlockRange(blocks, order)
+	require.NoError(t, err)
+	// the injected global exit root is sent like a trusted one
+	require.Len(t, chExitRootEvent, 1)
+	assert.Equal(t, ger, <-chExitRootEvent)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sync/atomic"
 	"time"
 
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
@@ -44,6 +45,7 @@ type ClientSynchronizer struct {
 	messagePushProducer messagepush.KafkaProducer
 	redisStorage        redisstorage.RedisStorage
 	rollupID            uint
+	chunkSize           atomic.Uint64
 }
 
 // NewSynchronizer creates and initializes an instance of Synchronizer
@@ -59,6 +61,9 @@ func NewSynchronizer(
 	messagePushProducer messagepush.KafkaProducer,
 	redisStorage redisstorage.RedisStorage,
 	cfg Config) (Synchronizer, error) {
+	if err := validateSyncTargets(cfg.SyncTargets); err != nil {
+		return nil, err
+	}
 	ctx, cancel := context.WithCancel(ctx)
 	networkID, err := ethMan.GetNetworkID(ctx)
 	if err != nil {
@@ -136,64 +141,79 @@ func (s *ClientSynchronizer) Sync() error {
 			}
 			log.Warnf("networkID: %d, error getting the latest block. No data stored. Using initial block: %+v. Error: %s",
 				s.networkID, lastBlockSynced, err.Error())
+		} else if s.ctx.Err() != nil {
+			log.Debugf("NetworkID: %d, synchronizer ctx done", s.networkID)
+			return nil
 		} else {
 			log.Fatalf("networkID: %d, unexpected error getting the latest block. Error: %s", s.networkID, err.Error())
 		}
 	}
 	log.Debugf("NetworkID: %d, initial lastBlockSynced: %+v", s.networkID, lastBlockSynced)
+	var newHeads <-chan uint64
+	if subscriber, ok := s.etherMan.(headSubscriber); ok {
+		newHeads = subscriber.NewHeads()
+	}
 	for {
 		select {
 		case <-s.ctx.Done():
 			log.Debugf("NetworkID: %d, synchronizer ctx done", s.networkID)
 			return nil
 		case <-time.After(waitDuration):
-			log.Debugf("NetworkID: %d, syncing...", s.networkID)
-			//Sync L1Blocks
-			if lastBlockSynced, err = s.syncBlocks(lastBlockSynced); err != nil {
-				log.Warnf("networkID: %d, error syncing blocks: %v", s.networkID, err)
-				lastBlockSynced, err = s.storage.GetLastBlock(s.ctx, s.networkID, nil)
-				if err != nil {
-					log.Fatalf("networkID: %d, error getting lastBlockSynced to resume the synchronization... Error: ", s.networkID, err)
-				}
-				if s.ctx.Err() != nil {
-					continue
-				}
+		case blockNumber := <-newHeads:
+			log.Debugf("NetworkID: %d, new block %d received", s.networkID, blockNumber)
+		}
+		log.Debugf("NetworkID: %d, syncing...", s.networkID)
+		//Sync L1Blocks
+		if lastBlockSynced, err = s.syncBlocks(lastBlockSynced); err != nil {
+			log.Warnf("networkID: %d, error syncing blocks: %v", s.networkID, err)
+			lastBlockSynced, err = s.storage.GetLastBlock(s.ctx, s.networkID, nil)
+			// the synchronizer of a network removed from the registry is stopped while syncing
+			if s.ctx.Err() != nil {
+				log.Debugf("NetworkID: %d, synchronizer ctx done", s.networkID)
+				return nil
 			}
-			if !s.synced {
-				// Check latest Block
-				header, err := s.etherMan.HeaderByNumber(s.ctx, nil)
-				if err != nil {
-					log.Warnf("networkID: %d, error getting latest block from. Error: %s", s.networkID, err.Error())
-					continue
-				}
-				lastKnownBlock := header.Number.Uint64()
-				if lastBlockSynced.BlockNumber == lastKnownBlock && !s.synced {
-					log.Infof("NetworkID %d Synced!", s.networkID)
-					waitDuration = s.cfg.SyncInterval.Duration
-					s.synced = true
-					s.chSynced <- s.networkID
-				}
-				if lastBlockSynced.BlockNumber > lastKnownBlock {
-					if s.networkID == 0 {
-						log.Fatalf("networkID: %d, error: latest Synced BlockNumber (%d) is higher than the latest Proposed block (%d) in the network", s.networkID, lastBlockSynced.BlockNumber, lastKnownBlock)
-					} else {
-						log.Errorf("networkID: %d, error: latest Synced BlockNumber (%d) is higher than the latest Proposed block (%d) in the network", s.networkID, lastBlockSynced.BlockNumber, lastKnownBlock)
-						err = s.resetState(lastKnownBlock)
-						if err != nil {
-							log.Errorf("networkID: %d, error resetting the state to a previous block. Error: %v", s.networkID, err)
-							continue
-						}
+			if err != nil {
+				log.Fatalf("networkID: %d, error getting lastBlockSynced to resume the synchronization... Error: %v", s.networkID, err)
+			}
+		}
+		if !s.synced {
+			// Check latest Block
+			lastKnownBlock, head, err := s.getSyncTargetBlock()
+			if err != nil {
+				log.Warnf("networkID: %d, error getting latest block from. Error: %s", s.networkID, err.Error())
+				continue
+			}
+			if lastBlockSynced.BlockNumber == lastKnownBlock && !s.synced {
+				log.Infof("NetworkID %d Synced!", s.networkID)
+				waitDuration = s.cfg.SyncInterval.Duration
+				s.synced = true
+				s.chSynced <- s.networkID
+			}
+			// the blocks after the sync target can be already synced if the sync target was changed
+			lastKnownBlock = head
+			if lastBlockSynced.BlockNumber > lastKnownBlock {
+				if s.networkID == 0 {
+					log.Fatalf("networkID: %d, error: latest Synced BlockNumber (%d) is higher than the latest Proposed block (%d) in the network", s.networkID, lastBlockSynced.BlockNumber, lastKnownBlock)
+				} else {
+					log.Errorf("networkID: %d, error: latest Synced BlockNumber (%d) is higher than the latest Proposed block (%d) in the network", s.networkID, lastBlockSynced.BlockNumber, lastKnownBlock)
+					err = s.resetState(lastKnownBlock)
+					if err != nil {
+						log.Errorf("networkID: %d, error resetting the state to a previous block. Error: %v", s.networkID, err)
+						continue
 					}
 				}
-			} else { // Sync Trusted GlobalExitRoots if L1 is synced
-				if s.networkID != 0 {
-					continue
-				}
-				log.Infof("networkID: %d, Virtual state is synced, getting trusted state", s.networkID)
-				err = s.syncTrustedState()
-				if err != nil {
-					log.Errorf("networkID: %d, error getting current trusted state", s.networkID)
+			}
+		} else { // Sync Trusted GlobalExitRoots if L1 is synced
+			if s.networkID != 0 {
+				if s.cfg.TrustedL2Deposits {
+					s.promoteTrustedDeposits()
 				}
+				continue
+			}
+			log.Infof("networkID: %d, Virtual state is synced, getting trusted state", s.networkID)
+			err = s.syncTrustedState()
+			if err != nil {
+				log.Errorf("networkID: %d, error getting current trusted state", s.networkID)
 			}
 		}
 	}
@@ -260,26 +280,57 @@ func (s *ClientSynchronizer) syncBlocks(lastBlockSynced *etherman.Block) (*ether
 	}
 	log.Debugf("NetworkID: %d, after checkReorg: no reorg detected", s.networkID)
 	// Call the blockchain to retrieve data
-	header, err := s.etherMan.HeaderByNumber(s.ctx, nil)
+	target, head, err := s.getSyncTargetBlock()
 	if err != nil {
 		return lastBlockSynced, err
 	}
-	lastKnownBlock := header.Number
+	lastKnownBlock := new(big.Int).SetUint64(target)
+	isLatestTarget := s.isLatestSyncTarget()
+	if !isLatestTarget {
+		// the blocks after the sync target are only kept in the unconfirmed view
+		defer func() {
+			s.syncUnconfirmedHead(max(lastBlockSynced.BlockNumber, target)+1, head)
+		}()
+	}
 
 	var fromBlock uint64
 	if lastBlockSynced.BlockNumber > 0 {
 		fromBlock = lastBlockSynced.BlockNumber + 1
 	}
+	if !isLatestTarget && fromBlock > target {
+		log.Debugf("NetworkID: %d, waiting for the sync target to reach block %d, it's %d", s.networkID, fromBlock, target)
+		if !s.synced {
+			log.Infof("NetworkID %d Synced!", s.networkID)
+			waitDuration = s.cfg.SyncInterval.Duration
+			s.synced = true
+			s.chSynced <- s.networkID
+		}
+		return lastBlockSynced, nil
+	}
+
+	// Far behind the sync target, the ranges are fetched concurrently. The last chunk is synced by the
+	// loop below, that detects when the network is synced
+	if s.cfg.FetchWorkers > 1 && fromBlock+s.cfg.SyncChunkSize < target {
+		parallelToBlock := target - s.cfg.SyncChunkSize
+		lastBlockSynced, err = s.syncBlocksParallel(lastBlockSynced, fromBlock, parallelToBlock)
+		if err != nil {
+			return lastBlockSynced, err
+		}
+		fromBlock = parallelToBlock + 1
+	}
 
 	for {
-		toBlock := fromBlock + s.cfg.SyncChunkSize
+		toBlock := fromBlock + s.getChunkSize()
+		if !isLatestTarget && toBlock > target {
+			toBlock = target
+		}
 
 		log.Debugf("NetworkID: %d, Getting bridge info from block %d to block %d", s.networkID, fromBlock, toBlock)
 		// This function returns the rollup information contained in the ethereum blocks and an extra param called order.
 		// Order param is a map that contains the event order to allow the synchronizer store the info in the same order that is read.
 		// Name can be different in the order struct. This name is an identifier to check if the next info that must be stored in the db.
 		// The value pos (position) tells what is the array index where this value is.
-		blocks, order, err := s.etherMan.GetRollupInfoByBlockRange(s.ctx, fromBlock, &toBlock)
+		blocks, order, err := s.fetchBlockRange(s.ctx, fromBlock, toBlock)
 		if err != nil {
 			return lastBlockSynced, err
 		}
@@ -334,7 +385,7 @@ func (s *ClientSynchronizer) syncBlocks(lastBlockSynced *etherman.Block) (*ether
 
 func (s *ClientSynchronizer) processBlockRange(blocks []etherman.Block, order map[common.Hash][]etherman.Order) error {
 	// New info has to be included into the db using the state
-	var isNewGer bool
+	var isNewGer, isNewSovereignGer bool
 	for i := range blocks {
 		// Begin db transaction
 		dbTx, err := s.storage.BeginDBTransaction(s.ctx)
@@ -397,6 +448,20 @@ func (s *ClientSynchronizer) processBlockRange(blocks []etherman.Block, order ma
 			case etherman.ActivateEtrogOrder:
 				// this is activated when the bridge detects the CreateNewRollup or the AddExistingRollup event from the rollupManager
 				log.Info("Event received. Activating LxLyEtrog...")
+			case etherman.SovereignGlobalExitRootsOrder:
+				log.Infof("NetworkID: %d. block %d SovereignGlobalExitRootsOrder", s.networkID, blocks[i].BlockNumber)
+				ger := blocks[i].SovereignGlobalExitRoots[element.Pos]
+				isNewSovereignGer = isNewSovereignGer || !ger.Removed
+				err = s.processSovereignGlobalExitRoot(ger, blockID, dbTx)
+				if err != nil {
+					return err
+				}
+			case etherman.UnsetClaimsOrder:
+				log.Infof("NetworkID: %d. block %d UnsetClaimsOrder", s.networkID, blocks[i].BlockNumber)
+				err = s.processUnsetClaim(blocks[i].UnsetClaims[element.Pos], dbTx)
+				if err != nil {
+					return err
+				}
 			}
 
 			metrics.RecordSynchronizerEvent(uint32(s.networkID), string(element.Name))
@@ -429,6 +494,9 @@ func (s *ClientSynchronizer) processBlockRange(blocks []etherman.Block, order ma
 			s.chExitRootEvent <- ger
 		}
 	}
+	if isNewSovereignGer {
+		return s.sendSovereignGlobalExitRoot()
+	}
 	return nil
 }
 
@@ -440,6 +508,28 @@ func (s *ClientSynchronizer) resetState(blockNumber uint64) error {
 		log.Errorf("networkID: %d, Error starting a db transaction to reset the state. Error: %v", s.networkID, err)
 		return err
 	}
+	journal, err := s.journalReorg(blockNumber, dbTx)
+	if err != nil {
+		log.Errorf("networkID: %d, error journaling the reorg. Error: %v", s.networkID, err)
+		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
+		if rollbackErr != nil {
+			log.Errorf("networkID: %d, error rolling back state to store block. BlockNumber: %d, rollbackErr: %v, error : %s",
+				s.networkID, blockNumber, rollbackErr, err.Error())
+			return rollbackErr
+		}
+		return err
+	}
+	err = s.addReorgedPushMessages(journal, dbTx)
+	if err != nil {
+		log.Errorf("networkID: %d, error adding the push messages of the reorg. Error: %v", s.networkID, err)
+		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
+		if rollbackErr != nil {
+			log.Errorf("networkID: %d, error rolling back state to store block. BlockNumber: %d, rollbackErr: %v, error : %s",
+				s.networkID, blockNumber, rollbackErr, err.Error())
+			return rollbackErr
+		}
+		return err
+	}
 	err = s.storage.Reset(s.ctx, blockNumber, s.networkID, dbTx)
 	if err != nil {
 		log.Errorf("networkID: %d, error resetting the state. Error: %v", s.networkID, err)
@@ -485,7 +575,6 @@ func (s *ClientSynchronizer) resetState(blockNumber uint64) error {
 		}
 		return err
 	}
-
 	return nil
 }
 
@@ -650,8 +739,17 @@ func (s *ClientSynchronizer) processClaim(claim etherman.Claim, blockID uint64,
 	}
 
 	// For X Layer
-	// It shouldn't block the sync process
-	go s.afterProcessClaim(&claim, dbTx)
+	err = s.afterProcessClaim(&claim, dbTx)
+	if err != nil {
+		log.Errorf("networkID: %d, failed to add the push message of the claim in Block: %d, Claim: %+v, err: %v", s.networkID, claim.BlockNumber, claim, err)
+		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
+		if rollbackErr != nil {
+			log.Errorf("networkID: %d, error rolling back state to store block. BlockNumber: %d, rollbackErr: %v, err: %s",
+				s.networkID, claim.BlockNumber, rollbackErr, err.Error())
+			return rollbackErr
+		}
+		return err
+	}
 
 	return nil
 }
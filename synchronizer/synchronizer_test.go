@@ -181,3 +181,13 @@ func TestSyncGer(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestSyncStopped(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	m := newStorageMock(t)
+	sync := &ClientSynchronizer{ctx: ctx, cancelCtx: cancel, storage: m, networkID: 2}
+
+	// the synchronizer of a removed network is stopped while reading the storage, it returns instead of exiting
+	m.On("GetLastBlock", ctx, uint(2), nil).Run(func(args mock.Arguments) { cancel() }).Return(nil, context.Canceled)
+	require.NoError(t, sync.Sync())
+}
@@ -12,10 +12,12 @@ import (
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/metrics"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/pushoutbox"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/pushtask"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/server/tokenlogoinfo"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/utils"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/messagebridge"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/jackc/pgx/v4"
 	"github.com/pkg/errors"
 )
@@ -31,6 +33,9 @@ var (
 
 func (s *ClientSynchronizer) beforeProcessDeposit(deposit *etherman.Deposit) {
 	messagebridge.ReplaceDepositDestAddresses(deposit)
+	if s.networkID != 0 && s.cfg.TrustedL2Deposits {
+		deposit.TrustLevel = etherman.TrustLevelTrusted
+	}
 }
 
 func (s *ClientSynchronizer) afterProcessDeposit(deposit *etherman.Deposit, depositID uint64, dbTx pgx.Tx) error {
@@ -66,60 +71,82 @@ func (s *ClientSynchronizer) afterProcessDeposit(deposit *etherman.Deposit, depo
 	// Replace the USDC info here so that the metrics can report the correct token info
 	messagebridge.ReplaceDepositInfo(deposit, true)
 
-	// Notify FE about a new deposit
-	go func() {
-		if s.messagePushProducer == nil {
-			log.Errorf("kafka push producer is nil, so can't push tx status change msg!")
-			return
-		}
-		if deposit.LeafType != uint8(utils.LeafTypeAsset) {
-			if !messagebridge.IsAllowedContractAddress(origAddress) {
-				log.Infof("transaction is not asset, so skip push update change, hash: %v", deposit.TxHash)
-				return
-			}
-		}
-		rollupWorkId := utils.GetRollupNetworkId()
-		if deposit.NetworkID != rollupWorkId && deposit.DestinationNetwork != rollupWorkId {
-			log.Infof("transaction is not x layer, so skip push msg and filter large tx, hash: %v", deposit.TxHash)
-			return
-		}
-
-		transaction := &pb.Transaction{
-			FromChain:       uint32(deposit.NetworkID),
-			ToChain:         uint32(deposit.DestinationNetwork),
-			BridgeToken:     deposit.OriginalAddress.Hex(),
-			TokenAmount:     deposit.Amount.String(),
-			EstimateTime:    s.getEstimateTimeForDepositCreated(deposit.NetworkID),
-			Time:            uint64(deposit.Time.UnixMilli()),
-			TxHash:          deposit.TxHash.String(),
-			Id:              depositID,
-			Index:           uint64(deposit.DepositCount),
-			Status:          uint32(pb.TransactionStatus_TX_CREATED),
-			BlockNumber:     deposit.BlockNumber,
-			DestAddr:        deposit.DestinationAddress.Hex(),
-			FromChainId:     utils.GetChainIdByNetworkId(deposit.NetworkID),
-			ToChainId:       utils.GetChainIdByNetworkId(deposit.DestinationNetwork),
-			GlobalIndex:     s.getGlobalIndex(deposit).String(),
-			LeafType:        uint32(deposit.LeafType),
-			OriginalNetwork: uint32(deposit.OriginalNetwork),
-		}
-		transactionMap := make(map[string][]*pb.Transaction)
-		chainId := utils.GetChainIdByNetworkId(deposit.OriginalNetwork)
-		logoCacheKey := tokenlogoinfo.GetTokenLogoMapKey(transaction.GetBridgeToken(), chainId)
-		transactionMap[logoCacheKey] = append(transactionMap[logoCacheKey], transaction)
-		tokenlogoinfo.FillLogoInfos(s.ctx, s.redisStorage, transactionMap)
-		err := s.messagePushProducer.PushTransactionUpdate(transaction)
+	// Notify FE about a new deposit, the update is pushed by the outbox relay once the block is committed
+	transaction := s.newDepositCreatedTransaction(deposit, origAddress, depositID)
+	if transaction != nil {
+		err = s.addPushOutboxMessage(transaction, deposit.BlockID, dbTx)
 		if err != nil {
-			log.Errorf("PushTransactionUpdate error: %v", err)
+			log.Errorf("networkID: %d, failed to add the push message of the deposit, BlockNumber: %d, Deposit: %+v, err: %s", s.networkID, deposit.BlockNumber, deposit, err)
+			rollbackErr := s.storage.Rollback(s.ctx, dbTx)
+			if rollbackErr != nil {
+				log.Errorf("networkID: %d, error rolling back state to store block. BlockNumber: %v, rollbackErr: %v, err: %s",
+					s.networkID, deposit.BlockNumber, rollbackErr, err.Error())
+				return rollbackErr
+			}
+			return err
 		}
 		// filter and cache large transactions
-		s.filterLargeTransaction(s.ctx, transaction, uint(chainId))
-	}()
+		go func() {
+			chainId := utils.GetChainIdByNetworkId(deposit.OriginalNetwork)
+			logoCacheKey := tokenlogoinfo.GetTokenLogoMapKey(transaction.GetBridgeToken(), chainId)
+			tokenlogoinfo.FillLogoInfos(s.ctx, s.redisStorage, map[string][]*pb.Transaction{logoCacheKey: {transaction}})
+			s.filterLargeTransaction(s.ctx, transaction, uint(chainId))
+		}()
+	}
 
 	metrics.RecordOrder(uint32(deposit.NetworkID), uint32(deposit.DestinationNetwork), uint32(deposit.LeafType), uint32(deposit.OriginalNetwork), deposit.OriginalAddress, deposit.Amount)
 	return nil
 }
 
+// newDepositCreatedTransaction returns the created update of the deposit, nil if it isn't pushed
+func (s *ClientSynchronizer) newDepositCreatedTransaction(deposit *etherman.Deposit, origAddress common.Address, depositID uint64) *pb.Transaction {
+	if s.messagePushProducer == nil {
+		log.Errorf("kafka push producer is nil, so can't push tx status change msg!")
+		return nil
+	}
+	if deposit.LeafType != uint8(utils.LeafTypeAsset) {
+		if !messagebridge.IsAllowedContractAddress(origAddress) {
+			log.Infof("transaction is not asset, so skip push update change, hash: %v", deposit.TxHash)
+			return nil
+		}
+	}
+	rollupWorkId := utils.GetRollupNetworkId()
+	if deposit.NetworkID != rollupWorkId && deposit.DestinationNetwork != rollupWorkId {
+		log.Infof("transaction is not x layer, so skip push msg and filter large tx, hash: %v", deposit.TxHash)
+		return nil
+	}
+
+	return &pb.Transaction{
+		FromChain:       uint32(deposit.NetworkID),
+		ToChain:         uint32(deposit.DestinationNetwork),
+		BridgeToken:     deposit.OriginalAddress.Hex(),
+		TokenAmount:     deposit.Amount.String(),
+		EstimateTime:    s.getEstimateTimeForDepositCreated(deposit.NetworkID),
+		Time:            uint64(deposit.Time.UnixMilli()),
+		TxHash:          deposit.TxHash.String(),
+		Id:              depositID,
+		Index:           uint64(deposit.DepositCount),
+		Status:          uint32(pb.TransactionStatus_TX_CREATED),
+		BlockNumber:     deposit.BlockNumber,
+		DestAddr:        deposit.DestinationAddress.Hex(),
+		FromChainId:     utils.GetChainIdByNetworkId(deposit.NetworkID),
+		ToChainId:       utils.GetChainIdByNetworkId(deposit.DestinationNetwork),
+		GlobalIndex:     s.getGlobalIndex(deposit).String(),
+		LeafType:        uint32(deposit.LeafType),
+		OriginalNetwork: uint32(deposit.OriginalNetwork),
+		Trusted:         deposit.TrustLevel == etherman.TrustLevelTrusted,
+	}
+}
+
+// addPushOutboxMessage stores the transaction update in the db tx, to be pushed by the outbox relay
+func (s *ClientSynchronizer) addPushOutboxMessage(transaction *pb.Transaction, blockID uint64, dbTx pgx.Tx) error {
+	msg, err := pushoutbox.NewMessage(transaction, blockID)
+	if err != nil {
+		return err
+	}
+	return s.storage.AddPushOutboxMessage(s.ctx, msg, dbTx)
+}
+
 func (s *ClientSynchronizer) filterLargeTransaction(ctx context.Context, transaction *pb.Transaction, chainId uint) {
 	if transaction.LogoInfo == nil {
 		log.Infof("failed to get logo info, so skip filter large transaction, tx: %v", transaction.GetTxHash())
@@ -182,48 +209,42 @@ func (s *ClientSynchronizer) getEstimateTimeForDepositCreated(networkId uint) ui
 	return uint32(pushtask.GetAvgCommitDuration(s.ctx, s.redisStorage))
 }
 
-func (s *ClientSynchronizer) afterProcessClaim(claim *etherman.Claim, dbTx pgx.Tx) {
+func (s *ClientSynchronizer) afterProcessClaim(claim *etherman.Claim, dbTx pgx.Tx) error {
 	// Try to retrieve deposit transaction info
-	deposit, err := s.storage.GetDeposit(s.ctx, claim.Index, claim.OriginalNetwork, nil)
+	deposit, err := s.storage.GetDeposit(s.ctx, claim.Index, claim.OriginalNetwork, dbTx)
 	if err != nil || deposit == nil {
 		log.Warnf("failed to get deposit for claim, claim: %+v, err: %v", claim, err)
-		return
+		return nil
 	}
 
 	err = s.processWstETHClaim(deposit, dbTx)
 	if err != nil {
 		log.Warnf("failed to process wstETH claim, claim: %+v, err: %v", claim, err)
-		return
+		return nil
 	}
 
 	// Notify FE that the tx has been claimed
-	go func() {
-		if s.messagePushProducer == nil {
-			log.Errorf("kafka push producer is nil, so can't push tx status change msg!")
-			return
-		}
-
-		if deposit.LeafType != uint8(utils.LeafTypeAsset) {
-			if !messagebridge.IsAllowedContractAddress(deposit.OriginalAddress) {
-				log.Infof("transaction is not asset, so skip push update change, hash: %v", deposit.TxHash)
-				return
-			}
-		}
-		err = s.messagePushProducer.PushTransactionUpdate(&pb.Transaction{
-			FromChain:   uint32(deposit.NetworkID),
-			ToChain:     uint32(deposit.DestinationNetwork),
-			TxHash:      deposit.TxHash.String(),
-			Index:       uint64(deposit.DepositCount),
-			Status:      uint32(pb.TransactionStatus_TX_CLAIMED),
-			ClaimTxHash: claim.TxHash.Hex(),
-			ClaimTime:   uint64(claim.Time.UnixMilli()),
-			DestAddr:    deposit.DestinationAddress.Hex(),
-			GlobalIndex: s.getGlobalIndex(deposit).String(),
-		})
-		if err != nil {
-			log.Errorf("PushTransactionUpdate error: %v", err)
+	if s.messagePushProducer == nil {
+		log.Errorf("kafka push producer is nil, so can't push tx status change msg!")
+		return nil
+	}
+	if deposit.LeafType != uint8(utils.LeafTypeAsset) {
+		if !messagebridge.IsAllowedContractAddress(deposit.OriginalAddress) {
+			log.Infof("transaction is not asset, so skip push update change, hash: %v", deposit.TxHash)
+			return nil
 		}
-	}()
+	}
+	return s.addPushOutboxMessage(&pb.Transaction{
+		FromChain:   uint32(deposit.NetworkID),
+		ToChain:     uint32(deposit.DestinationNetwork),
+		TxHash:      deposit.TxHash.String(),
+		Index:       uint64(deposit.DepositCount),
+		Status:      uint32(pb.TransactionStatus_TX_CLAIMED),
+		ClaimTxHash: claim.TxHash.Hex(),
+		ClaimTime:   uint64(claim.Time.UnixMilli()),
+		DestAddr:    deposit.DestinationAddress.Hex(),
+		GlobalIndex: s.getGlobalIndex(deposit).String(),
+	}, claim.BlockID, dbTx)
 }
 
 func (s *ClientSynchronizer) getGlobalIndex(deposit *etherman.Deposit) *big.Int {
@@ -236,8 +257,14 @@ func (s *ClientSynchronizer) getGlobalIndex(deposit *etherman.Deposit) *big.Int
 func (s *ClientSynchronizer) recordLatestBlockNum() {
 	log.Debugf("Start recordLatestBlockNum")
 	ticker := time.NewTicker(2 * time.Second) //nolint:gomnd
+	defer ticker.Stop()
 
-	for range ticker.C {
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+		}
 		// Get the latest block header
 		header, err := s.etherMan.HeaderByNumber(s.ctx, nil)
 		if err != nil {
@@ -0,0 +1,47 @@
+package synchronizer
+
+import (
+	"sort"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+)
+
+// promoteTrustedDeposits marks as virtual the trusted deposits of the blocks that are already virtualized. The
+// blocks are virtualized in order, so the last virtualized one is searched among the blocks with trusted
+// deposits. The trusted deposits of the blocks replaced by the sequencer are removed by the reorg detection
+func (s *ClientSynchronizer) promoteTrustedDeposits() {
+	blocks, err := s.storage.GetTrustedDepositBlocks(s.ctx, s.networkID, nil)
+	if err != nil {
+		log.Errorf("networkID: %d, error getting the blocks with trusted deposits. Error: %v", s.networkID, err)
+		return
+	}
+	if len(blocks) == 0 {
+		return
+	}
+	var searchErr error
+	// n is the number of blocks that are virtualized
+	n := sort.Search(len(blocks), func(i int) bool {
+		if searchErr != nil {
+			return true
+		}
+		virtualized, err := s.etherMan.IsBlockVirtualized(s.ctx, blocks[i])
+		if err != nil {
+			searchErr = err
+			return true
+		}
+		return !virtualized
+	})
+	if searchErr != nil {
+		log.Errorf("networkID: %d, error checking if the trusted blocks are virtualized. Error: %v", s.networkID, searchErr)
+		return
+	}
+	if n == 0 {
+		return
+	}
+	promoted, err := s.storage.PromoteTrustedDeposits(s.ctx, s.networkID, blocks[n-1], nil)
+	if err != nil {
+		log.Errorf("networkID: %d, error promoting the trusted deposits up to block %d. Error: %v", s.networkID, blocks[n-1], err)
+		return
+	}
+	log.Infof("networkID: %d, %d trusted deposits up to block %d are virtualized", s.networkID, promoted, blocks[n-1])
+}
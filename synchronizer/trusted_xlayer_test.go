@@ -0,0 +1,50 @@
+package synchronizer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBeforeProcessDepositTrustLevel(t *testing.T) {
+	s := &ClientSynchronizer{networkID: 1, cfg: Config{TrustedL2Deposits: true}}
+	deposit := &etherman.Deposit{}
+	s.beforeProcessDeposit(deposit)
+	assert.Equal(t, etherman.TrustLevelTrusted, deposit.TrustLevel)
+
+	// the L1 deposits are always virtual
+	s = &ClientSynchronizer{networkID: 0, cfg: Config{TrustedL2Deposits: true}}
+	deposit = &etherman.Deposit{}
+	s.beforeProcessDeposit(deposit)
+	assert.Equal(t, etherman.TrustLevelVirtual, deposit.TrustLevel)
+}
+
+func TestPromoteTrustedDeposits(t *testing.T) {
+	ctx := context.Background()
+	var dbTx pgx.Tx
+	blocks := []uint64{10, 12, 15, 20, 21}
+
+	m := newEthermanMock(t)
+	st := newStorageMock(t)
+	s := &ClientSynchronizer{ctx: ctx, etherMan: m, storage: st, networkID: 1}
+	st.On("GetTrustedDepositBlocks", ctx, uint(1), dbTx).Return(blocks, nil)
+	m.On("IsBlockVirtualized", ctx, uint64(15)).Return(true, nil).Once()
+	m.On("IsBlockVirtualized", ctx, uint64(21)).Return(false, nil).Once()
+	m.On("IsBlockVirtualized", ctx, uint64(20)).Return(false, nil).Once()
+	st.On("PromoteTrustedDeposits", ctx, uint(1), uint64(15), dbTx).Return(int64(3), nil).Once()
+	s.promoteTrustedDeposits()
+
+	// nothing is promoted while the first block is not virtualized
+	m.On("IsBlockVirtualized", ctx, uint64(15)).Return(false, nil).Once()
+	m.On("IsBlockVirtualized", ctx, uint64(12)).Return(false, nil).Once()
+	m.On("IsBlockVirtualized", ctx, uint64(10)).Return(false, nil).Once()
+	s.promoteTrustedDeposits()
+
+	// nothing is promoted if the node fails
+	m.On("IsBlockVirtualized", ctx, uint64(15)).Return(false, errors.New("node error")).Once()
+	s.promoteTrustedDeposits()
+}
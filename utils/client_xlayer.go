@@ -7,6 +7,7 @@ import (
 
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/0xPolygonHermez/zkevm-node/etherman/smartcontracts/polygonzkevmbridge"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -82,3 +83,42 @@ func (c *Client) SendClaimXLayer(ctx context.Context, deposit *etherman.Deposit,
 
 	return tx, nil
 }
+
+// GetGlobalIndex returns the global index of the deposit, the rollup index of the networks settled on L1 is their
+// network ID - 1
+func GetGlobalIndex(deposit *etherman.Deposit) *big.Int {
+	mainnetFlag := deposit.NetworkID == 0
+	var rollupIndex uint
+	if !mainnetFlag {
+		rollupIndex = deposit.NetworkID - 1
+	}
+	return etherman.GenerateGlobalIndex(mainnetFlag, rollupIndex, deposit.DepositCount)
+}
+
+// BuildClaimCalldata packs the claimAsset or claimMessage call of the bridge for the deposit, it returns the name
+// of the method and the calldata
+func BuildClaimCalldata(deposit *etherman.Deposit, smtProof, rollupSmtProof [mtHeight][keyLen]byte, globalExitRoot *etherman.GlobalExitRoot) (string, []byte, error) {
+	bridgeABI, err := polygonzkevmbridge.PolygonzkevmbridgeMetaData.GetAbi()
+	if err != nil {
+		return "", nil, err
+	}
+	var method string
+	destAddr := deposit.DestinationAddress
+	switch deposit.LeafType {
+	case uint8(LeafTypeAsset):
+		method = "claimAsset"
+	case uint8(LeafTypeMessage):
+		method = "claimMessage"
+		if deposit.DestContractAddress != (common.Address{}) {
+			destAddr = deposit.DestContractAddress
+		}
+	default:
+		return "", nil, fmt.Errorf("unknown leaf type %d", deposit.LeafType)
+	}
+	data, err := bridgeABI.Pack(method, smtProof, rollupSmtProof, GetGlobalIndex(deposit), globalExitRoot.ExitRoots[0], globalExitRoot.ExitRoots[1],
+		uint32(deposit.OriginalNetwork), deposit.OriginalAddress, uint32(deposit.DestinationNetwork), destAddr, deposit.Amount, deposit.Metadata)
+	if err != nil {
+		return "", nil, err
+	}
+	return method, data, nil
+}
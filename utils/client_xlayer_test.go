@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-node/etherman/smartcontracts/polygonzkevmbridge"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetGlobalIndex(t *testing.T) {
+	assert.Equal(t, etherman.GenerateGlobalIndex(true, 0, 5), GetGlobalIndex(&etherman.Deposit{NetworkID: 0, DepositCount: 5}))
+	assert.Equal(t, etherman.GenerateGlobalIndex(false, 2, 5), GetGlobalIndex(&etherman.Deposit{NetworkID: 3, DepositCount: 5}))
+}
+
+func TestBuildClaimCalldata(t *testing.T) {
+	bridgeABI, err := polygonzkevmbridge.PolygonzkevmbridgeMetaData.GetAbi()
+	require.NoError(t, err)
+	var smtProof, rollupSmtProof [mtHeight][keyLen]byte
+	smtProof[0][0], rollupSmtProof[1][0] = 1, 2
+	ger := &etherman.GlobalExitRoot{ExitRoots: []common.Hash{common.HexToHash("0x1"), common.HexToHash("0x2")}}
+	deposit := &etherman.Deposit{
+		LeafType:            uint8(LeafTypeMessage),
+		OriginalNetwork:     1,
+		OriginalAddress:     common.HexToAddress("0xa"),
+		Amount:              big.NewInt(10),
+		DestinationNetwork:  2,
+		DestinationAddress:  common.HexToAddress("0xb"),
+		DestContractAddress: common.HexToAddress("0xc"),
+		DepositCount:        7,
+		NetworkID:           1,
+		Metadata:            []byte{1, 2},
+	}
+
+	method, data, err := BuildClaimCalldata(deposit, smtProof, rollupSmtProof, ger)
+	require.NoError(t, err)
+	assert.Equal(t, "claimMessage", method)
+	assert.Equal(t, bridgeABI.Methods[method].ID, data[:4])
+	args, err := bridgeABI.Methods[method].Inputs.Unpack(data[4:])
+	require.NoError(t, err)
+	assert.Equal(t, smtProof, args[0])
+	assert.Equal(t, rollupSmtProof, args[1])
+	assert.Equal(t, etherman.GenerateGlobalIndex(false, 0, 7), args[2])
+	assert.Equal(t, [32]byte(ger.ExitRoots[1]), args[4])
+	// the messages are claimed to the destination contract
+	assert.Equal(t, common.HexToAddress("0xc"), args[8])
+	assert.Equal(t, []byte{1, 2}, args[10])
+
+	deposit.LeafType = uint8(LeafTypeAsset)
+	method, data, err = BuildClaimCalldata(deposit, smtProof, rollupSmtProof, ger)
+	require.NoError(t, err)
+	assert.Equal(t, "claimAsset", method)
+	args, err = bridgeABI.Methods[method].Inputs.Unpack(data[4:])
+	require.NoError(t, err)
+	assert.Equal(t, common.HexToAddress("0xb"), args[8])
+
+	deposit.LeafType = 5
+	_, _, err = BuildClaimCalldata(deposit, smtProof, rollupSmtProof, ger)
+	assert.Error(t, err)
+}
@@ -54,6 +54,7 @@ func EthermanDepositToPbTransaction(deposit *etherman.Deposit) *pb.Transaction {
 		BlockNumber:      deposit.BlockNumber,
 		DestContractAddr: deposit.DestContractAddress.Hex(),
 		OriginalNetwork:  uint32(deposit.OriginalNetwork),
+		Trusted:          deposit.TrustLevel == etherman.TrustLevelTrusted,
 	}
 }
 
@@ -1,21 +1,8 @@
 package utils
 
-type NetworkChainIdMapping struct {
-	chainIDs map[uint]uint32
-}
-
-var networkChainIdMapping NetworkChainIdMapping
-
-func InitChainIdManager(networks []uint, chainIds []uint) {
-	var chainIDs = make(map[uint]uint32)
-	for i, network := range networks {
-		chainIDs[network] = uint32(chainIds[i])
-	}
-	networkChainIdMapping = NetworkChainIdMapping{
-		chainIDs: chainIDs,
-	}
-}
+import "github.com/0xPolygonHermez/zkevm-bridge-service/networkregistry"
 
+// GetChainIdByNetworkId returns the chain ID of the network in the network registry
 func GetChainIdByNetworkId(networkId uint) uint32 {
-	return networkChainIdMapping.chainIDs[networkId]
+	return networkregistry.Default().ChainID(networkId)
 }
@@ -1,19 +1,21 @@
 package utils
 
+import "github.com/0xPolygonHermez/zkevm-bridge-service/networkregistry"
+
 const (
 	MainNetworkId = 0
 )
 
-var rollupNetworkId uint
-
+// InitRollupNetworkId sets the rollup network of the network registry
 func InitRollupNetworkId(rollupNetWorkId uint) {
-	rollupNetworkId = rollupNetWorkId
+	networkregistry.Default().SetRollupNetworkID(rollupNetWorkId)
 }
 
 func GetMainNetworkId() uint {
 	return MainNetworkId
 }
 
+// GetRollupNetworkId returns the rollup network of the network registry
 func GetRollupNetworkId() uint {
-	return rollupNetworkId
+	return networkregistry.Default().RollupNetworkID()
 }
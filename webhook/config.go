@@ -0,0 +1,25 @@
+package webhook
+
+import "github.com/0xPolygonHermez/zkevm-node/config/types"
+
+// Config is the configuration of the webhook delivery of the transaction updates
+type Config struct {
+	// Enabled whether the transaction updates are delivered to the webhook subscriptions
+	Enabled bool `mapstructure:"Enabled"`
+	// PollInterval is the interval to look for the deliveries to send
+	PollInterval types.Duration `mapstructure:"PollInterval"`
+	// RefreshInterval is the interval to reload the subscriptions, the new ones receive the updates after it
+	RefreshInterval types.Duration `mapstructure:"RefreshInterval"`
+	// RequestTimeout is the timeout of the HTTP requests to the subscriptions
+	RequestTimeout types.Duration `mapstructure:"RequestTimeout"`
+	// MaxAttempts is the number of attempts of a delivery before it's marked as failed
+	MaxAttempts uint `mapstructure:"MaxAttempts"`
+	// InitialBackoff is the wait after the first failed attempt, it's doubled after each one up to MaxBackoff
+	InitialBackoff types.Duration `mapstructure:"InitialBackoff"`
+	MaxBackoff     types.Duration `mapstructure:"MaxBackoff"`
+	// BatchSize is the max number of deliveries sent on each poll
+	BatchSize uint `mapstructure:"BatchSize"`
+	// AllowPrivateHosts allows the subscriptions to loopback, private and link-local addresses, only
+	// meant for local environments
+	AllowPrivateHosts bool `mapstructure:"AllowPrivateHosts"`
+}
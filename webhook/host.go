@@ -0,0 +1,63 @@
+package webhook
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidURL is returned when the url of a subscription is not an absolute http or https url
+	ErrInvalidURL = errors.New("invalid url, it must be an absolute http or https url")
+	// ErrPrivateHost is returned when the host of a subscription is a loopback, private or link-local address
+	ErrPrivateHost = errors.New("the url must point to a public host")
+
+	// sharedAddressSpace is the carrier-grade NAT range, it's not covered by net.IP.IsPrivate
+	sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)} //nolint:gomnd
+)
+
+// CheckURL returns an error if the url can't be used by a subscription. Unless the private hosts are
+// allowed, every address the host resolves to must be public
+func (d *Dispatcher) CheckURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return ErrInvalidURL
+	}
+	if d.cfg.AllowPrivateHosts {
+		return nil
+	}
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, u.Hostname())
+	if err != nil {
+		return errors.Wrapf(err, "failed to resolve the host %s", u.Hostname())
+	}
+	for _, addr := range addrs {
+		if !isPublicIP(addr.IP) {
+			return ErrPrivateHost
+		}
+	}
+	return nil
+}
+
+// isPublicIP returns whether the address is reachable on the internet, the deliveries are never sent to
+// the hosts of the internal network of the service
+func isPublicIP(ip net.IP) bool {
+	return ip != nil && !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsUnspecified() && !ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() && !ip.IsInterfaceLocalMulticast() && !ip.IsMulticast() && !sharedAddressSpace.Contains(ip)
+}
+
+// dialControl rejects the connections to the addresses that are not public. It's checked when dialing, so a
+// host that resolved to a public address when the subscription was added can't be pointed to a private one
+// later, and the redirects are covered too
+func dialControl(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if !isPublicIP(net.ParseIP(host)) {
+		return ErrPrivateHost
+	}
+	return nil
+}
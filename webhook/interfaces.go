@@ -0,0 +1,17 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/jackc/pgx/v4"
+)
+
+type storageInterface interface {
+	GetWebhookSubscriptions(ctx context.Context, dbTx pgx.Tx) ([]*Subscription, error)
+	AddWebhookDeliveries(ctx context.Context, deliveries []*Delivery, dbTx pgx.Tx) error
+	ClaimDueWebhookDeliveries(ctx context.Context, limit uint, claimedUntil time.Time, dbTx pgx.Tx) ([]*Delivery, error)
+	UpdateWebhookDelivery(ctx context.Context, delivery *Delivery, dbTx pgx.Tx) error
+	GetDeposit(ctx context.Context, depositCounterUser uint, networkID uint, dbTx pgx.Tx) (*etherman.Deposit, error)
+}
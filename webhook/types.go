@@ -0,0 +1,58 @@
+package webhook
+
+import (
+	"time"
+)
+
+const (
+	// DeliveryStatusPending means the delivery is waiting to be sent or retried
+	DeliveryStatusPending = DeliveryStatus("pending")
+
+	// DeliveryStatusDelivered means the subscription answered the delivery with a 2xx status
+	DeliveryStatusDelivered = DeliveryStatus("delivered")
+
+	// DeliveryStatusFailed means the delivery ran out of attempts or its subscription was removed
+	DeliveryStatusFailed = DeliveryStatus("failed")
+)
+
+// DeliveryStatus represents the status of a delivery
+type DeliveryStatus string
+
+// IsValid returns whether the status is known
+func (s DeliveryStatus) IsValid() bool {
+	return s == DeliveryStatusPending || s == DeliveryStatusDelivered || s == DeliveryStatusFailed
+}
+
+// Subscription is an HTTP endpoint receiving the transaction updates that match its filters, an empty
+// filter matches everything
+type Subscription struct {
+	ID     uint64
+	URL    string
+	Secret string
+	// Owner is the name of the integrator that added the subscription, only it can read or delete it
+	Owner string
+	// DestAddr filters the updates by destination address
+	DestAddr string
+	// TokenAddr filters the updates by original token address
+	TokenAddr string
+	// NetworkIDs filters the updates by source or destination network
+	NetworkIDs []uint
+	Enabled    bool
+	CreatedAt  time.Time
+}
+
+// Delivery is a transaction update sent to a subscription, it's the log of the attempts to send it
+type Delivery struct {
+	ID             uint64
+	SubscriptionID uint64
+	// Event is the status of the transaction update
+	Event         string
+	Payload       []byte
+	Status        DeliveryStatus
+	Attempts      uint
+	ResponseCode  int
+	LastError     string
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
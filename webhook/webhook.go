@@ -0,0 +1,296 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/log"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// SignatureHeader is the HMAC-SHA256 signature of the timestamp and the payload, see Sign
+	SignatureHeader = "X-Webhook-Signature"
+	// TimestampHeader is the unix time the delivery attempt was sent
+	TimestampHeader  = "X-Webhook-Timestamp"
+	deliveryIDHeader = "X-Webhook-Delivery"
+	eventHeader      = "X-Webhook-Event"
+
+	signaturePrefix  = "sha256="
+	maxErrorLength   = 256
+	maxResponseBytes = 64 * 1024
+)
+
+// payload is the body of the deliveries
+type payload struct {
+	Event       string          `json:"event"`
+	Time        int64           `json:"time"`
+	Transaction json.RawMessage `json:"transaction"`
+}
+
+// Dispatcher delivers the transaction updates to the webhook subscriptions. The updates are stored as
+// deliveries when they're published, and sent in the background with retries, so they survive restarts
+type Dispatcher struct {
+	cfg     Config
+	storage storageInterface
+	client  *http.Client
+
+	mu            sync.Mutex
+	subscriptions []*Subscription
+	refreshedAt   time.Time
+}
+
+// NewDispatcher creates the webhook dispatcher
+func NewDispatcher(cfg Config, storage interface{}) *Dispatcher {
+	client := &http.Client{Timeout: cfg.RequestTimeout.Duration}
+	if !cfg.AllowPrivateHosts {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.Proxy = nil
+		transport.DialContext = (&net.Dialer{Timeout: cfg.RequestTimeout.Duration, Control: dialControl}).DialContext
+		client.Transport = transport
+	}
+	return &Dispatcher{
+		cfg:     cfg,
+		storage: storage.(storageInterface),
+		client:  client,
+	}
+}
+
+// PublishTransactionUpdate stores a delivery of the transaction update for each subscription matching it
+func (d *Dispatcher) PublishTransactionUpdate(ctx context.Context, tx *pb.Transaction) error {
+	subscriptions, err := d.getSubscriptions(ctx)
+	if err != nil {
+		return err
+	}
+	var (
+		deliveries    []*Delivery
+		body          []byte
+		tokenAddr     = tx.BridgeToken
+		tokenResolved = tokenAddr != ""
+	)
+	for _, sub := range subscriptions {
+		// Only the created updates have the token, it's read from the deposit for the status changes
+		if sub.TokenAddr != "" && !tokenResolved {
+			tokenAddr = d.getDepositToken(ctx, tx)
+			tokenResolved = true
+		}
+		if !sub.match(tx, tokenAddr) {
+			continue
+		}
+		if body == nil {
+			body, err = newPayload(tx)
+			if err != nil {
+				return err
+			}
+		}
+		deliveries = append(deliveries, &Delivery{
+			SubscriptionID: sub.ID,
+			Event:          pb.TransactionStatus(tx.Status).String(),
+			Payload:        body,
+			Status:         DeliveryStatusPending,
+			NextAttemptAt:  time.Now(),
+		})
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+	err = d.storage.AddWebhookDeliveries(ctx, deliveries, nil)
+	return errors.Wrap(err, "AddWebhookDeliveries error")
+}
+
+// Start sends the due deliveries every poll interval until the context is done. Several replicas can run
+// it, each delivery is claimed by the one sending it
+func (d *Dispatcher) Start(ctx context.Context) {
+	log.Info("webhook dispatcher started")
+	ticker := time.NewTicker(d.cfg.PollInterval.Duration)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("webhook dispatcher stopped")
+			return
+		case <-ticker.C:
+			if err := d.deliverDue(ctx); err != nil {
+				log.Errorf("error sending the webhook deliveries: %v", err)
+			}
+		}
+	}
+}
+
+// deliverDue claims the due deliveries and sends them. The claim pushes their next attempt after the time
+// needed to send the whole batch, so no db tx is kept open during the requests, and the deliveries of a
+// replica that stops before sending them are due again after it
+func (d *Dispatcher) deliverDue(ctx context.Context) error {
+	claimedUntil := time.Now().Add(d.cfg.RequestTimeout.Duration * time.Duration(d.cfg.BatchSize+1))
+	deliveries, err := d.storage.ClaimDueWebhookDeliveries(ctx, d.cfg.BatchSize, claimedUntil, nil)
+	if err != nil || len(deliveries) == 0 {
+		return err
+	}
+	subscriptions, err := d.getSubscriptions(ctx)
+	if err != nil {
+		return err
+	}
+	byID := make(map[uint64]*Subscription, len(subscriptions))
+	for _, sub := range subscriptions {
+		byID[sub.ID] = sub
+	}
+
+	for _, delivery := range deliveries {
+		sub, ok := byID[delivery.SubscriptionID]
+		if ok {
+			d.deliver(ctx, sub, delivery)
+		} else {
+			delivery.Status = DeliveryStatusFailed
+			delivery.LastError = "subscription removed"
+		}
+		if err = d.storage.UpdateWebhookDelivery(ctx, delivery, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deliver sends the delivery and updates its status, a failed attempt is retried after a backoff until
+// the max attempts are reached
+func (d *Dispatcher) deliver(ctx context.Context, sub *Subscription, delivery *Delivery) {
+	delivery.Attempts++
+	code, err := d.send(ctx, sub, delivery)
+	delivery.ResponseCode = code
+	if err == nil {
+		delivery.Status = DeliveryStatusDelivered
+		delivery.LastError = ""
+		log.Debugf("webhook delivery %d sent to subscription %d", delivery.ID, sub.ID)
+		return
+	}
+	delivery.LastError = err.Error()
+	if len(delivery.LastError) > maxErrorLength {
+		delivery.LastError = delivery.LastError[:maxErrorLength]
+	}
+	if delivery.Attempts >= d.cfg.MaxAttempts {
+		delivery.Status = DeliveryStatusFailed
+		log.Warnf("webhook delivery %d to subscription %d failed after %d attempts: %v", delivery.ID, sub.ID, delivery.Attempts, err)
+		return
+	}
+	delivery.NextAttemptAt = time.Now().Add(d.backoff(delivery.Attempts))
+	log.Debugf("webhook delivery %d to subscription %d failed, retrying at %v: %v", delivery.ID, sub.ID, delivery.NextAttemptAt, err)
+}
+
+func (d *Dispatcher) send(ctx context.Context, sub *Subscription, delivery *Delivery) (int, error) {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(eventHeader, delivery.Event)
+	req.Header.Set(deliveryIDHeader, strconv.FormatUint(delivery.ID, 10))
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, signaturePrefix+Sign(sub.Secret, timestamp, delivery.Payload))
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBytes))
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return resp.StatusCode, fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// backoff returns the wait after the failed attempt, it doubles on each attempt up to the max backoff
+func (d *Dispatcher) backoff(attempts uint) time.Duration {
+	backoff := d.cfg.InitialBackoff.Duration
+	for i := uint(1); i < attempts && backoff < d.cfg.MaxBackoff.Duration; i++ {
+		backoff *= 2
+	}
+	if backoff > d.cfg.MaxBackoff.Duration {
+		backoff = d.cfg.MaxBackoff.Duration
+	}
+	return backoff
+}
+
+// getSubscriptions returns the enabled subscriptions, reloading them after the refresh interval. The
+// previous ones are kept if they can't be reloaded
+func (d *Dispatcher) getSubscriptions(ctx context.Context) ([]*Subscription, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.subscriptions != nil && time.Since(d.refreshedAt) < d.cfg.RefreshInterval.Duration {
+		return d.subscriptions, nil
+	}
+	subscriptions, err := d.storage.GetWebhookSubscriptions(ctx, nil)
+	if err != nil {
+		if d.subscriptions != nil {
+			log.Warnf("error reloading the webhook subscriptions, using the previous ones: %v", err)
+			return d.subscriptions, nil
+		}
+		return nil, errors.Wrap(err, "GetWebhookSubscriptions error")
+	}
+	d.subscriptions = append([]*Subscription{}, subscriptions...)
+	d.refreshedAt = time.Now()
+	return d.subscriptions, nil
+}
+
+func (d *Dispatcher) getDepositToken(ctx context.Context, tx *pb.Transaction) string {
+	deposit, err := d.storage.GetDeposit(ctx, uint(tx.Index), uint(tx.FromChain), nil)
+	if err != nil {
+		log.Warnf("error getting the deposit %d of network %d for the webhook token filter: %v", tx.Index, tx.FromChain, err)
+		return ""
+	}
+	return deposit.OriginalAddress.Hex()
+}
+
+func (s *Subscription) match(tx *pb.Transaction, tokenAddr string) bool {
+	if s.DestAddr != "" && !strings.EqualFold(s.DestAddr, tx.DestAddr) {
+		return false
+	}
+	if s.TokenAddr != "" && !strings.EqualFold(s.TokenAddr, tokenAddr) {
+		return false
+	}
+	if len(s.NetworkIDs) == 0 {
+		return true
+	}
+	for _, networkID := range s.NetworkIDs {
+		if networkID == uint(tx.FromChain) || networkID == uint(tx.ToChain) {
+			return true
+		}
+	}
+	return false
+}
+
+func newPayload(tx *pb.Transaction) ([]byte, error) {
+	b, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(tx)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal transaction error")
+	}
+	return json.Marshal(payload{
+		Event:       pb.TransactionStatus(tx.Status).String(),
+		Time:        time.Now().UnixMilli(),
+		Transaction: b,
+	})
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of the timestamp and the payload with the secret of the
+// subscription, joined by a dot. The subscriptions verify it to authenticate the deliveries, and check the
+// timestamp to reject the old ones
+func Sign(secret string, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
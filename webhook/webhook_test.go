@@ -0,0 +1,207 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memStorage struct {
+	subscriptions []*Subscription
+	deliveries    []*Delivery
+	deposits      map[uint]*etherman.Deposit
+}
+
+func (s *memStorage) GetWebhookSubscriptions(ctx context.Context, dbTx pgx.Tx) ([]*Subscription, error) {
+	return s.subscriptions, nil
+}
+
+func (s *memStorage) AddWebhookDeliveries(ctx context.Context, deliveries []*Delivery, dbTx pgx.Tx) error {
+	for _, d := range deliveries {
+		d.ID = uint64(len(s.deliveries) + 1)
+		s.deliveries = append(s.deliveries, d)
+	}
+	return nil
+}
+
+func (s *memStorage) ClaimDueWebhookDeliveries(ctx context.Context, limit uint, claimedUntil time.Time, dbTx pgx.Tx) ([]*Delivery, error) {
+	var due []*Delivery
+	for _, d := range s.deliveries {
+		if d.Status == DeliveryStatusPending && !d.NextAttemptAt.After(time.Now()) && uint(len(due)) < limit {
+			d.NextAttemptAt = claimedUntil
+			copied := *d
+			due = append(due, &copied)
+		}
+	}
+	return due, nil
+}
+
+func (s *memStorage) UpdateWebhookDelivery(ctx context.Context, delivery *Delivery, dbTx pgx.Tx) error {
+	*s.deliveries[delivery.ID-1] = *delivery
+	return nil
+}
+
+func (s *memStorage) GetDeposit(ctx context.Context, depositCounterUser uint, networkID uint, dbTx pgx.Tx) (*etherman.Deposit, error) {
+	return s.deposits[depositCounterUser], nil
+}
+
+func newTestConfig() Config {
+	return Config{
+		RefreshInterval: types.NewDuration(time.Minute),
+		RequestTimeout:  types.NewDuration(time.Second),
+		MaxAttempts:     2,
+		InitialBackoff:  types.NewDuration(time.Second),
+		MaxBackoff:      types.NewDuration(3 * time.Second),
+		BatchSize:       10,
+		// the test subscriptions listen on the loopback
+		AllowPrivateHosts: true,
+	}
+}
+
+func TestPublishTransactionUpdate(t *testing.T) {
+	ctx := context.Background()
+	token := common.HexToAddress("0x1")
+	storage := &memStorage{
+		subscriptions: []*Subscription{
+			{ID: 1, DestAddr: "0x000000000000000000000000000000000000000A"},
+			{ID: 2, TokenAddr: token.Hex()},
+			{ID: 3, NetworkIDs: []uint{5}},
+			{ID: 4},
+		},
+		deposits: map[uint]*etherman.Deposit{7: {OriginalAddress: token}},
+	}
+	d := NewDispatcher(newTestConfig(), storage)
+
+	tx := &pb.Transaction{FromChain: 1, ToChain: 0, Index: 7, DestAddr: "0x000000000000000000000000000000000000000a", Status: uint32(pb.TransactionStatus_TX_CLAIMED)}
+	require.NoError(t, d.PublishTransactionUpdate(ctx, tx))
+
+	// the token of the status changes is read from the deposit
+	require.Len(t, storage.deliveries, 3)
+	for i, id := range []uint64{1, 2, 4} {
+		assert.Equal(t, id, storage.deliveries[i].SubscriptionID)
+		assert.Equal(t, "TX_CLAIMED", storage.deliveries[i].Event)
+		assert.Equal(t, DeliveryStatusPending, storage.deliveries[i].Status)
+	}
+	var body payload
+	require.NoError(t, json.Unmarshal(storage.deliveries[0].Payload, &body))
+	assert.Equal(t, "TX_CLAIMED", body.Event)
+	assert.Contains(t, string(body.Transaction), `"index":"7"`)
+
+	tx = &pb.Transaction{FromChain: 5, ToChain: 0, BridgeToken: "0x2"}
+	require.NoError(t, d.PublishTransactionUpdate(ctx, tx))
+	require.Len(t, storage.deliveries, 5)
+	assert.Equal(t, uint64(3), storage.deliveries[3].SubscriptionID)
+	assert.Equal(t, uint64(4), storage.deliveries[4].SubscriptionID)
+}
+
+func TestDeliverDue(t *testing.T) {
+	ctx := context.Background()
+	fail := true
+	var received []*http.Request
+	var receivedBody []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r)
+		receivedBody, _ = io.ReadAll(r.Body)
+		if fail {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer srv.Close()
+
+	storage := &memStorage{subscriptions: []*Subscription{{ID: 1, URL: srv.URL, Secret: "secret"}}}
+	d := NewDispatcher(newTestConfig(), storage)
+	require.NoError(t, d.PublishTransactionUpdate(ctx, &pb.Transaction{TxHash: "0x01"}))
+	require.NoError(t, storage.AddWebhookDeliveries(ctx, []*Delivery{{SubscriptionID: 9, Status: DeliveryStatusPending}}, nil))
+
+	// the first attempt fails and it's retried after the backoff, the removed subscription fails at once
+	require.NoError(t, d.deliverDue(ctx))
+	delivery := storage.deliveries[0]
+	assert.Equal(t, DeliveryStatusPending, delivery.Status)
+	assert.Equal(t, uint(1), delivery.Attempts)
+	assert.Equal(t, http.StatusInternalServerError, delivery.ResponseCode)
+	assert.True(t, delivery.NextAttemptAt.After(time.Now()))
+	assert.Equal(t, DeliveryStatusFailed, storage.deliveries[1].Status)
+
+	require.Len(t, received, 1)
+	timestamp := received[0].Header.Get(TimestampHeader)
+	assert.Equal(t, signaturePrefix+Sign("secret", timestamp, receivedBody), received[0].Header.Get(SignatureHeader))
+	assert.Equal(t, "1", received[0].Header.Get(deliveryIDHeader))
+	assert.Equal(t, delivery.Payload, receivedBody)
+
+	// the delivery is not due yet
+	require.NoError(t, d.deliverDue(ctx))
+	assert.Len(t, received, 1)
+
+	delivery.NextAttemptAt = time.Now()
+	fail = false
+	require.NoError(t, d.deliverDue(ctx))
+	assert.Equal(t, DeliveryStatusDelivered, delivery.Status)
+	assert.Equal(t, uint(2), delivery.Attempts)
+	assert.Empty(t, delivery.LastError)
+
+	// a delivery fails after the max attempts
+	fail = true
+	delivery.Status, delivery.Attempts, delivery.NextAttemptAt = DeliveryStatusPending, 1, time.Now()
+	require.NoError(t, d.deliverDue(ctx))
+	assert.Equal(t, DeliveryStatusFailed, delivery.Status)
+	assert.Contains(t, delivery.LastError, "500")
+}
+
+func TestBackoff(t *testing.T) {
+	d := NewDispatcher(newTestConfig(), &memStorage{})
+	assert.Equal(t, time.Second, d.backoff(1))
+	assert.Equal(t, 2*time.Second, d.backoff(2))
+	assert.Equal(t, 3*time.Second, d.backoff(3))
+	assert.Equal(t, 3*time.Second, d.backoff(10))
+}
+
+func TestDeliverClaimed(t *testing.T) {
+	ctx := context.Background()
+	storage := &memStorage{}
+	d := NewDispatcher(newTestConfig(), storage)
+	require.NoError(t, storage.AddWebhookDeliveries(ctx, []*Delivery{{SubscriptionID: 1, Status: DeliveryStatusPending}}, nil))
+
+	// a claimed delivery is not due for the other dispatchers until the claim expires
+	claimed, err := storage.ClaimDueWebhookDeliveries(ctx, 10, time.Now().Add(time.Minute), nil)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	require.NoError(t, d.deliverDue(ctx))
+	assert.Equal(t, DeliveryStatusPending, storage.deliveries[0].Status)
+	assert.Equal(t, uint(0), storage.deliveries[0].Attempts)
+}
+
+func TestCheckURL(t *testing.T) {
+	ctx := context.Background()
+	cfg := newTestConfig()
+	cfg.AllowPrivateHosts = false
+	d := NewDispatcher(cfg, &memStorage{})
+	for _, rawURL := range []string{"ftp://example.com", "/hook", "http://"} {
+		assert.ErrorIs(t, d.CheckURL(ctx, rawURL), ErrInvalidURL, rawURL)
+	}
+	for _, rawURL := range []string{"http://127.0.0.1:8080/hook", "http://localhost/hook", "http://10.0.0.1", "http://169.254.169.254/latest",
+		"http://[::1]/hook", "http://0.0.0.0", "http://100.64.0.1"} {
+		assert.ErrorIs(t, d.CheckURL(ctx, rawURL), ErrPrivateHost, rawURL)
+	}
+	assert.NoError(t, d.CheckURL(ctx, "https://8.8.8.8/hook"))
+
+	// the deliveries to private hosts are rejected when dialing
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+	_, err := d.send(ctx, &Subscription{URL: srv.URL}, &Delivery{})
+	assert.ErrorIs(t, err, ErrPrivateHost)
+
+	d = NewDispatcher(newTestConfig(), &memStorage{})
+	assert.NoError(t, d.CheckURL(ctx, srv.URL))
+}